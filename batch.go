@@ -0,0 +1,162 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"runtime"
+	"sync"
+)
+
+// A Point is a latitude and longitude, in degrees.
+type Point struct {
+	Lat, Long float64
+}
+
+// LookupZoneNames sets out[i] to LookupZoneName(points[i].Lat,
+// points[i].Long) for each point, using the default Table. It panics
+// if out is shorter than points. See Batch for details.
+func LookupZoneNames(points []Point, out []string) {
+	new(Batch).ZoneNames(points, out)
+}
+
+// LookupZoneIDs is like LookupZoneNames but returns ZoneIDs.
+func LookupZoneIDs(points []Point, out []ZoneID) {
+	new(Batch).ZoneIDs(points, out)
+}
+
+// A Batch looks up many points at once. The points are looked up in
+// order, and the tile found for one point is reused for the next if it
+// is in the same tile, as consecutive GPS track points usually are:
+// that makes a track about 20% faster than one call per point, while
+// points in no particular order cost about the same as one call each
+// (see the benchmarks in batch_test.go). With Workers, the points are
+// also split across CPUs.
+//
+// Invalid points resolve to no zone, as with LookupZoneName.
+type Batch struct {
+	// Table is the Table to use. If nil, the default Table is used.
+	Table *Table
+
+	// Workers is the number of goroutines to split the points
+	// across. Zero or one means to use only the calling goroutine,
+	// and a negative number means runtime.GOMAXPROCS(0).
+	Workers int
+
+	// Nautical makes ZoneNames return the nautical zone of valid
+	// points in no zone, as LookupNautical does, rather than "".
+	// It does not affect ZoneIDs: nautical zones have no ZoneID.
+	Nautical bool
+}
+
+// ZoneNames sets out[i] to the name of the zone at points[i]. It
+// panics if out is shorter than points.
+func (b *Batch) ZoneNames(points []Point, out []string) {
+	out = out[:len(points)]
+	t := b.table()
+	b.run(t, points, func(i int, zone uint16) {
+		out[i] = t.zoneName(zone)
+		if zone == oceanIndex && b.Nautical && t.Init() == nil {
+			if _, _, err := Normalize(points[i].Lat, points[i].Long); err == nil {
+				out[i] = NauticalZone(points[i].Long)
+			}
+		}
+	})
+}
+
+// ZoneIDs sets out[i] to the ZoneID of the zone at points[i]. It
+// panics if out is shorter than points.
+func (b *Batch) ZoneIDs(points []Point, out []ZoneID) {
+	out = out[:len(points)]
+	b.run(b.table(), points, func(i int, zone uint16) {
+		out[i] = zoneID(zone)
+	})
+}
+
+func (b *Batch) table() *Table {
+	if b.Table != nil {
+		return b.Table
+	}
+	return Default()
+}
+
+// run calls set with the index of each point and its static zone index
+// in t or oceanIndex. Each index is set exactly once, possibly from
+// another goroutine.
+func (b *Batch) run(t *Table, points []Point, set func(i int, zone uint16)) {
+	if t.Init() != nil {
+		for i := range points {
+			set(i, oceanIndex)
+		}
+		return
+	}
+	workers := b.Workers
+	if workers < 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if max := len(points) / minPointsPerWorker; workers > max {
+		workers = max
+	}
+	if workers <= 1 {
+		t.lookupBatch(points, 0, set)
+		return
+	}
+	var wg sync.WaitGroup
+	chunk := (len(points) + workers - 1) / workers
+	for start := 0; start < len(points); start += chunk {
+		end := start + chunk
+		if end > len(points) {
+			end = len(points)
+		}
+		wg.Add(1)
+		go func(start, end int) {
+			defer wg.Done()
+			t.lookupBatch(points[start:end], start, set)
+		}(start, end)
+	}
+	wg.Wait()
+}
+
+// minPointsPerWorker is the fewest points worth a goroutine.
+const minPointsPerWorker = 4096
+
+// lookupBatch looks up points, whose indexes for set start at base.
+func (t *Table) lookupBatch(points []Point, base int, set func(i int, zone uint16)) {
+	// The tile found for the previous point, of 1<<shift pixels at
+	// (tx, ty) << shift, which often serves the next one too.
+	var (
+		n      uint32
+		shift  uint
+		tx, ty = -1, -1
+	)
+	for i, p := range points {
+		lat, long, err := Normalize(p.Lat, p.Long)
+		if err != nil {
+			set(base+i, oceanIndex)
+			continue
+		}
+		x, y := t.latLongPixel(lat, long)
+		if x>>shift != tx || y>>shift != ty {
+			n, shift = t.tileNode(x, y)
+			tx, ty = x>>shift, y>>shift
+		}
+		zone := oceanIndex
+		if n&nodeLeaf != 0 {
+			zone = t.leafZone(uint16(n), x, y)
+		}
+		set(base+i, zone)
+	}
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// uniformPoints returns n points spread over the whole world.
+func uniformPoints(n int, seed int64) []Point {
+	r := rand.New(rand.NewSource(seed))
+	pts := make([]Point, n)
+	for i := range pts {
+		pts[i] = Point{r.Float64()*180 - 90, r.Float64()*360 - 180}
+	}
+	return pts
+}
+
+// clusteredPoints returns n points in a few small areas, like the
+// photos of a trip.
+func clusteredPoints(n int, seed int64) []Point {
+	r := rand.New(rand.NewSource(seed))
+	centers := []Point{
+		{37.7833, -122.4167}, // San Francisco
+		{39.74, -86.16},      // Indiana
+		{48.85, 2.35},        // Paris
+		{-16.8, 179.9},       // Fiji
+		{11.55, 104.92},      // Phnom Penh
+	}
+	pts := make([]Point, n)
+	for i := range pts {
+		c := centers[r.Intn(len(centers))]
+		pts[i] = Point{c.Lat + r.NormFloat64(), c.Long + r.NormFloat64()}
+	}
+	return pts
+}
+
+// trackPoints returns n points along a GPS track, in order: a few
+// hundred meters apart, heading across California.
+func trackPoints(n int, seed int64) []Point {
+	r := rand.New(rand.NewSource(seed))
+	pts := make([]Point, n)
+	p := Point{32.7, -117.2}
+	for i := range pts {
+		p.Lat += 0.002 + r.NormFloat64()*0.001
+		p.Long -= 0.002 + r.NormFloat64()*0.001
+		if p.Lat > 42 {
+			p = Point{32.7, -117.2}
+		}
+		pts[i] = p
+	}
+	return pts
+}
+
+func TestLookupZoneNamesMatchesSingle(t *testing.T) {
+	pts := append(uniformPoints(20000, 1), clusteredPoints(20000, 2)...)
+	pts = append(pts, trackPoints(20000, 3)...)
+	pts = append(pts,
+		Point{math.NaN(), 0},
+		Point{95, 0},
+		Point{0, 540},
+		Point{-90, -180},
+		Point{90, 180},
+	)
+	for _, workers := range []int{0, 4, -1} {
+		b := &Batch{Workers: workers}
+		names := make([]string, len(pts))
+		ids := make([]ZoneID, len(pts))
+		b.ZoneNames(pts, names)
+		b.ZoneIDs(pts, ids)
+		for i, p := range pts {
+			want := LookupZoneName(p.Lat, p.Long)
+			if names[i] != want {
+				t.Fatalf("workers=%d: ZoneNames[%d] (%v) = %q; want %q", workers, i, p, names[i], want)
+			}
+			if got := ids[i].String(); got != want {
+				t.Fatalf("workers=%d: ZoneIDs[%d] (%v) = %d (%q); want %q", workers, i, p, ids[i], got, want)
+			}
+		}
+	}
+}
+
+func TestLookupZoneIDsSmall(t *testing.T) {
+	pts := []Point{{37.7833, -122.4167}, {27.5, -55}, {math.Inf(1), 0}}
+	ids := make([]ZoneID, len(pts))
+	LookupZoneIDs(pts, ids)
+	if ids[0].String() != "America/Los_Angeles" || ids[1] != NoZone || ids[2] != NoZone {
+		t.Errorf("LookupZoneIDs = %v", ids)
+	}
+}
+
+func TestBatchFixtureTable(t *testing.T) {
+	fix := fixtureTable()
+	pts := uniformPoints(1000, 3)
+	names := make([]string, len(pts))
+	(&Batch{Table: fix}).ZoneNames(pts, names)
+	for i, p := range pts {
+		if want := fix.LookupZoneName(p.Lat, p.Long); names[i] != want {
+			t.Fatalf("ZoneNames[%d] (%v) = %q; want %q", i, p, names[i], want)
+		}
+	}
+}
+
+// highResTable returns a 200 pixels per degree table, whose pixel x
+// coordinates need more than 16 bits, and whose only zone is the
+// 256px tile at the north-east corner of the world.
+func highResTable() *Table {
+	const dp = 200
+	var levels [6]string
+	var tile [6]byte
+	binary.BigEndian.PutUint32(tile[:], uint32(newTileKey(5, 360*dp/256, 0)))
+	levels[5] = string(tile[:])
+	f := &tableFile{degPixels: dp, nodes: buildQuadtree(dp, levels), numLeaves: 1, leaves: "SEast/Edge\x00"}
+	var buf bytes.Buffer
+	if err := f.writeTo(&buf, false); err != nil {
+		panic(err)
+	}
+	t, err := parseTable(buf.String(), false)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestBatchHighResTable(t *testing.T) {
+	tb := highResTable()
+	if got := tb.LookupZoneName(89.99, 179.99); got != "East/Edge" {
+		t.Fatalf("LookupZoneName(89.99, 179.99) = %q; want East/Edge", got)
+	}
+	pts := uniformPoints(1000, 4)
+	for i := 0; i < 100; i++ {
+		pts = append(pts, Point{89.99 - float64(i)/1000, 179.99 - float64(i)/1000})
+	}
+	names := make([]string, len(pts))
+	(&Batch{Table: tb}).ZoneNames(pts, names)
+	for i, p := range pts {
+		if want := tb.LookupZoneName(p.Lat, p.Long); names[i] != want {
+			t.Fatalf("ZoneNames[%d] (%v) = %q; want %q", i, p, names[i], want)
+		}
+	}
+}
+
+func benchmarkSingle(b *testing.B, pts []Point) {
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, p := range pts {
+			LookupZoneID(p.Lat, p.Long)
+		}
+	}
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(pts)), "ns/point")
+}
+
+func benchmarkBatch(b *testing.B, pts []Point, workers int) {
+	out := make([]ZoneID, len(pts))
+	bt := &Batch{Workers: workers}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		bt.ZoneIDs(pts, out)
+	}
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*len(pts)), "ns/point")
+}
+
+func BenchmarkSingleClustered(b *testing.B)   { benchmarkSingle(b, clusteredPoints(1e6, 1)) }
+func BenchmarkBatchClustered(b *testing.B)    { benchmarkBatch(b, clusteredPoints(1e6, 1), 0) }
+func BenchmarkBatchClusteredPar(b *testing.B) { benchmarkBatch(b, clusteredPoints(1e6, 1), -1) }
+func BenchmarkSingleTrack(b *testing.B)       { benchmarkSingle(b, trackPoints(1e6, 1)) }
+func BenchmarkBatchTrack(b *testing.B)        { benchmarkBatch(b, trackPoints(1e6, 1), 0) }
+func BenchmarkSingleUniform(b *testing.B)     { benchmarkSingle(b, uniformPoints(1e6, 1)) }
+func BenchmarkBatchUniform(b *testing.B)      { benchmarkBatch(b, uniformPoints(1e6, 1), 0) }
+func BenchmarkBatchUniformPar(b *testing.B)   { benchmarkBatch(b, uniformPoints(1e6, 1), -1) }
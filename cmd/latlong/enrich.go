@@ -0,0 +1,260 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/bradfitz/latlong"
+)
+
+// chunkSize is how many rows are looked up at once, with a
+// latlong.Batch.
+const chunkSize = 4096
+
+// An enricher appends time zone columns to rows of CSV or NDJSON.
+type enricher struct {
+	format                   string // "csv" or "ndjson"
+	latCol, longCol, timeCol string
+	bad                      string    // "fail", "skip" or "empty"
+	at                       time.Time // for offsets, if timeCol is empty
+
+	badRows int
+}
+
+// A row is one input row.
+type row struct {
+	line   int
+	fields []string // CSV fields
+	obj    []byte   // NDJSON object
+	p      latlong.Point
+	t      time.Time
+	err    error // why the row is bad, if it is
+}
+
+func (e *enricher) run(r io.Reader, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	var err error
+	if e.format == "ndjson" {
+		err = e.runNDJSON(r, bw)
+	} else {
+		err = e.runCSV(r, bw)
+	}
+	if ferr := bw.Flush(); err == nil {
+		err = ferr
+	}
+	return err
+}
+
+func (e *enricher) runCSV(r io.Reader, w io.Writer) error {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	header, err := cr.Read()
+	if err != nil {
+		return fmt.Errorf("reading CSV header: %v", err)
+	}
+	latIdx, longIdx, timeIdx := -1, -1, -1
+	for i, name := range header {
+		switch name {
+		case e.latCol:
+			latIdx = i
+		case e.longCol:
+			longIdx = i
+		case e.timeCol:
+			timeIdx = i
+		}
+	}
+	if latIdx < 0 || longIdx < 0 || e.timeCol != "" && timeIdx < 0 {
+		return fmt.Errorf("CSV header %q lacks a %q, %q or %q column", header, e.latCol, e.longCol, e.timeCol)
+	}
+
+	cw := csv.NewWriter(w)
+	cw.Write(append(header, "zone", "utc_offset", "zone_id"))
+	field := func(fields []string, i int) string {
+		if i < 0 || i >= len(fields) {
+			return ""
+		}
+		return fields[i]
+	}
+	var chunk []row
+	flush := func() error {
+		err := e.process(chunk, func(r row, zone, offset, id string) {
+			cw.Write(append(r.fields, zone, offset, id))
+		})
+		chunk = chunk[:0]
+		cw.Flush()
+		if err == nil {
+			err = cw.Error()
+		}
+		return err
+	}
+	for line := 2; ; line++ {
+		fields, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		r := row{line: line, fields: fields}
+		e.parse(&r, field(fields, latIdx), field(fields, longIdx), field(fields, timeIdx))
+		if chunk = append(chunk, r); len(chunk) == chunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+func (e *enricher) runNDJSON(r io.Reader, w io.Writer) error {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<20)
+	// value returns a field as a string, unquoting strings.
+	value := func(m map[string]json.RawMessage, name string) string {
+		v := m[name]
+		var s string
+		if json.Unmarshal(v, &s) == nil {
+			return s
+		}
+		return string(v)
+	}
+	var chunk []row
+	flush := func() error {
+		var werr error
+		err := e.process(chunk, func(r row, zone, offset, id string) {
+			if werr != nil {
+				return
+			}
+			obj := bytes.TrimSpace(r.obj)
+			body := bytes.TrimSpace(obj[:len(obj)-1])
+			var b bytes.Buffer
+			b.Write(body)
+			if body[len(body)-1] != '{' {
+				b.WriteByte(',')
+			}
+			q, _ := json.Marshal(zone)
+			fmt.Fprintf(&b, `"zone":%s,"utc_offset":%s,"zone_id":%s}`+"\n", q, orNull(offset), orNull(id))
+			_, werr = w.Write(b.Bytes())
+		})
+		chunk = chunk[:0]
+		if err == nil {
+			err = werr
+		}
+		return err
+	}
+	for line := 1; sc.Scan(); line++ {
+		obj := bytes.TrimSpace(sc.Bytes())
+		if len(obj) == 0 {
+			continue
+		}
+		r := row{line: line, obj: append([]byte(nil), obj...)}
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(obj, &m); err != nil || m == nil { // "null" unmarshals to a nil map
+			r.err = fmt.Errorf("not a JSON object")
+			r.obj = nil
+		} else {
+			e.parse(&r, value(m, e.latCol), value(m, e.longCol), value(m, e.timeCol))
+		}
+		if chunk = append(chunk, r); len(chunk) == chunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	return flush()
+}
+
+func orNull(s string) string {
+	if s == "" {
+		return "null"
+	}
+	return s
+}
+
+// parse sets r's point and time from its field values, or r.err.
+func (e *enricher) parse(r *row, lat, long, t string) {
+	la, err1 := strconv.ParseFloat(lat, 64)
+	lo, err2 := strconv.ParseFloat(long, 64)
+	if err1 != nil || err2 != nil {
+		r.err = fmt.Errorf("bad coordinates %q, %q", lat, long)
+		return
+	}
+	if _, _, err := latlong.Normalize(la, lo); err != nil {
+		r.err = fmt.Errorf("bad coordinates %q, %q", lat, long)
+		return
+	}
+	r.p = latlong.Point{Lat: la, Long: lo}
+	r.t = e.at
+	if e.timeCol != "" {
+		var err error
+		if r.t, err = time.Parse(time.RFC3339, t); err != nil {
+			r.err = fmt.Errorf("bad time %q", t)
+		}
+	}
+}
+
+// process looks up the zones of chunk and calls write with each row to
+// output and its zone, UTC offset and ZoneID columns, applying the bad
+// row policy.
+func (e *enricher) process(chunk []row, write func(r row, zone, offset, id string)) error {
+	points := make([]latlong.Point, len(chunk))
+	for i, r := range chunk {
+		points[i] = r.p
+		if r.err != nil {
+			points[i] = latlong.Point{Lat: math.NaN(), Long: math.NaN()}
+		}
+	}
+	ids := make([]latlong.ZoneID, len(chunk))
+	latlong.LookupZoneIDs(points, ids)
+
+	for i, r := range chunk {
+		if r.err != nil {
+			e.badRows++
+			switch {
+			case e.bad == "fail":
+				return fmt.Errorf("line %d: %v", r.line, r.err)
+			case e.bad == "skip" || r.obj == nil && r.fields == nil:
+				continue
+			}
+			write(r, "", "", "")
+			continue
+		}
+		id := ids[i]
+		offset := ""
+		if id != latlong.NoZone {
+			off, err := latlong.ZoneOffset(id, r.t)
+			if err != nil {
+				return err
+			}
+			offset = strconv.Itoa(off.Seconds)
+		}
+		write(r, id.String(), offset, strconv.Itoa(int(id)))
+	}
+	return nil
+}
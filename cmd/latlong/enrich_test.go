@@ -0,0 +1,168 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testAt = time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+func enrich(t *testing.T, e *enricher, in string) (string, error) {
+	t.Helper()
+	if e.latCol == "" {
+		e.latCol, e.longCol = "lat", "long"
+	}
+	if e.bad == "" {
+		e.bad = "fail"
+	}
+	if e.at.IsZero() {
+		e.at = testAt
+	}
+	var out bytes.Buffer
+	err := e.run(strings.NewReader(in), &out)
+	return out.String(), err
+}
+
+func TestEnrichCSV(t *testing.T) {
+	in := "name,lat,long\n" +
+		"sf,37.7833,-122.4167\n" +
+		"berlin,52.52,13.405\n" +
+		"ocean,27.5,-55\n"
+	got, err := enrich(t, &enricher{format: "csv"}, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(got), "\n")
+	want := []string{
+		"name,lat,long,zone,utc_offset,zone_id",
+		"sf,37.7833,-122.4167,America/Los_Angeles,-25200,",
+		"berlin,52.52,13.405,Europe/Berlin,7200,",
+		"ocean,27.5,-55,,,0",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines:\n%s", len(lines), got)
+	}
+	for i := range want {
+		if !strings.HasPrefix(lines[i], want[i]) {
+			t.Errorf("line %d = %q; want prefix %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestEnrichNDJSON(t *testing.T) {
+	in := `{"id":1,"lat":37.7833,"lng":-122.4167,"when":"2023-01-01T00:00:00Z"}` + "\n" +
+		"\n" +
+		`{"lat":"52.52","lng":"13.405","when":"2023-01-01T00:00:00Z"}` + "\n"
+	got, err := enrich(t, &enricher{format: "ndjson", latCol: "lat", longCol: "lng", timeCol: "when"}, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(got), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines:\n%s", len(lines), got)
+	}
+	if want := `{"id":1,"lat":37.7833,"lng":-122.4167,"when":"2023-01-01T00:00:00Z","zone":"America/Los_Angeles","utc_offset":-28800,"zone_id":`; !strings.HasPrefix(lines[0], want) {
+		t.Errorf("line 0 = %s; want prefix %s", lines[0], want)
+	}
+	if want := `"zone":"Europe/Berlin","utc_offset":3600,"zone_id":`; !strings.Contains(lines[1], want) {
+		t.Errorf("line 1 = %s; want %s", lines[1], want)
+	}
+}
+
+func TestEnrichBadRows(t *testing.T) {
+	in := "lat,long\n" +
+		"37.7833,-122.4167\n" +
+		"north,west\n" +
+		"91,0\n"
+	if _, err := enrich(t, &enricher{format: "csv", bad: "fail"}, in); err == nil || !strings.Contains(err.Error(), "line 3") {
+		t.Errorf("fail policy error = %v; want one about line 3", err)
+	}
+
+	e := &enricher{format: "csv", bad: "skip"}
+	got, err := enrich(t, e, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := strings.Count(got, "\n"); n != 2 || e.badRows != 2 {
+		t.Errorf("skip policy: %d lines, %d bad rows:\n%s", n, e.badRows, got)
+	}
+
+	got, err = enrich(t, &enricher{format: "csv", bad: "empty"}, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(got, "north,west,,,\n") || !strings.Contains(got, "91,0,,,\n") {
+		t.Errorf("empty policy output:\n%s", got)
+	}
+
+	got, err = enrich(t, &enricher{format: "ndjson", bad: "empty"}, `{"lat":1}`+"\nnot json\nnull\n{}\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"lat":1,"zone":"","utc_offset":null,"zone_id":null}` + "\n" + `{"zone":"","utc_offset":null,"zone_id":null}` + "\n"; got != want {
+		t.Errorf("NDJSON empty policy output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestEnrichMissingColumn(t *testing.T) {
+	if _, err := enrich(t, &enricher{format: "csv", latCol: "y", longCol: "x"}, "lat,long\n1,2\n"); err == nil {
+		t.Error("missing columns: no error")
+	}
+}
+
+// Enough rows to take several chunks.
+func TestEnrichManyRows(t *testing.T) {
+	var in strings.Builder
+	in.WriteString("lat,long\n")
+	n := 3*chunkSize + 7
+	for i := 0; i < n; i++ {
+		in.WriteString("52.52,13.405\n")
+	}
+	got, err := enrich(t, &enricher{format: "csv"}, in.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := strings.Count(got, "Europe/Berlin"); c != n {
+		t.Errorf("%d rows enriched; want %d", c, n)
+	}
+}
+
+func TestSplitArgs(t *testing.T) {
+	cases := []struct {
+		args          []string
+		flags, coords []string
+	}{
+		{[]string{"37.78", "-122.41"}, []string{}, []string{"37.78", "-122.41"}},
+		{[]string{"-33.8", "151.2"}, []string{}, []string{"-33.8", "151.2"}},
+		{[]string{"-format", "ndjson", "-lat=y"}, []string{"-format", "ndjson", "-lat=y"}, nil},
+		{[]string{"-at", "2023-01-01T00:00:00Z", "-1", "2"}, []string{"-at", "2023-01-01T00:00:00Z"}, []string{"-1", "2"}},
+	}
+	for _, tt := range cases {
+		flags, coords := splitArgs(tt.args)
+		if len(flags) == 0 {
+			flags = []string{}
+		}
+		if !reflect.DeepEqual(flags, tt.flags) || !reflect.DeepEqual(coords, tt.coords) {
+			t.Errorf("splitArgs(%q) = %q, %q; want %q, %q", tt.args, flags, coords, tt.flags, tt.coords)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/bradfitz/latlong"
+)
+
+// leafTypes names the leaf types of latlong.Explanation.LeafType.
+var leafTypes = map[byte]string{
+	'S': "static zone",
+	'2': "oneBitTile",
+	'P': "pixmap",
+}
+
+// inspect writes an explanation of the lookup of lat and long to w,
+// ending with the 8x8 tile around it drawn with a letter per zone.
+func inspect(w io.Writer, lat, long string) error {
+	la, err1 := strconv.ParseFloat(lat, 64)
+	lo, err2 := strconv.ParseFloat(long, 64)
+	if err1 != nil || err2 != nil {
+		return fmt.Errorf("bad coordinates %q, %q", lat, long)
+	}
+	e, err := latlong.Explain(la, lo)
+	if err != nil {
+		return err
+	}
+
+	zone := e.Zone
+	if zone == "" {
+		zone = "no time zone"
+	}
+	fmt.Fprintf(w, "%v, %v: %s (ZoneID %d)\n", e.Lat, e.Long, zone, e.ID)
+	fmt.Fprintf(w, "pixel     (%d, %d): lat %v to %v, long %v to %v\n", e.X, e.Y, e.Min.Lat, e.Max.Lat, e.Min.Long, e.Max.Long)
+	for _, s := range e.Steps {
+		fmt.Fprintf(w, "level %d   %dpx tile (%d, %d), key %#08x: %s\n", s.Level, s.Size, s.X, s.Y, s.Key, s.Result)
+	}
+	if e.Leaf >= 0 {
+		fmt.Fprintf(w, "leaf      %d (%s)\n", e.Leaf, leafTypes[e.LeafType])
+	}
+	fmt.Fprintf(w, "data      %s\n", e.Version)
+
+	// Letter the zones in order of appearance, '.' for none.
+	letters := map[latlong.ZoneID]byte{latlong.NoZone: '.'}
+	var legend []latlong.ZoneID
+	ocean := false
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+	fmt.Fprintf(w, "\n8x8 tile from pixel (%d, %d), [X] marking (%d, %d):\n", e.X&^7, e.Y&^7, e.X, e.Y)
+	for yy, row := range e.Tile {
+		line := make([]byte, 0, 3*len(row))
+		for xx, id := range row {
+			c, ok := letters[id]
+			ocean = ocean || id == latlong.NoZone
+			if !ok {
+				c = alphabet[len(legend)] // at most 64 zones in a tile, one per pixel
+				letters[id] = c
+				legend = append(legend, id)
+			}
+			if yy == e.Y%8 && xx == e.X%8 {
+				line = append(line, '[', c, ']')
+			} else {
+				line = append(line, ' ', c, ' ')
+			}
+		}
+		fmt.Fprintf(w, "  %s\n", bytes.TrimRight(line, " "))
+	}
+	for _, id := range legend {
+		fmt.Fprintf(w, "  %c  %s\n", letters[id], id)
+	}
+	if ocean {
+		fmt.Fprintf(w, "  .  no time zone\n")
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	var buf bytes.Buffer
+	if err := inspect(&buf, "37.78", "-122.41"); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		"37.78, -122.41: America/Los_Angeles (ZoneID ",
+		"pixel     (1842, 1671): lat 37.75 to 37.78125, long -122.4375 to -122.40625\n",
+		"level 5   256px tile (7, 6), key 0x50018007: leaf\n",
+		"(static zone)\n",
+		"   A  A [A] A  A  A  A  A\n",
+		"  A  America/Los_Angeles\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output lacks %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "no time zone") {
+		t.Errorf("output mentions no time zone:\n%s", out)
+	}
+
+	// Where Algeria, Tunisia and Libya meet, the tile is split down
+	// to a pixmap.
+	buf.Reset()
+	if err := inspect(&buf, "30.37", "9.68"); err != nil {
+		t.Fatal(err)
+	}
+	out = buf.String()
+	for _, want := range []string{
+		"level 0   8px tile (758, 238)",
+		"(pixmap)\n",
+		"   B  A  A  A  A [A] A  A\n",
+		"  A  Africa/Tunis\n  B  Africa/Algiers\n  C  Africa/Tripoli\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("tri-point output lacks %q:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	if err := inspect(&buf, "0", "-30"); err != nil {
+		t.Fatal(err)
+	}
+	if out := buf.String(); !strings.Contains(out, ": empty\n") || !strings.Contains(out, "  [.] .") || !strings.Contains(out, "  .  no time zone\n") {
+		t.Errorf("ocean output:\n%s", out)
+	}
+}
+
+func TestInspectErrors(t *testing.T) {
+	var buf bytes.Buffer
+	if err := inspect(&buf, "x", "1"); err == nil {
+		t.Error("no error for bad coordinates")
+	}
+	if err := inspect(&buf, "91", "0"); err == nil {
+		t.Error("no error for invalid coordinates")
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The latlong command looks up the time zones of latitudes and
+// longitudes.
+//
+// Given a latitude and longitude, it prints the time zone there:
+//
+//	$ latlong 37.78 -122.41
+//	America/Los_Angeles
+//
+// With no arguments, it reads CSV or NDJSON rows from standard input
+// and writes them to standard output with zone, UTC offset and ZoneID
+// columns appended:
+//
+//	$ latlong -format=csv -lat=latitude -long=longitude < points.csv
+//
+// The inspect subcommand explains how the lookup of a latitude and
+// longitude found its zone (see latlong.Explain), for diagnosing wrong
+// answers near borders, and draws the 8x8 pixel tile around it:
+//
+//	$ latlong inspect 37.78 -122.41
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bradfitz/latlong"
+)
+
+var (
+	flagFormat = flag.String("format", "csv", "Input and output format: csv or ndjson.")
+	flagLat    = flag.String("lat", "lat", "Name of the latitude column or field.")
+	flagLong   = flag.String("long", "long", "Name of the longitude column or field.")
+	flagTime   = flag.String("time", "", "Name of an RFC 3339 time column or field to compute each row's UTC offset at. If empty, -at is used.")
+	flagAt     = flag.String("at", "", "RFC 3339 time to compute UTC offsets at. The default is now.")
+	flagBad    = flag.String("bad", "fail", "What to do with rows whose coordinates, or time, are missing or invalid: fail, skip or empty (write them with empty zone columns).")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: latlong [flags] [lat long]\n")
+	fmt.Fprintf(os.Stderr, "       latlong inspect lat long\n\n")
+	fmt.Fprintf(os.Stderr, "With a latitude and longitude, prints the time zone there. Otherwise\n")
+	fmt.Fprintf(os.Stderr, "reads rows from stdin and writes them with zone, utc_offset (seconds\n")
+	fmt.Fprintf(os.Stderr, "east of UTC) and zone_id columns appended. Inspect explains a lookup.\n\nFlags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	if len(os.Args) > 1 && os.Args[1] == "inspect" {
+		if len(os.Args) != 4 {
+			usage()
+			os.Exit(2)
+		}
+		if err := inspect(os.Stdout, os.Args[2], os.Args[3]); err != nil {
+			fmt.Fprintf(os.Stderr, "latlong: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+	flagArgs, coords := splitArgs(os.Args[1:])
+	flag.CommandLine.Parse(flagArgs)
+	coords = append(flag.Args(), coords...)
+
+	if len(coords) > 0 {
+		if len(coords) != 2 {
+			usage()
+			os.Exit(2)
+		}
+		zone, err := lookup(coords[0], coords[1])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "latlong: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(zone)
+		return
+	}
+
+	e, err := newEnricher()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "latlong: %v\n", err)
+		os.Exit(2)
+	}
+	if err := e.run(os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "latlong: %v\n", err)
+		os.Exit(1)
+	}
+	if e.badRows > 0 {
+		fmt.Fprintf(os.Stderr, "latlong: %d bad rows\n", e.badRows)
+	}
+}
+
+// splitArgs splits args into flags and, from the first number on,
+// coordinates, so that a negative latitude isn't taken for a flag.
+func splitArgs(args []string) (flags, coords []string) {
+	for i := 0; i < len(args); i++ {
+		a := args[i]
+		if _, err := strconv.ParseFloat(a, 64); err == nil {
+			return args[:i], args[i:]
+		}
+		if a == "--" || !strings.HasPrefix(a, "-") {
+			break
+		}
+		if !strings.Contains(a, "=") && a != "-h" && a != "-help" && a != "--help" {
+			i++ // the flag's value
+		}
+	}
+	return args, nil
+}
+
+// lookup returns the zone at the given coordinates.
+func lookup(lat, long string) (string, error) {
+	la, err1 := strconv.ParseFloat(lat, 64)
+	lo, err2 := strconv.ParseFloat(long, 64)
+	if err1 != nil || err2 != nil {
+		return "", fmt.Errorf("bad coordinates %q, %q", lat, long)
+	}
+	zone, err := latlong.LookupZoneNameErr(la, lo)
+	if errors.Is(err, latlong.ErrInvalidCoordinate) {
+		return "", fmt.Errorf("invalid coordinates %s, %s", lat, long)
+	}
+	if err != nil {
+		return "", err
+	}
+	if zone == "" {
+		return "", fmt.Errorf("no time zone at %s, %s", lat, long)
+	}
+	return zone, nil
+}
+
+// newEnricher returns an enricher configured by the flags.
+func newEnricher() (*enricher, error) {
+	e := &enricher{
+		format:  *flagFormat,
+		latCol:  *flagLat,
+		longCol: *flagLong,
+		timeCol: *flagTime,
+		bad:     *flagBad,
+		at:      time.Now(),
+	}
+	if *flagAt != "" {
+		t, err := time.Parse(time.RFC3339, *flagAt)
+		if err != nil {
+			return nil, fmt.Errorf("bad -at time: %v", err)
+		}
+		e.at = t
+	}
+	switch e.format {
+	case "csv", "ndjson":
+	default:
+		return nil, fmt.Errorf("unknown -format %q", e.format)
+	}
+	switch e.bad {
+	case "fail", "skip", "empty":
+	default:
+		return nil, fmt.Errorf("unknown -bad policy %q", e.bad)
+	}
+	return e, nil
+}
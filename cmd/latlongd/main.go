@@ -0,0 +1,98 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The latlongd command is an HTTP service that looks up time zones.
+//
+// Endpoints:
+//
+//	GET /v1/zone?lat=37.78&lon=-122.41[&t=2023-07-01T12:00:00Z]
+//	POST /v1/zones, with a body of {"points": [{"lat": 37.78, "lon": -122.41}, ...]}
+//	GET /healthz
+//
+// Lookups return the zone name, its UTC offset and DST status now (or
+// at time t) and the version of the data that answered (see
+// latlong.Table.Version). It uses the tables embedded in the binary,
+// unless given a table file with -tables, and needs no network access.
+//
+// With -tables, a SIGHUP rereads the file and swaps in the new tables
+// without dropping requests. A file that fails to load is logged and
+// the old tables are kept. With -admin_addr too, so does a POST to
+// /v1/reload on that address, which is separate so that it needn't be
+// exposed to those doing lookups; it has no authentication of its own.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/bradfitz/latlong"
+)
+
+var (
+	flagAddr   = flag.String("addr", "localhost:8080", "Address to listen on.")
+	flagTables = flag.String("tables", "", "Table file to use instead of the embedded tables.")
+	flagAdmin  = flag.String("admin_addr", "", "If non-empty, address to serve POST /v1/reload on.")
+)
+
+func main() {
+	flag.Parse()
+	table := latlong.Default()
+	if *flagTables != "" {
+		var err error
+		if table, err = loadTables(*flagTables); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := table.Init(); err != nil {
+		log.Printf("tables unusable: %v", err) // reported by /healthz
+	}
+	s := newServer(table)
+	if *flagTables != "" {
+		s.load = func() (*latlong.Table, error) { return loadTables(*flagTables) }
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		go func() {
+			for range hup {
+				if _, err := s.reload(); err != nil {
+					log.Printf("reload failed: %v", err)
+				}
+			}
+		}()
+	}
+	if *flagAdmin != "" {
+		go func() { log.Fatal(http.ListenAndServe(*flagAdmin, s.admin)) }()
+	}
+	log.Printf("serving tables %s on %s", table.Version(), *flagAddr)
+	log.Fatal(http.ListenAndServe(*flagAddr, s))
+}
+
+func loadTables(file string) (*latlong.Table, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	t, err := latlong.LoadTable(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", file, err)
+	}
+	return t, nil
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bradfitz/latlong"
+)
+
+// maxBatch is the most points a POST /v1/zones request may have.
+const maxBatch = 100000
+
+// A server serves lookups from a Table, which reload can replace
+// while requests are running. Its admin handler serves the endpoints
+// that change it, to be listened for separately from lookups.
+type server struct {
+	mux   *http.ServeMux
+	admin *http.ServeMux
+	table atomic.Value // *latlong.Table
+	now   func() time.Time
+
+	// load, if non-nil, loads the Table that reload swaps in.
+	load func() (*latlong.Table, error)
+
+	reloadMu sync.Mutex // serializes reloads
+}
+
+func newServer(table *latlong.Table) *server {
+	s := &server{
+		mux:   http.NewServeMux(),
+		admin: http.NewServeMux(),
+		now:   time.Now,
+	}
+	s.table.Store(table)
+	s.mux.HandleFunc("/v1/zone", s.serveZone)
+	s.mux.HandleFunc("/v1/zones", s.serveZones)
+	s.mux.HandleFunc("/healthz", s.serveHealth)
+	s.admin.HandleFunc("/v1/reload", s.serveReload)
+	return s
+}
+
+// tables returns the current Table. Each request uses one Table
+// throughout, so that its answers and version agree.
+func (s *server) tables() *latlong.Table {
+	return s.table.Load().(*latlong.Table)
+}
+
+// reload loads a new Table and swaps it in if it is usable, returning
+// its version. Requests already running finish with the old Table.
+func (s *server) reload() (string, error) {
+	if s.load == nil {
+		return "", errNoReload
+	}
+	s.reloadMu.Lock()
+	defer s.reloadMu.Unlock()
+	table, err := s.load()
+	if err == nil {
+		err = table.Init()
+	}
+	if err != nil {
+		return "", err
+	}
+	old := s.tables().Version()
+	s.table.Store(table)
+	log.Printf("reloaded tables: version %s, was %s", table.Version(), old)
+	return table.Version(), nil
+}
+
+var errNoReload = errors.New("no -tables file to reload")
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+// A zoneResult is the answer for one point.
+type zoneResult struct {
+	Zone   string `json:"zone"`             // "" if none
+	Offset *int   `json:"offset,omitempty"` // seconds east of UTC
+	Abbrev string `json:"abbrev,omitempty"`
+	DST    bool   `json:"dst"`
+	Error  string `json:"error,omitempty"`
+}
+
+type zoneResponse struct {
+	zoneResult
+	Version string `json:"version"`
+}
+
+type zonesRequest struct {
+	Points []struct {
+		Lat float64 `json:"lat"`
+		Lon float64 `json:"lon"`
+	} `json:"points"`
+	Time string `json:"t,omitempty"`
+}
+
+type zonesResponse struct {
+	Results []zoneResult `json:"results"`
+	Version string       `json:"version"`
+}
+
+func (s *server) serveZone(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		httpError(w, http.StatusMethodNotAllowed, "use GET")
+		return
+	}
+	q := r.URL.Query()
+	lat, err1 := strconv.ParseFloat(q.Get("lat"), 64)
+	lon, err2 := strconv.ParseFloat(q.Get("lon"), 64)
+	if err1 != nil || err2 != nil {
+		httpError(w, http.StatusBadRequest, "lat and lon must be numbers")
+		return
+	}
+	at, err := s.parseTime(q.Get("t"))
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	if _, _, err := latlong.Normalize(lat, lon); err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	table := s.tables()
+	if err := table.Init(); err != nil {
+		httpError(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	id := table.LookupZoneID(lat, lon)
+	writeJSON(w, http.StatusOK, zoneResponse{s.result(table, id, at), table.Version()})
+}
+
+func (s *server) serveZones(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		httpError(w, http.StatusMethodNotAllowed, "use POST")
+		return
+	}
+	var req zonesRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<20)).Decode(&req); err != nil {
+		httpError(w, http.StatusBadRequest, "bad JSON body: "+err.Error())
+		return
+	}
+	if len(req.Points) > maxBatch {
+		httpError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("more than %d points", maxBatch))
+		return
+	}
+	at, err := s.parseTime(req.Time)
+	if err != nil {
+		httpError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	table := s.tables()
+	if err := table.Init(); err != nil {
+		httpError(w, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+
+	points := make([]latlong.Point, len(req.Points))
+	for i, p := range req.Points {
+		points[i] = latlong.Point{Lat: p.Lat, Long: p.Lon}
+	}
+	ids := make([]latlong.ZoneID, len(points))
+	(&latlong.Batch{Table: table}).ZoneIDs(points, ids)
+
+	resp := zonesResponse{Results: make([]zoneResult, len(points)), Version: table.Version()}
+	for i, id := range ids {
+		if _, _, err := latlong.Normalize(points[i].Lat, points[i].Long); err != nil {
+			resp.Results[i].Error = err.Error()
+			continue
+		}
+		resp.Results[i] = s.result(table, id, at)
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (s *server) serveHealth(w http.ResponseWriter, r *http.Request) {
+	table := s.tables()
+	status := struct {
+		OK      bool   `json:"ok"`
+		Error   string `json:"error,omitempty"`
+		Version string `json:"version"`
+	}{OK: true, Version: table.Version()}
+	code := http.StatusOK
+	if err := table.Init(); err != nil {
+		status.OK, status.Error = false, err.Error()
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, status)
+}
+
+func (s *server) serveReload(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		httpError(w, http.StatusMethodNotAllowed, "use POST")
+		return
+	}
+	version, err := s.reload()
+	switch {
+	case err == errNoReload:
+		httpError(w, http.StatusNotImplemented, err.Error())
+	case err != nil:
+		httpError(w, http.StatusInternalServerError, "reload failed, still serving "+s.tables().Version()+": "+err.Error())
+	default:
+		writeJSON(w, http.StatusOK, struct {
+			Version string `json:"version"`
+		}{version})
+	}
+}
+
+// parseTime parses an optional RFC 3339 time, defaulting to now.
+func (s *server) parseTime(v string) (time.Time, error) {
+	if v == "" {
+		return s.now(), nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("bad time %q: want RFC 3339", v)
+	}
+	return t, nil
+}
+
+// result returns the answer for table's zone id at instant at.
+func (s *server) result(table *latlong.Table, id latlong.ZoneID, at time.Time) zoneResult {
+	zone := table.ZoneName(id)
+	if zone == "" {
+		return zoneResult{}
+	}
+	off, err := table.ZoneOffset(id, at)
+	if err != nil {
+		return zoneResult{Zone: zone, Error: err.Error()}
+	}
+	return zoneResult{Zone: zone, Offset: &off.Seconds, Abbrev: off.Abbrev, DST: off.DST}
+}
+
+func httpError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, struct {
+		Error string `json:"error"`
+	}{msg})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bradfitz/latlong"
+)
+
+func testServer() *server {
+	s := newServer(latlong.Default())
+	s.now = func() time.Time { return time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC) }
+	return s
+}
+
+func do(t *testing.T, h http.Handler, method, url, body string, v interface{}) int {
+	t.Helper()
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("%s %s: Content-Type = %q", method, url, ct)
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), v); err != nil {
+		t.Fatalf("%s %s: %v in %q", method, url, err, rec.Body)
+	}
+	return rec.Code
+}
+
+func TestServeZone(t *testing.T) {
+	s := testServer()
+	tests := []struct {
+		url    string
+		zone   string
+		offset int
+		dst    bool
+	}{
+		{"/v1/zone?lat=37.7833&lon=-122.4167", "America/Los_Angeles", -7 * 3600, true},
+		{"/v1/zone?lat=37.7833&lon=-122.4167&t=2023-01-15T12:00:00Z", "America/Los_Angeles", -8 * 3600, false},
+		{"/v1/zone?lat=-33.87&lon=151.21", "Australia/Sydney", 10 * 3600, false},
+		{"/v1/zone?lat=27.5&lon=-55", "", 0, false},
+	}
+	for _, tt := range tests {
+		var got zoneResponse
+		if code := do(t, s, "GET", tt.url, "", &got); code != http.StatusOK {
+			t.Errorf("%s: status %d", tt.url, code)
+			continue
+		}
+		if got.Version != latlong.Version() {
+			t.Errorf("%s: version = %q", tt.url, got.Version)
+		}
+		if got.Zone != tt.zone || got.DST != tt.dst {
+			t.Errorf("%s: zone %q, dst %v; want %q, %v", tt.url, got.Zone, got.DST, tt.zone, tt.dst)
+		}
+		switch {
+		case tt.zone == "" && got.Offset != nil:
+			t.Errorf("%s: offset %d for no zone", tt.url, *got.Offset)
+		case tt.zone != "" && (got.Offset == nil || *got.Offset != tt.offset):
+			t.Errorf("%s: offset %v; want %d", tt.url, got.Offset, tt.offset)
+		}
+	}
+}
+
+func TestServeZoneErrors(t *testing.T) {
+	s := testServer()
+	tests := []struct {
+		method, url string
+		code        int
+	}{
+		{"GET", "/v1/zone?lat=37.78", http.StatusBadRequest},
+		{"GET", "/v1/zone?lat=x&lon=1", http.StatusBadRequest},
+		{"GET", "/v1/zone?lat=91&lon=0", http.StatusBadRequest},
+		{"GET", "/v1/zone?lat=NaN&lon=0", http.StatusBadRequest},
+		{"GET", "/v1/zone?lat=0&lon=0&t=yesterday", http.StatusBadRequest},
+		{"POST", "/v1/zone?lat=0&lon=0", http.StatusMethodNotAllowed},
+		{"GET", "/v1/zones", http.StatusMethodNotAllowed},
+	}
+	for _, tt := range tests {
+		var got struct{ Error string }
+		if code := do(t, s, tt.method, tt.url, "", &got); code != tt.code || got.Error == "" {
+			t.Errorf("%s %s: status %d, error %q; want %d", tt.method, tt.url, code, got.Error, tt.code)
+		}
+	}
+}
+
+func TestServeZones(t *testing.T) {
+	s := testServer()
+	body := `{"points": [
+		{"lat": 37.7833, "lon": -122.4167},
+		{"lat": 52.52, "lon": 13.405},
+		{"lat": 27.5, "lon": -55},
+		{"lat": 95, "lon": 0},
+		{"lat": 37.7833, "lon": 237.5833}
+	]}`
+	var got zonesResponse
+	if code := do(t, s, "POST", "/v1/zones", body, &got); code != http.StatusOK {
+		t.Fatalf("status %d", code)
+	}
+	if got.Version != latlong.Version() {
+		t.Errorf("version = %q", got.Version)
+	}
+	want := []string{"America/Los_Angeles", "Europe/Berlin", "", "", "America/Los_Angeles"}
+	if len(got.Results) != len(want) {
+		t.Fatalf("got %d results; want %d", len(got.Results), len(want))
+	}
+	for i, r := range got.Results {
+		if r.Zone != want[i] {
+			t.Errorf("result %d: zone %q; want %q", i, r.Zone, want[i])
+		}
+	}
+	if r := got.Results[1]; r.Offset == nil || *r.Offset != 2*3600 || !r.DST || r.Abbrev != "CEST" {
+		t.Errorf("Berlin = %+v", r)
+	}
+	if got.Results[3].Error == "" {
+		t.Errorf("no error for invalid point")
+	}
+
+	var bad struct{ Error string }
+	if code := do(t, s, "POST", "/v1/zones", `{"points": [`, &bad); code != http.StatusBadRequest {
+		t.Errorf("truncated body: status %d", code)
+	}
+}
+
+func TestHealthz(t *testing.T) {
+	var got struct {
+		OK      bool
+		Error   string
+		Version string
+	}
+	if code := do(t, testServer(), "GET", "/healthz", "", &got); code != http.StatusOK || !got.OK || got.Version != latlong.Version() {
+		t.Errorf("default tables: status %d, %+v", code, got)
+	}
+
+	// A Table that was never loaded is unusable.
+	s := newServer(new(latlong.Table))
+	if code := do(t, s, "GET", "/healthz", "", &got); code != http.StatusServiceUnavailable || got.OK || got.Error == "" {
+		t.Errorf("empty table: status %d, %+v", code, got)
+	}
+	var z struct{ Error string }
+	if code := do(t, s, "GET", "/v1/zone?lat=0&lon=0", "", &z); code != http.StatusServiceUnavailable {
+		t.Errorf("lookup on empty table: status %d", code)
+	}
+}
+
+func TestReload(t *testing.T) {
+	var got struct {
+		OK      bool
+		Error   string
+		Version string
+	}
+	s := newServer(new(latlong.Table))
+	if code := do(t, s.admin, "POST", "/v1/reload", "", &got); code != http.StatusNotImplemented {
+		t.Errorf("reload without a loader: status %d", code)
+	}
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, httptest.NewRequest("POST", "/v1/reload", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("reload on the lookup handler: status %d", rec.Code)
+	}
+
+	// A failed load keeps the old tables.
+	s.load = func() (*latlong.Table, error) { return nil, errors.New("no such file") }
+	if code := do(t, s.admin, "POST", "/v1/reload", "", &got); code != http.StatusInternalServerError || got.Error == "" {
+		t.Errorf("failed reload: status %d, %+v", code, got)
+	}
+	s.load = func() (*latlong.Table, error) { return new(latlong.Table), nil }
+	if code := do(t, s.admin, "POST", "/v1/reload", "", &got); code != http.StatusInternalServerError {
+		t.Errorf("reload of unusable tables: status %d", code)
+	}
+
+	s.load = func() (*latlong.Table, error) { return latlong.Default(), nil }
+	if code := do(t, s.admin, "POST", "/v1/reload", "", &got); code != http.StatusOK || got.Version != latlong.Version() {
+		t.Errorf("reload: status %d, %+v", code, got)
+	}
+	if code := do(t, s, "GET", "/healthz", "", &got); code != http.StatusOK || !got.OK {
+		t.Errorf("healthz after reload: status %d, %+v", code, got)
+	}
+	var z zoneResponse
+	if code := do(t, s, "GET", "/v1/zone?lat=52.52&lon=13.405", "", &z); code != http.StatusOK || z.Zone != "Europe/Berlin" {
+		t.Errorf("lookup after reload: status %d, %+v", code, z)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+// An Explanation traces how a lookup found its zone, for diagnosing
+// wrong answers near borders.
+type Explanation struct {
+	// Lat and Long are the coordinate looked up, normalized.
+	Lat, Long float64
+
+	// X and Y are the pixel the coordinate maps to, and Min and Max
+	// the south-west and north-east corners of that pixel.
+	X, Y     int
+	Min, Max Point
+
+	// Steps are the tiles visited, from the 256 pixel square tile
+	// containing the pixel down to the one that decided the lookup.
+	Steps []TileStep
+
+	// Leaf is the index of the leaf the last step resolved to, or
+	// -1 if it was empty, and LeafType that leaf's type: 'S' for a
+	// single zone, '2' for a two-zone 8x8 bitmap (a oneBitTile) or
+	// 'P' for a multi-zone 8x8 bitmap (a pixmap).
+	Leaf     int
+	LeafType byte
+
+	// Zone and ID are the answer: those of LookupZoneName and
+	// LookupZoneID.
+	Zone string
+	ID   ZoneID
+
+	// Tile holds the zones of the 8x8 pixel tile containing the
+	// pixel, by row from north to south, with the pixel itself at
+	// Tile[Y%8][X%8]. Pixels beyond the edge of the world are NoZone.
+	Tile [8][8]ZoneID
+
+	// Version is the Version of the Table that answered.
+	Version string
+}
+
+// A TileStep is one tile visited by a lookup.
+type TileStep struct {
+	Level int    // zoom level: 5 for 256 pixel tiles down to 0 for 8 pixel ones
+	Size  int    // width and height in pixels
+	X, Y  int    // position, in tiles of this size
+	Key   uint32 // the tileKey the generator identifies the tile by
+
+	// Result is "empty" if there is no zone anywhere in the tile,
+	// "leaf" if a single leaf covers the tile, or "split" if the
+	// tile is split into four smaller ones.
+	Result string
+}
+
+// Explain looks up a coordinate in the default Table, as
+// LookupZoneNameErr does, and returns a trace of the lookup.
+func Explain(lat, long float64) (Explanation, error) {
+	return Default().Explain(lat, long)
+}
+
+// Explain is like the package-level Explain but uses t.
+func (t *Table) Explain(lat, long float64) (Explanation, error) {
+	lat, long, err := Normalize(lat, long)
+	if err != nil {
+		return Explanation{}, err
+	}
+	if err := t.Init(); err != nil {
+		return Explanation{}, err
+	}
+	x, y := t.latLongPixel(lat, long)
+	e := Explanation{
+		Lat:     lat,
+		Long:    long,
+		X:       x,
+		Y:       y,
+		Min:     t.pixelPoint(float64(x), float64(y+1)),
+		Max:     t.pixelPoint(float64(x+1), float64(y)),
+		Leaf:    -1,
+		Version: t.Version(),
+	}
+
+	// As tileNode, recording each step.
+	shift := uint(8)
+	n := t.node((y>>shift)*t.gridW + x>>shift)
+	for {
+		step := TileStep{
+			Level: int(shift) - 3,
+			Size:  1 << shift,
+			X:     x >> shift,
+			Y:     y >> shift,
+		}
+		step.Key = uint32(newTileKey(uint8(step.Level), uint16(step.X), uint16(step.Y)))
+		switch {
+		case n == nodeEmpty:
+			step.Result = "empty"
+		case n&nodeLeaf != 0:
+			step.Result = "leaf"
+		default:
+			step.Result = "split"
+		}
+		e.Steps = append(e.Steps, step)
+		if step.Result != "split" || shift == 3 {
+			break
+		}
+		shift--
+		n = t.node(int(n) + (y>>shift&1)<<1 + x>>shift&1)
+	}
+
+	zone := oceanIndex
+	if n != nodeEmpty && n&nodeLeaf != 0 {
+		idx := uint16(n &^ nodeLeaf)
+		e.Leaf = int(idx)
+		e.LeafType, _ = t.leaf(idx)
+		zone = t.leafZone(idx, x, y)
+	}
+	e.Zone = t.zoneName(zone)
+	e.ID = zoneID(zone)
+
+	x0, y0 := x&^7, y&^7
+	for yy := range e.Tile {
+		for xx := range e.Tile[yy] {
+			px, py := x0+xx, y0+yy
+			if px < 360*t.degPixels && py < 180*t.degPixels {
+				e.Tile[yy][xx] = zoneID(t.pixelZone(px, py))
+			}
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestExplainFixture(t *testing.T) {
+	fix := fixtureTable()
+	cases := []struct {
+		lat, long float64
+		x, y      int
+		results   string // of the steps, by first letter
+		leaf      int
+		leafType  byte
+		zone      string
+	}{
+		{89.5, -179.5, 0, 0, "l", 0, 'S', "Fixture/West"},
+		{9.5, 140.5, 320, 80, "sssssl", 1, 'S', "Fixture/East"},
+		{49.5, 86.5, 266, 40, "sssssl", 2, '2', "Fixture/East"},
+		{49.5, 88.5, 268, 40, "sssssl", 2, '2', "Fixture/West"},
+		{-10.5, 120.5, 300, 100, "sse", -1, 0, ""},
+	}
+	for _, tt := range cases {
+		e, err := fix.Explain(tt.lat, tt.long)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var results string
+		for _, s := range e.Steps {
+			results += s.Result[:1]
+		}
+		if e.X != tt.x || e.Y != tt.y || results != tt.results || e.Leaf != tt.leaf || e.LeafType != tt.leafType || e.Zone != tt.zone {
+			t.Errorf("Explain(%v, %v) = pixel (%d, %d), steps %q, leaf %d %q, zone %q; want (%d, %d), %q, %d %q, %q",
+				tt.lat, tt.long, e.X, e.Y, results, e.Leaf, e.LeafType, e.Zone,
+				tt.x, tt.y, tt.results, tt.leaf, tt.leafType, tt.zone)
+		}
+		if e.Min.Lat > tt.lat || e.Max.Lat < tt.lat || e.Min.Long > tt.long || e.Max.Long < tt.long || e.Max.Lat-e.Min.Lat != 1 {
+			t.Errorf("Explain(%v, %v): pixel bounds %v to %v", tt.lat, tt.long, e.Min, e.Max)
+		}
+	}
+
+	e, _ := fix.Explain(49.5, 86.5)
+	last := e.Steps[len(e.Steps)-1]
+	if last.Level != 0 || last.Size != 8 || last.X != 33 || last.Y != 5 || last.Key != uint32(newTileKey(0, 33, 5)) {
+		t.Errorf("last step = %+v", last)
+	}
+	for yy, row := range e.Tile {
+		for xx, id := range row {
+			want := "Fixture/West"
+			if xx < 4 {
+				want = "Fixture/East"
+			}
+			if got := fix.ZoneName(id); got != want {
+				t.Errorf("Tile[%d][%d] = %q; want %q", yy, xx, got, want)
+			}
+		}
+	}
+
+	// The fixture's last tile row runs off the bottom of the world.
+	e, _ = fix.Explain(-89.5, 0)
+	if e.Y != 179 || e.Tile[3][0] != e.ID || e.Tile[4][0] != NoZone {
+		t.Errorf("at the south pole: Y = %d, Tile column %v", e.Y, [...]ZoneID{e.Tile[3][0], e.Tile[4][0]})
+	}
+}
+
+func TestExplainMatchesLookup(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		lat, long := rnd.Float64()*180-90, rnd.Float64()*360-180
+		e, err := Explain(lat, long)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if zone := LookupZoneName(lat, long); e.Zone != zone || e.ID != LookupZoneID(lat, long) {
+			t.Fatalf("Explain(%v, %v) = %q, %v; LookupZoneName = %q", lat, long, e.Zone, e.ID, zone)
+		}
+		if e.Tile[e.Y%8][e.X%8] != e.ID {
+			t.Fatalf("Explain(%v, %v): Tile at the pixel = %v; want %v", lat, long, e.Tile[e.Y%8][e.X%8], e.ID)
+		}
+		if len(e.Steps) == 0 || len(e.Steps) > 6 || e.Steps[0].Size != 256 {
+			t.Fatalf("Explain(%v, %v): steps %+v", lat, long, e.Steps)
+		}
+	}
+}
+
+func TestExplainErrors(t *testing.T) {
+	if _, err := Explain(91, 0); !errors.Is(err, ErrInvalidCoordinate) {
+		t.Errorf("Explain(91, 0) error = %v", err)
+	}
+	if _, err := new(Table).Explain(0, 0); err != ErrNoTables {
+		t.Errorf("Explain on zero Table error = %v", err)
+	}
+}
@@ -0,0 +1,408 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+// Table file format
+//
+// A table file, as written by the generator (see the Makefile) and
+// read by LoadTable, starts with a 12 byte header:
+//
+//	[4] magic "LLTZ"
+//	[2] format version, currently 1
+//	[2] flags; bit 0 set means the body is gzip-compressed
+//	[2] degPixels: pixels per degree of latitude and longitude, at
+//	    most 364
+//	[2] reserved, zero
+//
+// The (possibly compressed) body is a sequence of sections, each a 4
+// byte tag, a 4 byte length and that many bytes of data:
+//
+//	"QTRE" the 4 byte quadtree nodes described in quadtree.go
+//	"LEAF" a 4 byte leaf count followed by the packed leaves, each one
+//	       of 'S' and a NUL-terminated zone name, '2' and a 12 byte
+//	       oneBitTile, or 'P' and a 128 byte pixmap; all 'S' leaves
+//	       come first
+//	"LIDX" optional; for each leaf, the 4 byte offset of its type byte
+//	       from the start of the packed leaves
+//	"ZONE" optional; for each 'S' leaf, 20 bytes of zone metadata: a 4
+//	       byte pixel count, a 4 byte area in km², and 2 byte pixel
+//	       coordinates of the bounding box's minimum x and y and
+//	       maximum x and y (inclusive; min x > max x if the box
+//	       crosses the antimeridian) and of a point inside the zone.
+//	       Only allowed with degPixels of at most 182, so that the
+//	       coordinates fit
+//	"INFO" optional; the provenance of the data, as lines of a key, a
+//	       space and a value; see info.go
+//	"END " the 4 byte CRC-32 (IEEE) of the header and of every
+//	       uncompressed body byte before this section
+//
+// All integers are unsigned and big-endian. Readers skip sections with
+// unknown tags, so new sections can be added without a version change.
+//
+// An uncompressed file with a LIDX section can be looked up in place,
+// without decoding anything, which is how the compiled-in default
+// table is stored.
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+const (
+	tableMagic     = "LLTZ"
+	tableVersion   = 1
+	tableHeaderLen = 12
+
+	tableFlagGzip = 1 << 0
+
+	// maxDegPixels is the finest resolution a table file may have:
+	// tileKeys hold the positions of 8px tiles in 14 bits.
+	maxDegPixels = (1 << 14) * 8 / 360
+
+	// maxZoneDegPixels is the finest resolution a table file with a
+	// ZONE section may have: its pixel coordinates are 2 bytes.
+	maxZoneDegPixels = (1 << 16) / 360
+)
+
+// LoadTable reads a table file, such as one produced by the generator
+// from a newer boundary release. Errors caused by the file's contents
+// wrap ErrCorruptTables.
+//
+// A compressed file is decompressed once into memory; an uncompressed
+// one is looked up in place after being read.
+func LoadTable(r io.Reader) (*Table, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	t, err := parseTable(string(data), false)
+	if err != nil {
+		return nil, err
+	}
+	t.heapBytes += len(data)
+	return t, nil
+}
+
+// tableFile is the content of a table file.
+type tableFile struct {
+	degPixels int
+	nodes     string // quadtree nodes, as in the QTRE section
+	numLeaves int
+	leaves    string // packed leaves, as in the LEAF section
+	leafIdx   string // leaf offsets, as in the LIDX section; computed if empty
+	zones     string // zone metadata, as in the ZONE section, if any
+	info      string // provenance, as in the INFO section, if any
+
+	heapBytes int // bytes decompressed or built while reading the file
+}
+
+// writeTo writes f in the table file format, compressing the body if
+// compress is set.
+func (f *tableFile) writeTo(w io.Writer, compress bool) error {
+	var hdr [tableHeaderLen]byte
+	copy(hdr[:4], tableMagic)
+	binary.BigEndian.PutUint16(hdr[4:], tableVersion)
+	if compress {
+		binary.BigEndian.PutUint16(hdr[6:], tableFlagGzip)
+	}
+	binary.BigEndian.PutUint16(hdr[8:], uint16(f.degPixels))
+
+	leafIdx := f.leafIdx
+	if leafIdx == "" {
+		var err error
+		if leafIdx, err = leafOffsets(f.leaves, f.numLeaves); err != nil {
+			return err
+		}
+	}
+
+	var body, sec bytes.Buffer
+	writeSection(&body, "QTRE", []byte(f.nodes))
+	binary.Write(&sec, binary.BigEndian, uint32(f.numLeaves))
+	sec.WriteString(f.leaves)
+	writeSection(&body, "LEAF", sec.Bytes())
+	writeSection(&body, "LIDX", []byte(leafIdx))
+	if f.zones != "" {
+		if f.degPixels > maxZoneDegPixels {
+			return fmt.Errorf("ZONE section can't hold pixel coordinates at %d pixels per degree", f.degPixels)
+		}
+		writeSection(&body, "ZONE", []byte(f.zones))
+	}
+	if f.info != "" {
+		writeSection(&body, "INFO", []byte(f.info))
+	}
+
+	var sum [4]byte
+	binary.BigEndian.PutUint32(sum[:], crc32.Update(crc32.ChecksumIEEE(hdr[:]), crc32.IEEETable, body.Bytes()))
+	writeSection(&body, "END ", sum[:])
+
+	if _, err := w.Write(hdr[:]); err != nil {
+		return err
+	}
+	if !compress {
+		_, err := body.WriteTo(w)
+		return err
+	}
+	zw, err := gzip.NewWriterLevel(w, gzip.BestCompression)
+	if err != nil {
+		return err
+	}
+	if _, err := body.WriteTo(zw); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func writeSection(buf *bytes.Buffer, tag string, data []byte) {
+	buf.WriteString(tag)
+	binary.Write(buf, binary.BigEndian, uint32(len(data)))
+	buf.Write(data)
+}
+
+// leafOffsets returns the LIDX section for n packed leaves.
+func leafOffsets(leaves string, n int) (string, error) {
+	idx := make([]byte, 0, 4*n)
+	off := 0
+	for i := 0; i < n; i++ {
+		if off >= len(leaves) {
+			return "", fmt.Errorf("%w: leaf %d: truncated", ErrCorruptTables, i)
+		}
+		idx = append(idx, byte(off>>24), byte(off>>16), byte(off>>8), byte(off))
+		switch leaves[off] {
+		case leafStatic:
+			nul := strings.IndexByte(leaves[off:], 0)
+			if nul < 0 {
+				return "", fmt.Errorf("%w: leaf %d: unterminated zone name", ErrCorruptTables, i)
+			}
+			off += nul + 1
+		case leafOneBit:
+			off += 1 + 12
+		case leafPixmap:
+			off += 1 + 128
+		default:
+			return "", fmt.Errorf("%w: leaf %d: unknown leaf type %q", ErrCorruptTables, i, leaves[off])
+		}
+	}
+	if off != len(leaves) {
+		return "", fmt.Errorf("%w: %d bytes after last leaf", ErrCorruptTables, len(leaves)-off)
+	}
+	return string(idx), nil
+}
+
+// readTableFile parses the table file in data, verifying its checksum
+// unless it is trusted. The returned sections are substrings of data
+// if it is uncompressed.
+func readTableFile(data string, trusted bool) (*tableFile, error) {
+	if len(data) < tableHeaderLen || data[:4] != tableMagic {
+		return nil, fmt.Errorf("%w: not a table file", ErrCorruptTables)
+	}
+	hdr := data[:tableHeaderLen]
+	version := be16(hdr[4:])
+	if version != tableVersion {
+		return nil, fmt.Errorf("%w: unsupported format version %d", ErrCorruptTables, version)
+	}
+	flags := be16(hdr[6:])
+	f := &tableFile{degPixels: int(be16(hdr[8:]))}
+	if f.degPixels == 0 || f.degPixels > maxDegPixels {
+		return nil, fmt.Errorf("%w: degPixels %d out of range", ErrCorruptTables, f.degPixels)
+	}
+
+	body := data[tableHeaderLen:]
+	if flags&tableFlagGzip != 0 {
+		zr, err := gzip.NewReader(strings.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorruptTables, err)
+		}
+		b, err := ioutil.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorruptTables, err)
+		}
+		body = string(b)
+		f.heapBytes += len(body)
+	}
+
+	var sawLeaves bool
+	for pos := 0; ; {
+		if len(body)-pos < 8 {
+			return nil, fmt.Errorf("%w: missing END section", ErrCorruptTables)
+		}
+		tag := body[pos : pos+4]
+		n := int(be32(body[pos+4:]))
+		sec := body[pos+8:]
+		if n > len(sec) {
+			return nil, fmt.Errorf("%w: truncated %q section", ErrCorruptTables, tag)
+		}
+		sec = sec[:n]
+		switch tag {
+		case "QTRE":
+			f.nodes = sec
+		case "LEAF":
+			if len(sec) < 4 {
+				return nil, fmt.Errorf("%w: truncated LEAF section", ErrCorruptTables)
+			}
+			f.numLeaves = int(be32(sec))
+			f.leaves = sec[4:]
+			sawLeaves = true
+		case "LIDX":
+			f.leafIdx = sec
+		case "ZONE":
+			f.zones = sec
+		case "INFO":
+			f.info = sec
+		case "END ":
+			if n != 4 || !trusted && be32(sec) != updateCRC(updateCRC(0, hdr), body[:pos]) {
+				return nil, fmt.Errorf("%w: checksum mismatch", ErrCorruptTables)
+			}
+			if pos+8+n != len(body) {
+				return nil, fmt.Errorf("%w: data after END section", ErrCorruptTables)
+			}
+			if f.nodes == "" || !sawLeaves {
+				return nil, fmt.Errorf("%w: missing QTRE or LEAF section", ErrCorruptTables)
+			}
+			return f, nil
+		}
+		pos += 8 + n
+	}
+}
+
+// updateCRC returns the CRC-32 (IEEE) of s appended to crc, copying s
+// through a small buffer rather than converting it to a []byte.
+func updateCRC(crc uint32, s string) uint32 {
+	var buf [4096]byte
+	for len(s) > 0 {
+		n := copy(buf[:], s)
+		crc = crc32.Update(crc, crc32.IEEETable, buf[:n])
+		s = s[n:]
+	}
+	return crc
+}
+
+// parseTable returns the Table in the table file data. Unless the data
+// is trusted, as the compiled-in table is, it first checks that every
+// index in the file is in range so that lookups cannot fail.
+func parseTable(data string, trusted bool) (*Table, error) {
+	f, err := readTableFile(data, trusted)
+	if err != nil {
+		return nil, err
+	}
+	if f.numLeaves > int(oceanIndex) {
+		return nil, fmt.Errorf("%w: too many leaves", ErrCorruptTables)
+	}
+	if f.leafIdx == "" {
+		if f.leafIdx, err = leafOffsets(f.leaves, f.numLeaves); err != nil {
+			return nil, err
+		}
+		f.heapBytes += len(f.leafIdx)
+	}
+	t := &Table{
+		degPixels: f.degPixels,
+		nodes:     f.nodes,
+		leafIdx:   f.leafIdx,
+		leaves:    f.leaves,
+		numLeaves: f.numLeaves,
+		zones:     f.zones,
+		info:      f.info,
+		data:      data,
+		heapBytes: f.heapBytes,
+	}
+	t.gridW, t.gridH = quadtreeGrid(t.degPixels)
+
+	if len(t.leafIdx) != 4*t.numLeaves {
+		return nil, fmt.Errorf("%w: LIDX section has wrong length", ErrCorruptTables)
+	}
+	if trusted {
+		t.numStatic = 0
+		for t.numStatic < t.numLeaves && t.leaves[be32(t.leafIdx[4*t.numStatic:])] == leafStatic {
+			t.numStatic++
+		}
+		return t, nil
+	}
+
+	if err := t.checkQuadtree(); err != nil {
+		return nil, err
+	}
+
+	// Static zones come first, and the bitmaps after them may only
+	// refer to static zones, so a lookup never needs more than one
+	// indirection.
+	t.numStatic = -1
+	validIdx := func(idx uint16) bool {
+		return int(idx) < t.numStatic
+	}
+	var prevEnd uint32
+	for i := 0; i < t.numLeaves; i++ {
+		off := be32(t.leafIdx[4*i:])
+		end := uint32(len(t.leaves))
+		if i+1 < t.numLeaves {
+			end = be32(t.leafIdx[4*i+4:])
+		}
+		if off != prevEnd || end <= off || end > uint32(len(t.leaves)) {
+			return nil, fmt.Errorf("%w: leaf %d: bad offset", ErrCorruptTables, i)
+		}
+		prevEnd = end
+		typ, data := t.leaves[off], t.leaves[off+1:end]
+		if typ != leafStatic && t.numStatic < 0 {
+			t.numStatic = i
+		}
+		ok := false
+		switch typ {
+		case leafStatic:
+			ok = t.numStatic < 0 && strings.IndexByte(data, 0) == len(data)-1
+		case leafOneBit:
+			ok = len(data) == 12 && validIdx(be16(data)) && validIdx(be16(data[2:]))
+		case leafPixmap:
+			ok = len(data) == 128
+			for j := 0; ok && j < 128; j += 2 {
+				idx := be16(data[j:])
+				ok = idx == oceanIndex || validIdx(idx)
+			}
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: leaf %d: bad %q leaf", ErrCorruptTables, i, typ)
+		}
+	}
+	if t.numStatic < 0 {
+		t.numStatic = t.numLeaves
+	}
+
+	if _, err := parseInfo(t.info); err != nil {
+		return nil, err
+	}
+	if t.zones != "" {
+		if t.degPixels > maxZoneDegPixels {
+			return nil, fmt.Errorf("%w: ZONE section at %d pixels per degree", ErrCorruptTables, t.degPixels)
+		}
+		if len(t.zones) != zoneInfoLen*t.numStatic {
+			return nil, fmt.Errorf("%w: ZONE section has wrong length", ErrCorruptTables)
+		}
+		w, h := 360*t.degPixels, 180*t.degPixels
+		for i := 0; i < t.numStatic; i++ {
+			z := t.zones[zoneInfoLen*i:]
+			for j := 8; j < zoneInfoLen; j += 4 {
+				if int(be16(z[j:])) >= w || int(be16(z[j+2:])) >= h {
+					return nil, fmt.Errorf("%w: zone %d: bad coordinates", ErrCorruptTables, i)
+				}
+			}
+		}
+	}
+	return t, nil
+}
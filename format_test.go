@@ -0,0 +1,174 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// encodeTable returns the table file bytes for t.
+func encodeTable(t *Table, compress bool) []byte {
+	f := &tableFile{
+		degPixels: t.degPixels,
+		nodes:     t.nodes,
+		numLeaves: t.numLeaves,
+		leaves:    t.leaves,
+		leafIdx:   t.leafIdx,
+		zones:     t.zones,
+		info:      t.info,
+	}
+	var buf bytes.Buffer
+	if err := f.writeTo(&buf, compress); err != nil {
+		panic(err)
+	}
+	return buf.Bytes()
+}
+
+func TestLoadTableRoundTrip(t *testing.T) {
+	fix := fixtureTable()
+	for _, compress := range []bool{false, true} {
+		tb, err := LoadTable(bytes.NewReader(encodeTable(fix, compress)))
+		if err != nil {
+			t.Fatalf("compress=%v: %v", compress, err)
+		}
+		for y := 0; y < 180; y++ {
+			for x := 0; x < 360; x++ {
+				if got, want := tb.lookupPixel(x, y), fix.lookupPixel(x, y); got != want {
+					t.Fatalf("compress=%v: pixel (%d, %d) = %q; want %q", compress, x, y, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestLoadTableDefault(t *testing.T) {
+	tb, err := LoadTable(strings.NewReader(defaultTableData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tb == Default() {
+		t.Fatal("LoadTable returned the default table")
+	}
+	if got := tb.LookupZoneName(37.7833, -122.4167); got != "America/Los_Angeles" {
+		t.Errorf("LookupZoneName = %q; want America/Los_Angeles", got)
+	}
+}
+
+func TestLoadTableCorrupt(t *testing.T) {
+	good := encodeTable(fixtureTable(), false)
+	cases := []struct {
+		name   string
+		mangle func([]byte) []byte
+	}{
+		{"empty", func(b []byte) []byte { return nil }},
+		{"magic", func(b []byte) []byte { b[0] = 'X'; return b }},
+		{"version", func(b []byte) []byte { b[5] = 99; return b }},
+		{"flipped bit", func(b []byte) []byte { b[tableHeaderLen+20] ^= 1; return b }},
+		{"truncated", func(b []byte) []byte { return b[:len(b)-3] }},
+		{"trailing", func(b []byte) []byte { return append(b, 0) }},
+		{"gzip flag", func(b []byte) []byte { b[7] = tableFlagGzip; return b }},
+		{"zero degPixels", func(b []byte) []byte { b[8], b[9] = 0, 0; return b }},
+		{"degPixels too fine", func(b []byte) []byte { b[8], b[9] = 0x01, 0x6d; return b }}, // 365
+	}
+	for _, tt := range cases {
+		b := tt.mangle(append([]byte(nil), good...))
+		if _, err := LoadTable(bytes.NewReader(b)); !errors.Is(err, ErrCorruptTables) {
+			t.Errorf("%s: LoadTable error = %v; want ErrCorruptTables", tt.name, err)
+		}
+	}
+}
+
+// Files with a valid checksum but bad contents must also be rejected,
+// since lookups don't check indexes.
+func TestLoadTableBadIndexes(t *testing.T) {
+	fix := fixtureTable()
+	cases := []struct {
+		name   string
+		mangle func(f *tableFile)
+	}{
+		{"node leaf out of range", func(f *tableFile) {
+			f.nodes = "\x80\x00\x00\x09" + f.nodes[4:]
+		}},
+		{"node child before parent", func(f *tableFile) {
+			f.nodes = f.nodes[:4] + "\x00\x00\x00\x01" + f.nodes[8:]
+		}},
+		{"node child out of range", func(f *tableFile) {
+			f.nodes = f.nodes[:4] + "\x00\x01\x00\x00" + f.nodes[8:]
+		}},
+		{"node children too deep", func(f *tableFile) {
+			// A chain of first children, one level too long.
+			var b bytes.Buffer
+			b.WriteString(f.nodes[:4])
+			binary.Write(&b, binary.BigEndian, uint32(2))
+			for i := 0; i < 6; i++ {
+				binary.Write(&b, binary.BigEndian, [4]uint32{uint32(2 + 4*(i+1))})
+			}
+			f.nodes = b.String()
+		}},
+		{"missing roots", func(f *tableFile) {
+			f.nodes = f.nodes[:4]
+		}},
+		{"bitmap refers to bitmap", func(f *tableFile) {
+			f.leaves = strings.Replace(f.leaves, "2\x00\x00\x00\x01", "2\x00\x00\x00\x02", 1)
+		}},
+		{"static zone after bitmap", func(f *tableFile) {
+			f.leaves += "SFixture/Late\x00"
+			f.numLeaves++
+		}},
+		{"unknown leaf type", func(f *tableFile) {
+			f.leaves = strings.Replace(f.leaves, "2\x00", "X\x00", 1)
+		}},
+		{"INFO line without value", func(f *tableFile) {
+			f.info = "source tz_world\nbogus\n"
+		}},
+		{"INFO bad build time", func(f *tableFile) {
+			f.info = "built yesterday\n"
+		}},
+		{"INFO unterminated", func(f *tableFile) {
+			f.info = "source tz_world"
+		}},
+	}
+	for _, tt := range cases {
+		f := &tableFile{
+			degPixels: fix.degPixels,
+			nodes:     fix.nodes,
+			numLeaves: fix.numLeaves,
+			leaves:    fix.leaves,
+		}
+		tt.mangle(f)
+		var buf bytes.Buffer
+		err := f.writeTo(&buf, false)
+		if err == nil {
+			_, err = LoadTable(&buf)
+		}
+		if !errors.Is(err, ErrCorruptTables) {
+			t.Errorf("%s: error = %v; want ErrCorruptTables", tt.name, err)
+		}
+	}
+}
+
+func BenchmarkParseDefaultTable(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := parseTable(defaultTableData, true); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -21,12 +21,9 @@ package latlong
 import (
 	"bufio"
 	"bytes"
-	"compress/gzip"
-	"encoding/base64"
 	"encoding/binary"
 	"flag"
 	"fmt"
-	"go/format"
 	"hash/crc32"
 	"image"
 	"image/color"
@@ -34,7 +31,10 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"os/exec"
+	"path/filepath"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -48,7 +48,15 @@ var (
 	flagHeader     = flag.Bool("c_header", false, "Generate C header")
 	flagWriteImage = flag.Bool("write_image", false, "Write out a debug image")
 	flagWriteCsv   = flag.Bool("write_csv", false, "Write CSV with zone colours")
+	flagCompress   = flag.Bool("compress", false, "Gzip the generated table file. Smaller, but it can't be looked up in place.")
 	flagScale      = flag.Float64("scale", 32, "Scaling factor. This many pixels wide & tall per degree (e.g. scale 1 is 360 x 180). Increasingly this code assumes a scale of 32, though.")
+	flagSourceDate = flag.String("source_date", "", "Release date or version of world/tz_world.shp, recorded in the table file. Defaults to the shapefile's modification date.")
+)
+
+// The boundary data the tables are generated from; see the Makefile.
+const (
+	sourceName = "tz_world"
+	sourceURL  = "http://efele.net/maps/tz/world/tz_world.zip"
 )
 
 func saveToPNGFile(filePath string, m image.Image) {
@@ -120,7 +128,7 @@ func worldImage(t *testing.T) (im *image.RGBA, zoneOfColor map[color.RGBA]string
 
 	sr, err := shp.Open("world/tz_world.shp")
 	if err != nil {
-		t.Fatalf("Error opening world/tz_world.shp: %v; unzip it from http://efele.net/maps/tz/world/tz_world.zip", err)
+		t.Fatalf("Error opening world/tz_world.shp: %v; unzip it from %s", err, sourceURL)
 	}
 	defer sr.Close()
 
@@ -208,13 +216,65 @@ func (s *setIndexTracker) Add(v interface{}) (idx uint16, isNew bool) {
 	return idx, true
 }
 
+// zoneRegistryFile lists the stable ZoneID of every zone; see ZoneID.
+const zoneRegistryFile = "zoneids.txt"
+
+// registeredZones returns the static zones in ZoneID order: those in
+// the registry, including any no longer in zoneOfColor so that their
+// IDs aren't reused, then new zones, which are also appended to the
+// registry.
+func registeredZones(t *testing.T, zoneOfColor map[color.RGBA]string) []string {
+	f, err := os.Open(zoneRegistryFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	zones, err := readZoneRegistry(f)
+	f.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registered := map[string]bool{}
+	for _, zone := range zones {
+		registered[zone] = true
+	}
+	var added []string
+	for _, zone := range zoneOfColor {
+		if !registered[zone] {
+			added = append(added, zone)
+		}
+		delete(registered, zone)
+	}
+	if len(registered) > 0 {
+		log.Printf("%d registered zones are no longer used but keep their IDs", len(registered))
+	}
+	if len(added) == 0 {
+		return zones
+	}
+
+	sort.Strings(added)
+	f, err = os.OpenFile(zoneRegistryFile, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, zone := range added {
+		zones = append(zones, zone)
+		fmt.Fprintf(f, "%d %s\n", len(zones), zone)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	log.Printf("Registered %d new zones in %s", len(added), zoneRegistryFile)
+	return zones
+}
+
 func init() {
 	testAllPixels = testAllPixels_gen
 }
 
 func testAllPixels_gen(t *testing.T) {
-	if degPixels == -1 {
-		t.Skip("data not generated yet")
+	if err := Init(); err != nil {
+		t.Skipf("data not usable: %v", err)
 	}
 	im, zoneOfColor := worldImage(t)
 	w := im.Bounds().Max.X
@@ -234,7 +294,7 @@ func testAllPixels_gen(t *testing.T) {
 				A: 255,
 			}
 			want := zoneOfColor[c]
-			if got := lookupPixel(x, y); got != want {
+			if got := Default().lookupPixel(x, y); got != want {
 				fail++
 				if fail <= 10 {
 					t.Errorf("pixel(%d, %d) = %q; want %q", x, y, got, want)
@@ -258,14 +318,11 @@ func TestGenerate(t *testing.T) {
 		}
 	}
 
-	// The auto-generated source file (z_gen_tables.go)
-	var gen bytes.Buffer
-	gen.WriteString("// Auto-generated file. See README or Makefile.\n\npackage latlong\n\n")
-	gen.WriteString("func init() {\n")
-
-	fmt.Fprintf(&gen, "degPixels = %d\n", int(*flagScale))
+	// The auto-generated table file (z_gen_tables.bin)
+	file := &tableFile{degPixels: int(*flagScale)}
+	var levels [6]string // per zoom level, [tilekey][uint16_idx] repeated
 
-	// Source code for just the zoneLookers variables.
+	// The packed zoneLookers.
 	var zoneLookers zoneLookerWriter
 
 	// Maps from a unique key (either a string or colorTile) to
@@ -288,11 +345,7 @@ func TestGenerate(t *testing.T) {
 	// one of these, it'll resolve to an image tile that then
 	// resolves to one of these.
 	{
-		var zones []string
-		for _, zone := range zoneOfColor {
-			zones = append(zones, zone)
-		}
-		sort.Strings(zones)
+		zones := registeredZones(t, zoneOfColor)
 		for i, zone := range zones {
 			idx, _ := zoneIndex.Add(zone)
 			if idx != uint16(i) {
@@ -309,9 +362,7 @@ func TestGenerate(t *testing.T) {
 	}
 	dupColorTiles := 0
 
-	gen.WriteString("zoomLevels = [6]*zoomLevel{\n")
 	for _, sizeShift := range []uint8{5, 4, 3, 2, 1, 0} {
-		fmt.Fprintf(&gen, "\t%d: &zoomLevel{\n", sizeShift)
 		var keyIdxBuf bytes.Buffer // repeated binary [tilekey][uint16_idx]
 
 		pass := newSizePass(im, imo, sizeShift)
@@ -362,17 +413,9 @@ func TestGenerate(t *testing.T) {
 		})
 		log.Printf("For size %d, skipped %d, dist: %+v", pass.size, skipSquares, sizeCount)
 
-		var zbuf bytes.Buffer
-		zw := gzip.NewWriter(&zbuf)
-		zw.Write(keyIdxBuf.Bytes())
-		zw.Close()
-
-		log.Printf("size %d is %d entries: %d bytes (%d bytes compressed)", pass.size, keyIdxBuf.Len()/6, keyIdxBuf.Len(), zbuf.Len())
-
-		fmt.Fprintf(&gen, "\t\tgzipData: %q,\n", base64.StdEncoding.EncodeToString(zbuf.Bytes()))
-		gen.WriteString("\t},\n")
+		log.Printf("size %d is %d entries: %d bytes", pass.size, keyIdxBuf.Len()/6, keyIdxBuf.Len())
+		levels[sizeShift] = keyIdxBuf.String()
 	}
-	gen.WriteString("}\n\n")
 
 	log.Printf("Duplicate 8x8 pixmaps: %d", dupColorTiles)
 
@@ -380,19 +423,88 @@ func TestGenerate(t *testing.T) {
 		saveToPNGFile("regions.png", imo)
 	}
 
-	gen.Write(zoneLookers.Source())
-	gen.WriteString("}\n") // close init
+	file.nodes = buildQuadtree(file.degPixels, levels)
+	file.numLeaves = zoneLookers.n
+	file.leaves = zoneLookers.unbuf.String()
+	log.Printf("quadtree is %d nodes: %d bytes", len(file.nodes)/4, len(file.nodes))
+	checkQuadtreeMatchesTiles(t, file, levels)
 
-	fmt, err := format.Source(gen.Bytes())
+	var out bytes.Buffer
+	if err := file.writeTo(&out, false); err != nil {
+		t.Fatal(err)
+	}
+	tb, err := parseTable(out.String(), false)
 	if err != nil {
-		ioutil.WriteFile("z_gen_tables.go", gen.Bytes(), 0644)
+		t.Fatalf("generated table doesn't load: %v", err)
+	}
+	if file.zones, err = zoneInfoSection(tb); err != nil {
+		log.Printf("Leaving out zone metadata: %v", err)
+	}
+	file.info = infoSection(generatedInfo(t))
+
+	out.Reset()
+	if err := file.writeTo(&out, *flagCompress); err != nil {
 		t.Fatal(err)
 	}
-	if err := ioutil.WriteFile("z_gen_tables.go", fmt, 0644); err != nil {
+	log.Printf("z_gen_tables.bin = %d bytes", out.Len())
+	if tb, err = LoadTable(bytes.NewReader(out.Bytes())); err != nil {
+		t.Fatalf("generated table doesn't load: %v", err)
+	}
+	log.Printf("Stats: %+v", tb.Stats())
+	if err := ioutil.WriteFile("z_gen_tables.bin", out.Bytes(), 0644); err != nil {
 		t.Fatal(err)
 	}
 }
 
+// generatedInfo returns the provenance to record in the tables.
+func generatedInfo(t *testing.T) DataInfo {
+	di := DataInfo{
+		Source:     sourceName,
+		SourceURL:  sourceURL,
+		SourceDate: *flagSourceDate,
+		TZData:     tzdataVersion(),
+		Built:      time.Now().UTC().Truncate(time.Second),
+	}
+	if di.SourceDate == "" {
+		fi, err := os.Stat("world/tz_world.shp")
+		if err != nil {
+			t.Fatal(err)
+		}
+		di.SourceDate = fi.ModTime().UTC().Format("2006-01-02")
+	}
+	if out, err := exec.Command("git", "describe", "--always", "--dirty").Output(); err == nil {
+		di.Generator = strings.TrimSpace(string(out))
+	}
+	log.Printf("Data info: %+v", di)
+	return di
+}
+
+// tzdataVersion returns the version of the tzdata that
+// time.LoadLocation reads, or "" if it can't tell.
+func tzdataVersion() string {
+	dirs := []string{"/usr/share/zoneinfo", "/usr/share/lib/zoneinfo", "/usr/lib/locale/TZ"}
+	if dir := os.Getenv("ZONEINFO"); dir != "" {
+		dirs = []string{dir}
+	}
+	for _, dir := range dirs {
+		// tzdata.zi starts with a "# version 2023c" line; +VERSION
+		// holds just the version.
+		if b, err := ioutil.ReadFile(filepath.Join(dir, "tzdata.zi")); err == nil {
+			line := string(b)
+			if i := strings.IndexByte(line, '\n'); i >= 0 {
+				line = line[:i]
+			}
+			if v := strings.TrimPrefix(line, "# version "); v != line {
+				return v
+			}
+		}
+		if b, err := ioutil.ReadFile(filepath.Join(dir, "+VERSION")); err == nil {
+			return strings.TrimSpace(string(b))
+		}
+	}
+	return ""
+}
+
 func TestHeader(t *testing.T) {
 	if !*flagHeader {
 		t.Skip("skipping generation without --c_header flag")
@@ -427,11 +539,7 @@ func TestHeader(t *testing.T) {
 	// one of these, it'll resolve to an image tile that then
 	// resolves to one of these.
 	{
-		var zones []string
-		for _, zone := range zoneOfColor {
-			zones = append(zones, zone)
-		}
-		sort.Strings(zones)
+		zones := registeredZones(t, zoneOfColor)
 		for i, zone := range zones {
 			idx, _ := zoneIndex.Add(zone)
 			if idx != uint16(i) {
@@ -810,17 +918,3 @@ func (w *zoneLookerWriter) Add(s string) {
 		panic("unexpected type")
 	}
 }
-
-func (w *zoneLookerWriter) Source() []byte {
-	var buf bytes.Buffer
-	zw := gzip.NewWriter(&buf)
-	zw.Write(w.unbuf.Bytes())
-	zw.Close()
-
-	bstr := base64.StdEncoding.EncodeToString(buf.Bytes())
-	buf.Reset()
-	fmt.Fprintf(&buf, "leaf = make([]zoneLooker, %d)\n", w.n)
-	fmt.Fprintf(&buf, "uniqueLeavesPacked = %q\n", bstr)
-	log.Printf("zone lookers packed line = %d bytes", buf.Len())
-	return buf.Bytes()
-}
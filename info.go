@@ -0,0 +1,136 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// DataInfo describes where a Table's data came from and what it
+// holds, so that an answer can be traced back to the boundary data
+// that gave it.
+type DataInfo struct {
+	// Provenance, as recorded by the generator in the table file.
+	// Fields the generator could not determine, or that tables
+	// generated before it recorded them lack, are empty.
+	Source     string    // boundary dataset, such as "tz_world"
+	SourceURL  string    // where the dataset was downloaded from
+	SourceDate string    // the dataset's release date or version
+	TZData     string    // tzdata release the zone names were checked against
+	Generator  string    // revision of the generator
+	Built      time.Time // when the table file was generated
+
+	// Version is the Table's Version.
+	Version string
+
+	// DegPixels is the resolution of the tables, in pixels per
+	// degree of latitude and longitude.
+	DegPixels int
+
+	// Tiles is the number of tiles, from 8 to 256 pixels square,
+	// that resolve to a leaf. Leaves is the number of leaves they
+	// resolve to: the Zones static zones and the 8x8 bitmaps of
+	// tiles with more than one zone.
+	Tiles, Leaves, Zones int
+}
+
+// Info returns the DataInfo of the default Table.
+func Info() DataInfo {
+	return Default().DataInfo()
+}
+
+// DataInfo returns t's DataInfo. It is the zero DataInfo if t is
+// unusable.
+func (t *Table) DataInfo() DataInfo {
+	if t.Init() != nil {
+		return DataInfo{}
+	}
+	di, _ := parseInfo(t.info) // checked when t was loaded
+	di.Version = t.Version()
+	di.DegPixels = t.degPixels
+	di.Leaves = t.numLeaves
+	di.Zones = t.numStatic
+	for i := 0; i < len(t.nodes); i += 4 {
+		if be32(t.nodes[i:])&nodeLeaf != 0 {
+			di.Tiles++
+		}
+	}
+	return di
+}
+
+// The INFO section of a table file holds a DataInfo's provenance
+// fields as lines of a key, a space and a value, in any order. Empty
+// fields are omitted and unknown keys are ignored.
+var infoKeys = []struct {
+	key   string
+	field func(*DataInfo) *string
+}{
+	{"source", func(di *DataInfo) *string { return &di.Source }},
+	{"source-url", func(di *DataInfo) *string { return &di.SourceURL }},
+	{"source-date", func(di *DataInfo) *string { return &di.SourceDate }},
+	{"tzdata", func(di *DataInfo) *string { return &di.TZData }},
+	{"generator", func(di *DataInfo) *string { return &di.Generator }},
+}
+
+// infoSection returns the INFO section recording di's provenance.
+func infoSection(di DataInfo) string {
+	var b strings.Builder
+	for _, k := range infoKeys {
+		if v := *k.field(&di); v != "" {
+			fmt.Fprintf(&b, "%s %s\n", k.key, v)
+		}
+	}
+	if !di.Built.IsZero() {
+		fmt.Fprintf(&b, "built %s\n", di.Built.UTC().Format(time.RFC3339))
+	}
+	return b.String()
+}
+
+// parseInfo parses an INFO section.
+func parseInfo(sec string) (DataInfo, error) {
+	var di DataInfo
+	if !utf8.ValidString(sec) || sec != "" && !strings.HasSuffix(sec, "\n") {
+		return di, fmt.Errorf("%w: bad INFO section", ErrCorruptTables)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(sec, "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		sp := strings.IndexByte(line, ' ')
+		if sp <= 0 {
+			return di, fmt.Errorf("%w: bad INFO line %q", ErrCorruptTables, line)
+		}
+		key, val := line[:sp], line[sp+1:]
+		if key == "built" {
+			built, err := time.Parse(time.RFC3339, val)
+			if err != nil {
+				return di, fmt.Errorf("%w: bad INFO build time %q", ErrCorruptTables, val)
+			}
+			di.Built = built
+			continue
+		}
+		for _, k := range infoKeys {
+			if k.key == key {
+				*k.field(&di) = val
+			}
+		}
+	}
+	return di, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestInfoSectionRoundTrip(t *testing.T) {
+	want := DataInfo{
+		Source:     "tz_world",
+		SourceURL:  "http://efele.net/maps/tz/world/tz_world.zip",
+		SourceDate: "2016-11-28",
+		TZData:     "2023c",
+		Generator:  "1a2b3c4-dirty",
+		Built:      time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC),
+	}
+	sec := infoSection(want)
+	got, err := parseInfo(sec)
+	if err != nil {
+		t.Fatalf("parseInfo(%q): %v", sec, err)
+	}
+	if got != want {
+		t.Errorf("parseInfo(%q) = %+v; want %+v", sec, got, want)
+	}
+
+	if sec := infoSection(DataInfo{Source: "tz_world"}); sec != "source tz_world\n" {
+		t.Errorf("infoSection with only Source = %q", sec)
+	}
+	got, err = parseInfo("future-key some value\nsource tz_world\n")
+	if err != nil || got.Source != "tz_world" {
+		t.Errorf("parseInfo with unknown key = %+v, %v", got, err)
+	}
+}
+
+func TestTableDataInfo(t *testing.T) {
+	fix := fixtureTable()
+	fix.info = infoSection(DataInfo{Source: "fixture", SourceDate: "2014-01-01"})
+	tb, err := LoadTable(bytes.NewReader(encodeTable(fix, false)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := tb.DataInfo()
+	want := DataInfo{
+		Source:     "fixture",
+		SourceDate: "2014-01-01",
+		Version:    tb.Version(),
+		DegPixels:  1,
+		Tiles:      3,
+		Leaves:     3,
+		Zones:      2,
+	}
+	if got != want {
+		t.Errorf("DataInfo = %+v; want %+v", got, want)
+	}
+
+	if got := new(Table).DataInfo(); got != (DataInfo{}) {
+		t.Errorf("zero Table DataInfo = %+v", got)
+	}
+}
+
+func TestDefaultDataInfo(t *testing.T) {
+	di := Info()
+	if di.Source != "tz_world" || di.SourceURL == "" || di.SourceDate == "" {
+		t.Errorf("default tables have no provenance: %+v", di)
+	}
+	if di.Version != Version() || di.DegPixels != 32 || di.Zones != ZoneCount() {
+		t.Errorf("Info() = %+v", di)
+	}
+	if di.Tiles == 0 || di.Leaves <= di.Zones {
+		t.Errorf("Info() counts: %d tiles, %d leaves, %d zones", di.Tiles, di.Leaves, di.Zones)
+	}
+}
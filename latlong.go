@@ -23,145 +23,80 @@ limitations under the License.
 package latlong
 
 import (
-	"bufio"
-	"compress/gzip"
-	"encoding/base64"
-	"encoding/binary"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"sort"
-	"strings"
-	"sync"
+	"errors"
+	"math"
 )
 
-// Populated by z_gen_tables.go:
 var (
-	degPixels          = -1
-	zoomLevels         [6]*zoomLevel
-	uniqueLeavesPacked string
-	leaf               []zoneLooker
+	// ErrNoTables is returned when the package was built before its
+	// data tables were generated.
+	ErrNoTables = errors.New("latlong: tables not generated yet")
+
+	// ErrCorruptTables is returned when the compiled-in tables fail
+	// to decode. Errors wrapping it describe what was wrong.
+	ErrCorruptTables = errors.New("latlong: corrupt tables")
+
+	// ErrInvalidCoordinate is returned for a latitude or longitude
+	// that is NaN, infinite or out of range.
+	ErrInvalidCoordinate = errors.New("latlong: invalid coordinate")
 )
 
+// Init reports whether the default tables are usable. Servers can call
+// it at startup or from a health check. It is cheap and safe to call
+// more than once.
+func Init() error {
+	return Default().Init()
+}
+
 // LookupZoneName returns the timezone name at the given latitude and
 // longitude. The returned name is either the empty string (if not
 // found) or a name suitable for passing to time.LoadLocation. For
 // example, "America/New_York".
+//
+// Longitudes outside [-180, 180] are wrapped around the antimeridian,
+// so 190 is the same as -170. LookupZoneName returns the empty string
+// for an invalid coordinate (see Normalize) or if the tables are
+// unusable; use LookupZoneNameErr to tell those cases apart.
 func LookupZoneName(lat, long float64) string {
-	x := int((long + 180) * float64(degPixels))
-	y := int((90 - lat) * float64(degPixels))
-	if x < 0 {
-		x = 0
-	} else if x >= 360*degPixels {
-		x = 360*degPixels - 1
-	}
-	if y < 0 {
-		y = 0
-	} else if y >= 180*degPixels {
-		y = 180*degPixels - 1
-	}
-	return lookupPixel(x, y)
+	return Default().LookupZoneName(lat, long)
 }
 
-func lookupPixel(x, y int) string {
-	if degPixels == -1 {
-		return "tables not generated yet"
-	}
-	unpackOnce.Do(unpackTables)
-
-	for level := 5; level >= 0; level-- {
-		shift := 3 + uint8(level)
-		xt := uint16(x >> shift)
-		yt := uint16(y >> shift)
-		tk := newTileKey(uint8(level), xt, yt)
-		zone, ok := zoomLevels[level].LookupZone(x, y, tk)
-		if ok {
-			return zone
-		}
-	}
-	return ""
+// LookupZoneNameErr is like LookupZoneName but returns an error if the
+// tables are unusable or the coordinate is invalid. A valid coordinate
+// with no time zone, such as in the ocean, returns "" and a nil error.
+func LookupZoneNameErr(lat, long float64) (string, error) {
+	return Default().LookupZoneNameErr(lat, long)
 }
 
-var unpackOnce sync.Once
-
-func unpackTables() {
-	for _, zl := range zoomLevels {
-		zr, err := gzip.NewReader(
-			base64.NewDecoder(base64.StdEncoding,
-				strings.NewReader(zl.gzipData)))
-		check(err)
-		slurp, err := ioutil.ReadAll(zr)
-		check(err)
-		if len(slurp)%6 != 0 {
-			panic("bogus encoded tileLooker length")
-		}
-		zl.tiles = make([]tileLooker, len(slurp)/6)
-		for i := range zl.tiles {
-			idx := i * 6
-			zl.tiles[i] = tileLooker{
-				tileKey(binary.BigEndian.Uint32(slurp[idx : idx+4])),
-				binary.BigEndian.Uint16(slurp[idx+4 : idx+6]),
-			}
-		}
-	}
-
-	zr, err := gzip.NewReader(
-		base64.NewDecoder(base64.StdEncoding,
-			strings.NewReader(uniqueLeavesPacked)))
-	check(err)
-	br := bufio.NewReader(zr)
-	var buf [128]byte
-	for i := range leaf {
-		t, err := br.ReadByte()
-		check(err)
-		switch t {
-		default:
-			panic("unknown leaf type: " + fmt.Sprintf("%q", t))
-		case 'S': // static zone
-			v, err := br.ReadBytes(0) // null-terminated
-			check(err)
-			leaf[i] = staticZone(string(v[:len(v)-1]))
-		case '2': // two-timezone 1bpp bitmap (pass.bitmapPixmapBytes)
-			_, err := io.ReadFull(br, buf[:12])
-			check(err)
-			t := oneBitTile{
-				idx: [2]uint16{
-					binary.BigEndian.Uint16(buf[0:2]),
-					binary.BigEndian.Uint16(buf[2:4]),
-				},
-			}
-			bits := binary.BigEndian.Uint64(buf[4:12])
-			for y := range t.rows {
-				for x := 0; x < 8; x++ {
-					if bits&(1<<uint(y*8+x)) != 0 {
-						t.rows[y] |= (1 << uint(x))
-					}
-				}
-			}
-			leaf[i] = t
-		case 'P': // multi-timezone 4bpp bitmap
-			_, err := io.ReadFull(br, buf[:128])
-			check(err)
-			leaf[i] = pixmap(buf[:128])
-		}
-	}
-
+// LookupZoneNameStrict is like LookupZoneNameErr but does not wrap
+// longitudes: anything outside [-180, 180] is rejected with
+// ErrInvalidCoordinate. It suits callers who would rather catch
+// swapped or mis-scaled coordinates than resolve them.
+func LookupZoneNameStrict(lat, long float64) (string, error) {
+	return Default().LookupZoneNameStrict(lat, long)
 }
 
-func check(err error) {
-	if err != nil {
-		panic(err)
+// Normalize validates a coordinate and wraps its longitude into
+// [-180, 180). It returns ErrInvalidCoordinate if either value is NaN
+// or infinite, or if the latitude is outside [-90, 90].
+func Normalize(lat, long float64) (nlat, nlong float64, err error) {
+	if math.IsNaN(lat) || math.IsNaN(long) || math.IsInf(lat, 0) || math.IsInf(long, 0) {
+		return 0, 0, ErrInvalidCoordinate
 	}
-}
-
-type zoneLooker interface {
-	LookupZone(x, y int, tk tileKey) (zone string, ok bool)
-}
-
-type staticZone string
-
-func (z staticZone) LookupZone(x, y int, tk tileKey) (zone string, ok bool) {
-	return string(z), true
+	if lat < -90 || lat > 90 {
+		return 0, 0, ErrInvalidCoordinate
+	}
+	if long < -180 || long >= 180 {
+		long = math.Mod(long+180, 360)
+		if long < 0 {
+			long += 360
+		}
+		long -= 180
+		if long == 180 { // long+180 was just below zero and rounded up
+			long = -180
+		}
+	}
+	return lat, long, nil
 }
 
 // A tilekey is a packed 32 bit integer where:
@@ -192,62 +127,51 @@ func (v tileKey) y() uint16 {
 	return uint16((v >> 14) & (1<<14 - 1))
 }
 
-type tileLooker struct {
-	tile tileKey
-	idx  uint16 // index into leaf
-}
-
-type zoomLevel struct {
-	gzipData string       // compressed [tilekey][uint16_idx], repeated
-	tiles    []tileLooker // lazily populated
-}
-
-func (zl *zoomLevel) LookupZone(x, y int, tk tileKey) (zone string, ok bool) {
-	pos := sort.Search(len(zl.tiles), func(i int) bool {
-		return zl.tiles[i].tile >= tk
-	})
-	if pos >= len(zl.tiles) {
-		return
-	}
-	tl := zl.tiles[pos]
-	if tl.tile != tk {
-		return
-	}
-	return leaf[tl.idx].LookupZone(x, y, tk)
-}
+// The leaves a tile can resolve to. Leaf indexes are uint16s, and
+// the static zones always come first, so bitmaps refer only to them.
+const (
+	leafStatic = 'S' // a single zone; data is its name
+	leafOneBit = '2' // two-zone 8x8 bitmap; data is a oneBitTile
+	leafPixmap = 'P' // multi-zone 8x8 bitmap; data is a pixmap
+)
 
 // A oneBitTile represents a fully opaque 8x8 grid tile that only has
-// two colors. The idx represents the indexes of the two colors (the palette)
-// table, and the rows are the bits.
-type oneBitTile struct {
-	idx  [2]uint16 // bit 0 and bit 1's index into []leaf
-	rows [8]uint8  // [y], then 1<<x.
-}
-
-func (t oneBitTile) LookupZone(x, y int, tk tileKey) (zone string, ok bool) {
-	idx := t.idx[0]
-	if t.rows[y&7]&(1<<(uint(x&7))) != 0 {
-		idx = t.idx[1]
+// two colors. It is 12 bytes long: the big endian uint16 indexes of
+// the two colors (the palette), then a big endian uint64 of bits, bit
+// y*8+x set for the second color.
+type oneBitTile string
+
+func (b oneBitTile) zone(x, y int) uint16 {
+	bits := be64(string(b[4:]))
+	if bits&(1<<uint((y&7)*8+(x&7))) != 0 {
+		return be16(string(b[2:]))
 	}
-	return leaf[idx].LookupZone(x, y, tk)
+	return be16(string(b))
 }
 
-// pixmap packs 8x8 row-order big ending uint16 indexes into
-// zoneLookers. Each string is 128 bytes long.
+// pixmap packs 8x8 row-order big endian uint16 leaf indexes. Each
+// string is 128 bytes long.
 type pixmap string
 
-func (p pixmap) LookupZone(x, y int, tk tileKey) (zone string, ok bool) {
+func (p pixmap) zone(x, y int) uint16 {
 	xx := x & 7
 	yy := y & 7
 	i := 2 * (yy*8 + xx)
-	idx := uint16(p[i])<<8 + uint16(p[i+1])
-	if idx == oceanIndex {
-		return "", true
-	}
-	return leaf[idx].LookupZone(x, y, tk)
+	return uint16(p[i])<<8 + uint16(p[i+1])
 }
 
-// The oceanIndex is a magic index into zoneLooker which says that
-// it's invalid and there's an ocean or something there. Unknown
-// timezone.
+// The oceanIndex is a magic leaf index which says that it's invalid
+// and there's an ocean or something there. Unknown timezone.
 const oceanIndex uint16 = 0xffff
+
+func be16(s string) uint16 {
+	return uint16(s[0])<<8 | uint16(s[1])
+}
+
+func be32(s string) uint32 {
+	return uint32(s[0])<<24 | uint32(s[1])<<16 | uint32(s[2])<<8 | uint32(s[3])
+}
+
+func be64(s string) uint64 {
+	return uint64(be32(s))<<32 | uint64(be32(s[4:]))
+}
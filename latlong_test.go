@@ -16,7 +16,10 @@ limitations under the License.
 
 package latlong
 
-import "testing"
+import (
+	"math"
+	"testing"
+)
 
 func TestLookupLatLong(t *testing.T) {
 	cases := []struct {
@@ -72,9 +75,9 @@ func TestLookupPixel(t *testing.T) {
 		// Little solid tile:
 		{2924, 2316, "America/Belize"},
 	}
-	scale := int(*flagScale)
+	scale := Default().degPixels
 	for _, tt := range cases {
-		if got := lookupPixel(tt.x*scale/32, tt.y*scale/32); got != tt.want {
+		if got := Default().lookupPixel(tt.x*scale/32, tt.y*scale/32); got != tt.want {
 			t.Errorf("lookupPixel(%v, %v) = %q; want %q", tt.x, tt.y, got, tt.want)
 		}
 	}
@@ -106,3 +109,100 @@ func TestNewTileKey(t *testing.T) {
 		}
 	}
 }
+
+func TestLookupZoneNameErr(t *testing.T) {
+	if err := Init(); err != nil {
+		t.Fatalf("Init = %v", err)
+	}
+	nan := math.NaN()
+	inf := math.Inf(1)
+	cases := []struct {
+		lat, long float64
+		want      string
+		wantErr   error
+	}{
+		{37.7833, -122.4167, "America/Los_Angeles", nil},
+		{27.5, -55, "", nil},
+		{nan, 0, "", ErrInvalidCoordinate},
+		{0, nan, "", ErrInvalidCoordinate},
+		{inf, 0, "", ErrInvalidCoordinate},
+		{0, -inf, "", ErrInvalidCoordinate},
+		{91, 0, "", ErrInvalidCoordinate},
+		{-90.5, 0, "", ErrInvalidCoordinate},
+		{37.7833, -122.4167 + 360, "America/Los_Angeles", nil},
+	}
+	for _, tt := range cases {
+		got, err := LookupZoneNameErr(tt.lat, tt.long)
+		if got != tt.want || err != tt.wantErr {
+			t.Errorf("LookupZoneNameErr(%v, %v) = %q, %v; want %q, %v", tt.lat, tt.long, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestLookupZoneNameStrict(t *testing.T) {
+	if _, err := LookupZoneNameStrict(66, 190); err != ErrInvalidCoordinate {
+		t.Errorf("LookupZoneNameStrict(66, 190) error = %v; want ErrInvalidCoordinate", err)
+	}
+	if got, err := LookupZoneNameStrict(66, -170); got != "Asia/Anadyr" || err != nil {
+		t.Errorf("LookupZoneNameStrict(66, -170) = %q, %v; want Asia/Anadyr", got, err)
+	}
+	if got, err := LookupZoneNameStrict(-20, 180); got != "Pacific/Fiji" || err != nil {
+		t.Errorf("LookupZoneNameStrict(-20, 180) = %q, %v; want Pacific/Fiji", got, err)
+	}
+}
+
+// Date line locations, given both in [-180, 180] and wrapped by a
+// whole turn, must resolve the same.
+func TestLookupZoneNameDateLine(t *testing.T) {
+	cases := []struct {
+		lat, long float64
+		want      string
+	}{
+		// Kiribati spans the antimeridian.
+		{1.33, 172.98, "Pacific/Tarawa"},
+		{1.87, -157.4, "Pacific/Kiritimati"},
+		{-2.8, -171.7, "Pacific/Enderbury"},
+		// Fiji: Suva and Taveuni, either side of 180.
+		{-18.14, 178.44, "Pacific/Fiji"},
+		{-16.8, 179.95, "Pacific/Fiji"},
+		{-16.8, -179.95, "Pacific/Fiji"},
+		// Chukotka: Anadyr and the eastern tip.
+		{64.73, 177.5, "Asia/Anadyr"},
+		{66, -170, "Asia/Anadyr"},
+	}
+	for _, tt := range cases {
+		for _, long := range []float64{tt.long, tt.long + 360, tt.long - 360, tt.long + 720} {
+			if got := LookupZoneName(tt.lat, long); got != tt.want {
+				t.Errorf("LookupZoneName(%v, %v) = %q; want %q", tt.lat, long, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	cases := []struct {
+		lat, long         float64
+		wantLat, wantLong float64
+		wantErr           error
+	}{
+		{10, 20, 10, 20, nil},
+		{10, 180, 10, -180, nil},
+		{10, -180, 10, -180, nil},
+		{10, 190, 10, -170, nil},
+		{10, -190, 10, 170, nil},
+		{10, 540, 10, -180, nil},
+		{10, -900, 10, -180, nil},
+		{10, math.Nextafter(-180, -200), 10, -180, nil}, // rounds to 180 when wrapped
+		{90, 0, 90, 0, nil},
+		{-90, 0, -90, 0, nil},
+		{90.0001, 0, 0, 0, ErrInvalidCoordinate},
+		{math.NaN(), 0, 0, 0, ErrInvalidCoordinate},
+		{0, math.Inf(-1), 0, 0, ErrInvalidCoordinate},
+	}
+	for _, tt := range cases {
+		lat, long, err := Normalize(tt.lat, tt.long)
+		if lat != tt.wantLat || long != tt.wantLong || err != tt.wantErr {
+			t.Errorf("Normalize(%v, %v) = %v, %v, %v; want %v, %v, %v", tt.lat, tt.long, lat, long, err, tt.wantLat, tt.wantLong, tt.wantErr)
+		}
+	}
+}
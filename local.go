@@ -0,0 +1,130 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import "time"
+
+// A LocalConversion is the result of converting a local wall clock
+// time, with no UTC offset, to an instant.
+type LocalConversion struct {
+	Zone string // zone name, such as "America/New_York"
+
+	// Time is the instant to use when one is needed: the only one,
+	// the earlier of two if the wall time is Ambiguous, or the wall
+	// time read with the offset from before the transition if it is
+	// Nonexistent, which moves it forward by the size of the gap (so
+	// 02:30 becomes 03:30 when clocks go forward from 02:00 to
+	// 03:00). It is in the zone's Location.
+	Time time.Time
+
+	// Ambiguous is set when the wall time happened twice, because
+	// clocks were set back over it. Earlier and Later are the two
+	// instants.
+	Ambiguous bool
+
+	// Nonexistent is set when the wall time never happened, because
+	// clocks were set forward over it. Earlier and Later are the wall
+	// time read with the offsets in effect either side of the gap.
+	Nonexistent bool
+
+	// Earlier and Later are the candidate instants. They equal Time
+	// unless the wall time is Ambiguous or Nonexistent.
+	Earlier, Later time.Time
+}
+
+// LocalToUTC interprets the date and clock fields of naive, ignoring
+// its Location, as the local time at the given latitude and longitude,
+// such as a photo's EXIF timestamp and GPS position. It reports whether
+// that wall time is ambiguous or nonexistent because of a DST change,
+// with both candidate instants. It returns the same errors as
+// LookupLocation.
+func LocalToUTC(lat, long float64, naive time.Time) (LocalConversion, error) {
+	return Default().LocalToUTC(lat, long, naive)
+}
+
+// LocalToUTC is like the package-level LocalToUTC but uses t.
+func (t *Table) LocalToUTC(lat, long float64, naive time.Time) (LocalConversion, error) {
+	loc, err := t.LookupLocation(lat, long)
+	if err != nil {
+		return LocalConversion{}, err
+	}
+	return localToUTC(loc, naive), nil
+}
+
+// UTCToLocal returns the instant at in the local time at the given
+// latitude and longitude. It returns the same errors as LookupLocation.
+func UTCToLocal(lat, long float64, at time.Time) (time.Time, error) {
+	return Default().UTCToLocal(lat, long, at)
+}
+
+// UTCToLocal is like the package-level UTCToLocal but uses t.
+func (t *Table) UTCToLocal(lat, long float64, at time.Time) (time.Time, error) {
+	loc, err := t.LookupLocation(lat, long)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return at.In(loc), nil
+}
+
+// localToUTC finds the instants in loc whose wall time is naive's.
+func localToUTC(loc *time.Location, naive time.Time) LocalConversion {
+	y, mo, d := naive.Date()
+	h, mi, s := naive.Clock()
+	wall := time.Date(y, mo, d, h, mi, s, naive.Nanosecond(), time.UTC)
+
+	// No offset is more than a day, so the offsets in effect a day
+	// either side of wall, read as UTC, cover every candidate unless
+	// loc changed offset twice within two days.
+	before := offsetAt(wall.Add(-24*time.Hour), loc)
+	after := offsetAt(wall.Add(24*time.Hour), loc)
+	c := LocalConversion{Zone: loc.String()}
+	offs := []int{before, offsetAt(wall, loc), after}
+	var found []time.Time
+	for i, off := range offs {
+		if i > 0 && (off == offs[0] || off == offs[i-1]) {
+			continue // already tried
+		}
+		if t := wall.Add(-time.Duration(off) * time.Second).In(loc); offsetAt(t, loc) == off {
+			found = append(found, t)
+		}
+	}
+	switch {
+	case len(found) == 0:
+		c.Nonexistent = true
+		c.Time = wall.Add(-time.Duration(before) * time.Second).In(loc)
+		c.Earlier = wall.Add(-time.Duration(after) * time.Second).In(loc)
+		c.Later = c.Time
+		if c.Earlier.After(c.Later) {
+			c.Earlier, c.Later = c.Later, c.Earlier
+		}
+	case len(found) == 1:
+		c.Time, c.Earlier, c.Later = found[0], found[0], found[0]
+	default:
+		c.Ambiguous = true
+		c.Earlier, c.Later = found[0], found[len(found)-1]
+		if c.Earlier.After(c.Later) {
+			c.Earlier, c.Later = c.Later, c.Earlier
+		}
+		c.Time = c.Earlier
+	}
+	return c
+}
+
+func offsetAt(t time.Time, loc *time.Location) int {
+	_, off := t.In(loc).Zone()
+	return off
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLocalToUTC(t *testing.T) {
+	const lat, long = 37.7833, -122.4167 // San Francisco
+	utc := func(s string) time.Time {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+	cases := []struct {
+		naive                  time.Time
+		ambiguous, nonexistent bool
+		time, earlier, later   time.Time
+	}{
+		{
+			naive:   time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC),
+			time:    utc("2023-07-01T19:00:00Z"),
+			earlier: utc("2023-07-01T19:00:00Z"),
+			later:   utc("2023-07-01T19:00:00Z"),
+		},
+		{
+			// The naive time's Location is ignored.
+			naive:   time.Date(2023, 1, 15, 8, 30, 0, 0, time.FixedZone("X", 5*3600)),
+			time:    utc("2023-01-15T16:30:00Z"),
+			earlier: utc("2023-01-15T16:30:00Z"),
+			later:   utc("2023-01-15T16:30:00Z"),
+		},
+		{
+			// Clocks went back from 02:00 PDT to 01:00 PST.
+			naive:     time.Date(2023, 11, 5, 1, 30, 0, 0, time.UTC),
+			ambiguous: true,
+			time:      utc("2023-11-05T08:30:00Z"),
+			earlier:   utc("2023-11-05T08:30:00Z"),
+			later:     utc("2023-11-05T09:30:00Z"),
+		},
+		{
+			// Clocks went forward from 02:00 PST to 03:00 PDT.
+			naive:       time.Date(2023, 3, 12, 2, 30, 0, 0, time.UTC),
+			nonexistent: true,
+			time:        utc("2023-03-12T10:30:00Z"),
+			earlier:     utc("2023-03-12T09:30:00Z"),
+			later:       utc("2023-03-12T10:30:00Z"),
+		},
+	}
+	for _, tt := range cases {
+		c, err := LocalToUTC(lat, long, tt.naive)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.Zone != "America/Los_Angeles" || c.Ambiguous != tt.ambiguous || c.Nonexistent != tt.nonexistent ||
+			!c.Time.Equal(tt.time) || !c.Earlier.Equal(tt.earlier) || !c.Later.Equal(tt.later) {
+			t.Errorf("LocalToUTC(%v) = %+v; want ambiguous=%v nonexistent=%v time=%v earlier=%v later=%v",
+				tt.naive, c, tt.ambiguous, tt.nonexistent, tt.time, tt.earlier, tt.later)
+		}
+		if c.Time.Location().String() != "America/Los_Angeles" {
+			t.Errorf("LocalToUTC(%v).Time is in %v", tt.naive, c.Time.Location())
+		}
+	}
+
+	if _, err := LocalToUTC(27.5, -55, time.Now()); err != ErrNoZone {
+		t.Errorf("LocalToUTC(ocean) error = %v; want ErrNoZone", err)
+	}
+}
+
+// Lord Howe Island moves its clocks by only half an hour.
+func TestLocalToUTCHalfHour(t *testing.T) {
+	c, err := LocalToUTC(-31.55, 159.08, time.Date(2023, 4, 2, 1, 45, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Zone != "Australia/Lord_Howe" {
+		t.Fatalf("zone is %q; want Australia/Lord_Howe", c.Zone)
+	}
+	if !c.Ambiguous || c.Later.Sub(c.Earlier) != 30*time.Minute {
+		t.Errorf("LocalToUTC = %+v; want ambiguous by 30 minutes", c)
+	}
+}
+
+func TestUTCToLocal(t *testing.T) {
+	in := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	got, err := UTCToLocal(52.52, 13.405, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Equal(in) || got.Hour() != 14 || got.Location().String() != "Europe/Berlin" {
+		t.Errorf("UTCToLocal = %v", got)
+	}
+	for _, tt := range []time.Time{in, time.Date(2023, 11, 5, 8, 30, 0, 0, time.UTC)} {
+		local, err := UTCToLocal(37.7833, -122.4167, tt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := LocalToUTC(37.7833, -122.4167, local)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !c.Earlier.Equal(tt) && !c.Later.Equal(tt) {
+			t.Errorf("LocalToUTC(UTCToLocal(%v)) = %+v", tt, c)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrNoZone is returned when no time zone is known at a location,
+// such as in the open ocean.
+var ErrNoZone = errors.New("latlong: no time zone at location")
+
+// locCache maps zone names to their loaded *time.Location.
+var locCache struct {
+	sync.RWMutex
+	m map[string]*time.Location
+}
+
+// LookupLocation returns the time zone at the given latitude and
+// longitude as a *time.Location. It returns ErrNoZone if there is no
+// time zone there, or any error LookupZoneNameErr would return.
+//
+// Each zone is loaded from the system's tzdata at most once and then
+// cached for the life of the process, so repeated calls are nearly as
+// cheap as LookupZoneName.
+func LookupLocation(lat, long float64) (*time.Location, error) {
+	return Default().LookupLocation(lat, long)
+}
+
+// LookupLocation is like the package-level LookupLocation but uses t.
+func (t *Table) LookupLocation(lat, long float64) (*time.Location, error) {
+	zone, err := t.LookupZoneNameErr(lat, long)
+	if err != nil {
+		return nil, err
+	}
+	if zone == "" {
+		return nil, ErrNoZone
+	}
+	return loadLocation(zone)
+}
+
+// ZoneLocation returns the *time.Location of zone id in the default
+// Table, from the same cache as LookupLocation. It returns ErrNoZone
+// for NoZone and an error wrapping ErrUnknownZone for an id the Table
+// doesn't have.
+func ZoneLocation(id ZoneID) (*time.Location, error) {
+	return Default().ZoneLocation(id)
+}
+
+// ZoneLocation is like the package-level ZoneLocation but uses t.
+func (t *Table) ZoneLocation(id ZoneID) (*time.Location, error) {
+	if id == NoZone {
+		return nil, ErrNoZone
+	}
+	zone := t.ZoneName(id)
+	if zone == "" {
+		return nil, fmt.Errorf("%w: ZoneID %d", ErrUnknownZone, uint16(id))
+	}
+	return loadLocation(zone)
+}
+
+// PreloadLocations loads and caches the *time.Location of every zone
+// known to the tables, so later calls to LookupLocation never read
+// tzdata. It returns the first load error, if any, after attempting
+// every zone.
+func PreloadLocations() error {
+	return Default().PreloadLocations()
+}
+
+// PreloadLocations is like the package-level PreloadLocations but
+// loads the zones known to t.
+func (t *Table) PreloadLocations() error {
+	if err := t.Init(); err != nil {
+		return err
+	}
+	var first error
+	for i := 0; i < t.numStatic; i++ {
+		if _, err := loadLocation(t.zoneName(uint16(i))); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+func loadLocation(zone string) (*time.Location, error) {
+	locCache.RLock()
+	loc, ok := locCache.m[zone]
+	locCache.RUnlock()
+	if ok {
+		return loc, nil
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, err
+	}
+	locCache.Lock()
+	defer locCache.Unlock()
+	if cached, ok := locCache.m[zone]; ok {
+		return cached, nil
+	}
+	if locCache.m == nil {
+		locCache.m = make(map[string]*time.Location)
+	}
+	locCache.m[zone] = loc
+	return loc, nil
+}
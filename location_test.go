@@ -0,0 +1,97 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestLookupLocation(t *testing.T) {
+	loc, err := LookupLocation(37.7833, -122.4167)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := loc.String(), "America/Los_Angeles"; got != want {
+		t.Errorf("LookupLocation = %q; want %q", got, want)
+	}
+	loc2, err := LookupLocation(34.05, -118.25)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loc != loc2 {
+		t.Errorf("second lookup of same zone returned a different *time.Location")
+	}
+
+	// Open North Atlantic; see the empty tile in TestLookupPixel.
+	if loc, err := LookupLocation(27.5, -55); err != ErrNoZone {
+		t.Errorf("LookupLocation(ocean) = %v, %v; want ErrNoZone", loc, err)
+	}
+}
+
+func TestZoneLocation(t *testing.T) {
+	id, err := ParseZoneID("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loc, err := ZoneLocation(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := LookupLocation(52.52, 13.405); loc != want {
+		t.Errorf("ZoneLocation = %v; want the cached %v", loc, want)
+	}
+	if _, err := ZoneLocation(NoZone); err != ErrNoZone {
+		t.Errorf("ZoneLocation(NoZone) error = %v; want ErrNoZone", err)
+	}
+	if _, err := ZoneLocation(ZoneID(ZoneCount() + 1)); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("ZoneLocation of an unknown id: error = %v; want ErrUnknownZone", err)
+	}
+}
+
+func TestLookupLocationConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := LookupLocation(51.5, -0.12); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestPreloadLocations(t *testing.T) {
+	if err := PreloadLocations(); err != nil {
+		t.Fatal(err)
+	}
+	locCache.RLock()
+	n := len(locCache.m)
+	locCache.RUnlock()
+	if n < 400 {
+		t.Errorf("cached %d locations after preload; want at least 400", n)
+	}
+}
+
+func BenchmarkLookupLocation(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LookupLocation(37.7833, -122.4167)
+	}
+}
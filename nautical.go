@@ -0,0 +1,72 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"fmt"
+	"math"
+)
+
+// NauticalZone returns the nautical time zone at the given longitude,
+// as used at sea: one of the 25 zones from "Etc/GMT+12" to
+// "Etc/GMT-12", each 15 degrees wide and centered on a multiple of 15
+// degrees, except for the two halves of the zone on the antimeridian.
+// East of 172.5E is "Etc/GMT-12" (UTC+12) and west of 172.5W is
+// "Etc/GMT+12" (UTC-12); note that the Etc zones' signs are the
+// reverse of their offsets. Longitudes outside [-180, 180] are
+// wrapped, and NauticalZone returns "" for one that is NaN or
+// infinite.
+func NauticalZone(long float64) string {
+	_, long, err := Normalize(0, long)
+	if err != nil {
+		return ""
+	}
+	return nauticalZones[int(math.Floor((long+7.5)/15))+12]
+}
+
+// nauticalZones are the nautical zones from 180W eastward.
+var nauticalZones = func() (zones [25]string) {
+	for i := range zones {
+		switch n := i - 12; {
+		case n == 0:
+			zones[i] = "Etc/GMT"
+		case n > 0:
+			zones[i] = fmt.Sprintf("Etc/GMT-%d", n)
+		default:
+			zones[i] = fmt.Sprintf("Etc/GMT+%d", -n)
+		}
+	}
+	return zones
+}()
+
+// LookupNautical is like LookupResult but, for a coordinate in no
+// zone, such as a ship or aircraft over the open ocean, returns the
+// nautical zone for its longitude (see NauticalZone) rather than "",
+// with Result.Nautical set so that it can be told apart from a
+// territorial zone.
+func LookupNautical(lat, long float64) (Result, error) {
+	return Default().LookupNautical(lat, long)
+}
+
+// LookupNautical is like the package-level LookupNautical but uses t.
+func (t *Table) LookupNautical(lat, long float64) (Result, error) {
+	r, err := t.LookupResult(lat, long)
+	if err == nil && r.Zone == "" {
+		r.Zone, r.Nautical = NauticalZone(long), true
+	}
+	return r, err
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNauticalZone(t *testing.T) {
+	cases := []struct {
+		long float64
+		want string
+	}{
+		{0, "Etc/GMT"},
+		{-7.4, "Etc/GMT"},
+		{7.5, "Etc/GMT-1"},
+		{-7.6, "Etc/GMT+1"},
+		{-30, "Etc/GMT+2"},
+		{100, "Etc/GMT-7"},
+		{172.4, "Etc/GMT-11"},
+		{172.5, "Etc/GMT-12"},
+		{179.99, "Etc/GMT-12"},
+		{180, "Etc/GMT+12"},
+		{-180, "Etc/GMT+12"},
+		{-172.6, "Etc/GMT+12"},
+		{-172.5, "Etc/GMT+11"},
+		{190, "Etc/GMT+11"}, // wrapped to -170
+		{math.NaN(), ""},
+		{math.Inf(-1), ""},
+	}
+	for _, tt := range cases {
+		if got := NauticalZone(tt.long); got != tt.want {
+			t.Errorf("NauticalZone(%v) = %q; want %q", tt.long, got, tt.want)
+		}
+	}
+
+	// Every nautical zone is loadable, with the offset its longitude
+	// implies.
+	for long := -180.0; long < 180; long += 7.5 {
+		zone := NauticalZone(long)
+		loc, err := time.LoadLocation(zone)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, off := time.Date(2023, 7, 1, 0, 0, 0, 0, loc).Zone()
+		if want := math.Floor((long+7.5)/15) * 3600; float64(off) != want {
+			t.Errorf("%s (at %v) has offset %d; want %v", zone, long, off, want)
+		}
+	}
+}
+
+func TestLookupNautical(t *testing.T) {
+	r, err := LookupNautical(0, -30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Zone != "Etc/GMT+2" || !r.Nautical || r.ID != NoZone {
+		t.Errorf("LookupNautical mid-Atlantic = %+v", r)
+	}
+
+	r, err = LookupNautical(37.7833, -122.4167)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Zone != "America/Los_Angeles" || r.Nautical {
+		t.Errorf("LookupNautical on land = %+v", r)
+	}
+
+	if _, err := LookupNautical(0, math.NaN()); err != ErrInvalidCoordinate {
+		t.Errorf("LookupNautical(0, NaN) error = %v", err)
+	}
+	if r, err := LookupResult(0, -30); err != nil || r.Zone != "" || r.Nautical {
+		t.Errorf("LookupResult mid-Atlantic = %+v, %v; want no zone", r, err)
+	}
+}
+
+func TestBatchNautical(t *testing.T) {
+	points := []Point{{0, -30}, {37.7833, -122.4167}, {10, -175}, {math.NaN(), 0}}
+	out := make([]string, len(points))
+	(&Batch{Nautical: true}).ZoneNames(points, out)
+	want := []string{"Etc/GMT+2", "America/Los_Angeles", "Etc/GMT+12", ""}
+	for i := range want {
+		if out[i] != want[i] {
+			t.Errorf("ZoneNames[%d] = %q; want %q", i, out[i], want[i])
+		}
+	}
+	new(Batch).ZoneNames(points, out)
+	if out[0] != "" {
+		t.Errorf("without Nautical, ZoneNames[0] = %q", out[0])
+	}
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import "math"
+
+// NearestZone returns the zone at the given latitude and longitude or,
+// if there is none there, the zone nearest to it within maxMeters and
+// the distance to it in meters. It is for points that LookupZoneName
+// misses because the tables drew the coast, a pier or a small island
+// a pixel away: boats in harbors, say, or GPS fixes on a beach.
+//
+// The distance is to the nearest edge of the nearest pixel in a zone,
+// on a spherical Earth, so it is only as accurate as the tables. If
+// no zone is within maxMeters, NearestZone returns "" and a nil
+// error. It returns the errors LookupZoneNameErr would, and
+// ErrInvalidRadius for a maxMeters that is NaN, infinite or negative.
+func NearestZone(lat, long, maxMeters float64) (zone string, meters float64, err error) {
+	return Default().NearestZone(lat, long, maxMeters)
+}
+
+// NearestZone is like the package-level NearestZone but uses t.
+func (t *Table) NearestZone(lat, long, maxMeters float64) (zone string, meters float64, err error) {
+	lat, long, err = Normalize(lat, long)
+	if err != nil {
+		return "", 0, err
+	}
+	if math.IsNaN(maxMeters) || math.IsInf(maxMeters, 0) || maxMeters < 0 {
+		return "", 0, ErrInvalidRadius
+	}
+	if err := t.Init(); err != nil {
+		return "", 0, err
+	}
+	x, y := t.latLongPixel(lat, long)
+	if z := t.pixelZone(x, y); z != oceanIndex {
+		return t.zoneName(z), 0, nil
+	}
+
+	// Search the longitudes the circle of radius maxMeters spans,
+	// all of them if it contains a pole, row by row outward from the
+	// coordinate's row, until the rows are farther away in latitude
+	// alone than the nearest zone found so far.
+	dp := float64(t.degPixels)
+	angle := maxMeters / earthRadius
+	p0, p1 := 0, 360*t.degPixels
+	if phi := lat * math.Pi / 180; angle < math.Pi/2-math.Abs(phi) {
+		dLong := math.Asin(math.Sin(angle)/math.Cos(phi)) * 180 / math.Pi
+		p0, p1 = int(math.Floor((long-dLong+180)*dp)), int(math.Floor((long+dLong+180)*dp))+1
+	}
+	best, nearest := maxMeters, oceanIndex
+	searchRow := func(yy int) bool {
+		if yy < 0 || yy >= 180*t.degPixels {
+			return false
+		}
+		north := 90 - float64(yy)/dp
+		south := north - 1/dp
+		rowLat := math.Max(south, math.Min(north, lat))
+		if d := math.Abs(rowLat-lat) * math.Pi / 180 * earthRadius; d > best {
+			return false
+		}
+		t.spanRuns(yy, p0, p1, func(p, run int, zone uint16) {
+			if zone == oceanIndex {
+				return
+			}
+			west := float64(p)/dp - 180
+			east := float64(p+run)/dp - 180
+			if d := distance(lat, long, rowLat, math.Max(west, math.Min(east, long))); d <= best {
+				best, nearest = d, zone
+			}
+		})
+		return true
+	}
+	searchRow(y)
+	for d := 1; ; d++ {
+		north, south := searchRow(y-d), searchRow(y+d)
+		if !north && !south {
+			break
+		}
+	}
+	if nearest == oceanIndex {
+		return "", 0, nil
+	}
+	return t.zoneName(nearest), best, nil
+}
+
+// distance returns the great-circle distance in meters between two
+// points, by the haversine formula.
+func distance(lat1, long1, lat2, long2 float64) float64 {
+	const rad = math.Pi / 180
+	sinLat := math.Sin((lat2 - lat1) * rad / 2)
+	sinLong := math.Sin((long2 - long1) * rad / 2)
+	a := sinLat*sinLat + math.Cos(lat1*rad)*math.Cos(lat2*rad)*sinLong*sinLong
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestNearestZoneFixture(t *testing.T) {
+	fix := fixtureTable()
+	deg := earthRadius * math.Pi / 180 // meters per degree of latitude
+	cases := []struct {
+		name           string
+		lat, long, max float64
+		zone           string
+		meters         float64
+	}{
+		{"in a zone", 45, 0, 0, "Fixture/West", 0},
+		{"east of West", 0, 80, 500e3, "Fixture/West", 4 * deg},
+		{"too far", 0, 80, 400e3, "", 0},
+		{"west of East", 6, 130, 2000e3, "Fixture/East", distance(6, 130, 6, 140)},
+		{"south of East", -3, 144, 1000e3, "Fixture/East", 5 * deg},
+		{"across the antimeridian", 0, 179, 200e3, "Fixture/West", deg},
+		{"over the pole", 89.5, 100, 200e3, "Fixture/West", distance(89.5, 100, 89.5, 76)},
+	}
+	for _, tt := range cases {
+		zone, meters, err := fix.NearestZone(tt.lat, tt.long, tt.max)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if zone != tt.zone || math.Abs(meters-tt.meters) > 1e-6 {
+			t.Errorf("%s: NearestZone(%v, %v, %v) = %q, %v; want %q, %v", tt.name, tt.lat, tt.long, tt.max, zone, meters, tt.zone, tt.meters)
+		}
+	}
+}
+
+// TestNearestZoneExhaustive checks NearestZone against a search of
+// every pixel near ocean points off the world's coasts.
+func TestNearestZoneExhaustive(t *testing.T) {
+	tb := Default()
+	dp := float64(tb.degPixels)
+	w := 360 * tb.degPixels
+	const max = 150e3
+	rnd := rand.New(rand.NewSource(1))
+	found := 0
+	for n := 0; n < 100; {
+		lat, long := rnd.Float64()*140-70, rnd.Float64()*360-180
+		if LookupZoneName(lat, long) != "" {
+			continue
+		}
+		n++
+		zone, meters, err := NearestZone(lat, long, max)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		best, want := max, ""
+		y0, y1 := int((90-lat-2)*dp), int((90-lat+2)*dp)
+		p0, p1 := int((long+180-6)*dp), int((long+180+6)*dp)
+		for y := y0; y <= y1; y++ {
+			north := 90 - float64(y)/dp
+			rowLat := math.Max(north-1/dp, math.Min(north, lat))
+			for p := p0; p <= p1; p++ {
+				z := tb.pixelZone((p%w+w)%w, y)
+				if z == oceanIndex {
+					continue
+				}
+				west := float64(p)/dp - 180
+				if d := distance(lat, long, rowLat, math.Max(west, math.Min(west+1/dp, long))); d <= best {
+					best, want = d, tb.zoneName(z)
+				}
+			}
+		}
+		if want == "" {
+			best = 0
+		} else {
+			found++
+		}
+		if math.Abs(meters-best) > 1e-6 || (zone == "") != (want == "") {
+			t.Errorf("NearestZone(%v, %v) = %q, %v; exhaustive search found %q, %v", lat, long, zone, meters, want, best)
+		}
+	}
+	if found < 5 {
+		t.Errorf("only %d points near a coast", found)
+	}
+}
+
+func TestNearestZoneErrors(t *testing.T) {
+	for _, max := range []float64{-1, math.NaN(), math.Inf(1)} {
+		if _, _, err := NearestZone(0, -30, max); err != ErrInvalidRadius {
+			t.Errorf("NearestZone max %v error = %v; want ErrInvalidRadius", max, err)
+		}
+	}
+	if _, _, err := NearestZone(0, math.NaN(), 10); err != ErrInvalidCoordinate {
+		t.Errorf("NearestZone(0, NaN) error = %v", err)
+	}
+	if _, _, err := new(Table).NearestZone(0, 0, 10); err != ErrNoTables {
+		t.Errorf("NearestZone on zero Table error = %v", err)
+	}
+}
+
+func BenchmarkNearestZone(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NearestZone(0, -30, 500e3)
+	}
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import "time"
+
+// An Offset describes the local time at a place and instant.
+type Offset struct {
+	Zone    string // zone name, such as "Europe/Berlin"
+	Seconds int    // offset east of UTC, such as 7200
+	Abbrev  string // abbreviation, such as "CEST"
+	DST     bool   // whether daylight saving time is in effect
+}
+
+// LookupOffset returns the UTC offset in effect at the given latitude
+// and longitude at the instant at, with the zone's abbreviation and DST
+// status then. It returns the same errors as LookupLocation.
+func LookupOffset(lat, long float64, at time.Time) (Offset, error) {
+	return Default().LookupOffset(lat, long, at)
+}
+
+// LookupOffset is like the package-level LookupOffset but uses t.
+func (t *Table) LookupOffset(lat, long float64, at time.Time) (Offset, error) {
+	loc, err := t.LookupLocation(lat, long)
+	if err != nil {
+		return Offset{}, err
+	}
+	return offsetIn(loc, at), nil
+}
+
+// ZoneOffset is like LookupOffset but for zone id in the default Table
+// rather than a position. It returns the same errors as ZoneLocation.
+func ZoneOffset(id ZoneID, at time.Time) (Offset, error) {
+	return Default().ZoneOffset(id, at)
+}
+
+// ZoneOffset is like the package-level ZoneOffset but uses t.
+func (t *Table) ZoneOffset(id ZoneID, at time.Time) (Offset, error) {
+	loc, err := t.ZoneLocation(id)
+	if err != nil {
+		return Offset{}, err
+	}
+	return offsetIn(loc, at), nil
+}
+
+// offsetIn returns the Offset of loc at instant at.
+func offsetIn(loc *time.Location, at time.Time) Offset {
+	lt := at.In(loc)
+	abbrev, secs := lt.Zone()
+	return Offset{Zone: loc.String(), Seconds: secs, Abbrev: abbrev, DST: lt.IsDST()}
+}
+
+// ObservesDST reports whether daylight saving time is in effect at the
+// given latitude and longitude at any time during year, in local
+// time. It returns the same errors as LookupLocation.
+func ObservesDST(lat, long float64, year int) (bool, error) {
+	return Default().ObservesDST(lat, long, year)
+}
+
+// ObservesDST is like the package-level ObservesDST but uses t.
+func (t *Table) ObservesDST(lat, long float64, year int) (bool, error) {
+	loc, err := t.LookupLocation(lat, long)
+	if err != nil {
+		return false, err
+	}
+	return locationObservesDST(loc, year), nil
+}
+
+// locationObservesDST reports whether loc is in DST at any time in
+// year, stepping from one of its zone transitions to the next.
+func locationObservesDST(loc *time.Location, year int) bool {
+	end := time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+	for t := time.Date(year, time.January, 1, 0, 0, 0, 0, loc); t.Before(end); {
+		if t.IsDST() {
+			return true
+		}
+		_, next := t.ZoneBounds()
+		if next.IsZero() {
+			break
+		}
+		t = next
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLookupOffset(t *testing.T) {
+	cases := []struct {
+		lat, long float64
+		t         time.Time
+		want      Offset
+	}{
+		{52.52, 13.405, time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC),
+			Offset{Zone: "Europe/Berlin", Seconds: 7200, Abbrev: "CEST", DST: true}},
+		{52.52, 13.405, time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+			Offset{Zone: "Europe/Berlin", Seconds: 3600, Abbrev: "CET"}},
+		{37.7833, -122.4167, time.Date(2023, 3, 12, 9, 59, 0, 0, time.UTC),
+			Offset{Zone: "America/Los_Angeles", Seconds: -8 * 3600, Abbrev: "PST"}},
+		{37.7833, -122.4167, time.Date(2023, 3, 12, 10, 0, 0, 0, time.UTC),
+			Offset{Zone: "America/Los_Angeles", Seconds: -7 * 3600, Abbrev: "PDT", DST: true}},
+		{35.6895, 139.6917, time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			Offset{Zone: "Asia/Tokyo", Seconds: 9 * 3600, Abbrev: "JST"}},
+	}
+	for _, tt := range cases {
+		got, err := LookupOffset(tt.lat, tt.long, tt.t)
+		if err != nil {
+			t.Errorf("LookupOffset(%v, %v, %v): %v", tt.lat, tt.long, tt.t, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("LookupOffset(%v, %v, %v) = %+v; want %+v", tt.lat, tt.long, tt.t, got, tt.want)
+		}
+		if got, err := ZoneOffset(LookupZoneID(tt.lat, tt.long), tt.t); err != nil || got != tt.want {
+			t.Errorf("ZoneOffset(%s, %v) = %+v, %v; want %+v", tt.want.Zone, tt.t, got, err, tt.want)
+		}
+	}
+	if _, err := LookupOffset(27.5, -55, time.Now()); err != ErrNoZone {
+		t.Errorf("LookupOffset(ocean) error = %v; want ErrNoZone", err)
+	}
+	if _, err := ZoneOffset(NoZone, time.Now()); err != ErrNoZone {
+		t.Errorf("ZoneOffset(NoZone) error = %v; want ErrNoZone", err)
+	}
+}
+
+func TestObservesDST(t *testing.T) {
+	cases := []struct {
+		lat, long float64
+		year      int
+		want      bool
+	}{
+		{52.52, 13.405, 2023, true},       // Berlin
+		{35.6895, 139.6917, 2023, false},  // Tokyo
+		{33.45, -112.07, 2023, false},     // Phoenix
+		{-33.87, 151.21, 2023, true},      // Sydney, DST across the new year
+		{55.75, 37.62, 2010, true},        // Moscow, before it dropped DST
+		{55.75, 37.62, 2015, false},       // Moscow, after
+		{37.7833, -122.4167, 1900, false}, // San Francisco, before DST
+	}
+	for _, tt := range cases {
+		got, err := ObservesDST(tt.lat, tt.long, tt.year)
+		if err != nil || got != tt.want {
+			t.Errorf("ObservesDST(%v, %v, %d) = %v, %v; want %v", tt.lat, tt.long, tt.year, got, err, tt.want)
+		}
+	}
+}
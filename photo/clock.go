@@ -0,0 +1,154 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package photo
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/bradfitz/latlong"
+)
+
+// ErrNoClock is returned when a photo lacks the times (and, for
+// CheckClock, the position) needed to check its camera's clock.
+var ErrNoClock = errors.New("photo: no local and GPS times to check the clock with")
+
+// ClockTolerance is how far a camera's clock can disagree with GPS time
+// and still be considered right. It allows for clock drift and for GPS
+// timestamps from a fix taken a little before the photo.
+const ClockTolerance = 2 * time.Minute
+
+// A ClockCheck compares a camera's clock with GPS time and with the
+// time zone the photo was taken in.
+type ClockCheck struct {
+	Zone string // time zone at the photo's position
+
+	// ImpliedOffset is the camera's local time minus the GPS time:
+	// the UTC offset the camera's clock was set to, plus any drift.
+	ImpliedOffset time.Duration
+
+	// ExpectedOffset is the zone's UTC offset at the GPS time.
+	ExpectedOffset time.Duration
+
+	// Correction is what to add to the camera's local time to get
+	// the zone's local time: ExpectedOffset minus ImpliedOffset.
+	Correction time.Duration
+}
+
+// OK reports whether the camera's clock was right, to within
+// ClockTolerance.
+func (c ClockCheck) OK() bool {
+	return c.Correction >= -ClockTolerance && c.Correction <= ClockTolerance
+}
+
+// CheckClock compares the camera's clock with the GPS time and the time
+// zone at the photo's position, such as to catch a camera whose owner
+// didn't change its clock after travelling. It returns ErrNoClock if
+// the photo lacks a local time, GPS time or position, or the error
+// from latlong.LookupOffset.
+func (m *Metadata) CheckClock() (ClockCheck, error) {
+	if m.Local.IsZero() || m.GPSTime.IsZero() || !m.HasGPS {
+		return ClockCheck{}, ErrNoClock
+	}
+	off, err := latlong.LookupOffset(m.Lat, m.Long, m.GPSTime)
+	if err != nil {
+		return ClockCheck{}, err
+	}
+	c := ClockCheck{
+		Zone:           off.Zone,
+		ImpliedOffset:  m.Local.Sub(m.GPSTime),
+		ExpectedOffset: time.Duration(off.Seconds) * time.Second,
+	}
+	c.Correction = c.ExpectedOffset - c.ImpliedOffset
+	return c, nil
+}
+
+// A CameraClock describes the clock of the camera that took an album
+// of photos, assuming it wasn't changed while they were taken.
+type CameraClock struct {
+	// Offset is how far the camera's clock was ahead of UTC: the UTC
+	// offset it was set to, plus any drift. It is the median of the
+	// photos' offsets.
+	Offset time.Duration
+
+	// Photos is the number of photos with both a local and a GPS
+	// time, and Agreeing is how many of them are within
+	// ClockTolerance of Offset. If few agree, the clock was probably
+	// changed part way through.
+	Photos, Agreeing int
+
+	// Skew is what to add to the camera's local times to get the
+	// local times where the photos were taken. It is the median of
+	// the Correction from CheckClock of the photos that also have a
+	// position, and is zero if none do.
+	Skew time.Duration
+
+	// Located is the number of photos CheckClock could check, and
+	// SkewAgreeing is how many of their corrections are within
+	// ClockTolerance of Skew.
+	Located, SkewAgreeing int
+}
+
+// UTC returns the UTC time of a photo taken at local time by the
+// camera's clock. It can date the album's photos that lack both a GPS
+// time and a recorded offset.
+func (c CameraClock) UTC(local time.Time) time.Time {
+	return local.Add(-c.Offset)
+}
+
+// EstimateClock works out the offset of the clock of the camera that
+// took photos from those with both a local and a GPS time, and its skew
+// from the local time of the zones the photos were taken in from those
+// that also have a position. It returns ErrNoClock if there are none
+// with both times.
+func EstimateClock(photos []*Metadata) (CameraClock, error) {
+	var offsets, corrections []time.Duration
+	for _, m := range photos {
+		if m.Local.IsZero() || m.GPSTime.IsZero() {
+			continue
+		}
+		offsets = append(offsets, m.Local.Sub(m.GPSTime))
+		if check, err := m.CheckClock(); err == nil {
+			corrections = append(corrections, check.Correction)
+		}
+	}
+	if len(offsets) == 0 {
+		return CameraClock{}, ErrNoClock
+	}
+	var c CameraClock
+	c.Offset, c.Agreeing = consensus(offsets)
+	c.Photos = len(offsets)
+	if len(corrections) > 0 {
+		c.Skew, c.SkewAgreeing = consensus(corrections)
+		c.Located = len(corrections)
+	}
+	return c, nil
+}
+
+// consensus returns the median of ds, which it sorts, and how many of
+// ds are within ClockTolerance of it.
+func consensus(ds []time.Duration) (median time.Duration, agreeing int) {
+	sort.Slice(ds, func(i, j int) bool { return ds[i] < ds[j] })
+	median = ds[(len(ds)-1)/2]
+	for _, d := range ds {
+		if d -= median; d >= -ClockTolerance && d <= ClockTolerance {
+			agreeing++
+		}
+	}
+	return median, agreeing
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package photo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCheckClock(t *testing.T) {
+	gps := time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC)
+	tokyo := func(local time.Time) *Metadata {
+		return &Metadata{Lat: 35.6895, Long: 139.6917, HasGPS: true, Local: local, GPSTime: gps}
+	}
+
+	// Set right, give or take the GPS fix being 30s old.
+	c, err := tokyo(time.Date(2023, 7, 1, 19, 0, 30, 0, time.UTC)).CheckClock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Zone != "Asia/Tokyo" || c.ExpectedOffset != 9*time.Hour || c.Correction != -30*time.Second || !c.OK() {
+		t.Errorf("right clock: %+v, OK = %v", c, c.OK())
+	}
+
+	// Still on Berlin summer time.
+	c, err = tokyo(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)).CheckClock()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.ImpliedOffset != 2*time.Hour || c.Correction != 7*time.Hour || c.OK() {
+		t.Errorf("Berlin clock: %+v, OK = %v", c, c.OK())
+	}
+
+	noGPS := &Metadata{Local: gps}
+	if _, err := noGPS.CheckClock(); err != ErrNoClock {
+		t.Errorf("CheckClock without GPS = %v; want ErrNoClock", err)
+	}
+}
+
+func TestEstimateClock(t *testing.T) {
+	base := time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC)
+	var album []*Metadata
+	for i, skew := range []time.Duration{20, 25, 30, 35, 40, 3600} {
+		gps := base.Add(time.Duration(i) * time.Hour)
+		m := &Metadata{
+			Local:   gps.Add(2*time.Hour + skew*time.Second),
+			GPSTime: gps,
+		}
+		if i != 4 { // in Tokyo, with the camera still on Berlin summer time
+			m.Lat, m.Long, m.HasGPS = 35.6895, 139.6917, true
+		}
+		album = append(album, m)
+	}
+	album = append(album, &Metadata{Local: base}) // no GPS time
+
+	c, err := EstimateClock(album)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := 2*time.Hour + 30*time.Second; c.Offset != want || c.Photos != 6 || c.Agreeing != 5 {
+		t.Errorf("EstimateClock = %+v; want Offset %v, 6 photos, 5 agreeing", c, want)
+	}
+	if want := 7*time.Hour - 30*time.Second; c.Skew != want || c.Located != 5 || c.SkewAgreeing != 4 {
+		t.Errorf("EstimateClock = %+v; want Skew %v, 5 located, 4 agreeing", c, want)
+	}
+	if got, want := c.UTC(base.Add(2*time.Hour+30*time.Second)), base; !got.Equal(want) {
+		t.Errorf("UTC = %v; want %v", got, want)
+	}
+
+	if _, err := EstimateClock(album[6:]); err != ErrNoClock {
+		t.Errorf("EstimateClock without GPS times = %v; want ErrNoClock", err)
+	}
+}
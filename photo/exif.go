@@ -0,0 +1,307 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package photo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrCorrupt is returned by Decode when metadata is malformed. Errors
+// wrapping it describe what was wrong.
+var ErrCorrupt = errors.New("photo: corrupt metadata")
+
+const (
+	xmpJPEGPrefix = "http://ns.adobe.com/xap/1.0/\x00"
+	exifPrefix    = "Exif\x00\x00"
+)
+
+// split returns the EXIF (TIFF) and XMP metadata in data, a JPEG or
+// TIFF file or an XMP packet.
+func split(data []byte) (exif, xmp []byte) {
+	switch {
+	case bytes.HasPrefix(data, []byte("\xff\xd8")):
+		return splitJPEG(data)
+	case bytes.HasPrefix(data, []byte("II*\x00")), bytes.HasPrefix(data, []byte("MM\x00*")):
+		return data, nil
+	case bytes.Contains(data, []byte("<x:xmpmeta")):
+		return nil, data
+	}
+	return nil, nil
+}
+
+// splitJPEG returns the payloads of the EXIF and XMP APP1 segments of
+// a JPEG file.
+func splitJPEG(data []byte) (exif, xmp []byte) {
+	for pos := 2; pos+4 <= len(data); {
+		if data[pos] != 0xff {
+			break
+		}
+		marker := data[pos+1]
+		switch {
+		case marker == 0xff: // fill byte
+			pos++
+			continue
+		case marker == 0x01 || marker >= 0xd0 && marker <= 0xd7:
+			pos += 2
+			continue
+		case marker == 0xd9 || marker == 0xda: // end of image, start of scan
+			return exif, xmp
+		}
+		n := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if n < 2 || pos+2+n > len(data) {
+			break
+		}
+		seg := data[pos+4 : pos+2+n]
+		if marker == 0xe1 {
+			switch {
+			case exif == nil && bytes.HasPrefix(seg, []byte(exifPrefix)):
+				exif = seg[len(exifPrefix):]
+			case xmp == nil && bytes.HasPrefix(seg, []byte(xmpJPEGPrefix)):
+				xmp = seg[len(xmpJPEGPrefix):]
+			}
+		}
+		pos += 2 + n
+	}
+	return exif, xmp
+}
+
+// TIFF tags used.
+const (
+	tagXMP        = 0x02bc
+	tagExifIFD    = 0x8769
+	tagGPSIFD     = 0x8825
+	tagDateTimeOr = 0x9003 // DateTimeOriginal
+	tagOffsetOr   = 0x9011 // OffsetTimeOriginal
+	tagSubSecOr   = 0x9291 // SubSecTimeOriginal
+
+	tagGPSLatRef  = 0x01
+	tagGPSLat     = 0x02
+	tagGPSLongRef = 0x03
+	tagGPSLong    = 0x04
+	tagGPSTime    = 0x07
+	tagGPSDate    = 0x1d
+)
+
+// A tiff is the EXIF data of a photo, which is in TIFF format.
+type tiff struct {
+	b  []byte
+	bo binary.ByteOrder
+}
+
+// An ifdEntry is a tag's value in an image file directory.
+type ifdEntry struct {
+	typ   uint16
+	count int
+	data  []byte
+}
+
+// typeSize is the size of each TIFF field type.
+var typeSize = map[uint16]int{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8, 13: 4,
+}
+
+// ifd returns the entries of the image file directory at off.
+func (t *tiff) ifd(off uint32) (map[uint16]ifdEntry, error) {
+	if int64(off)+2 > int64(len(t.b)) {
+		return nil, fmt.Errorf("%w: IFD offset %d out of range", ErrCorrupt, off)
+	}
+	n := int(t.bo.Uint16(t.b[off:]))
+	p := int(off) + 2
+	if p+12*n > len(t.b) {
+		return nil, fmt.Errorf("%w: truncated IFD", ErrCorrupt)
+	}
+	m := make(map[uint16]ifdEntry, n)
+	for i := 0; i < n; i, p = i+1, p+12 {
+		e := ifdEntry{typ: t.bo.Uint16(t.b[p+2:]), count: int(t.bo.Uint32(t.b[p+4:]))}
+		size, ok := typeSize[e.typ]
+		if !ok {
+			continue
+		}
+		if int64(e.count)*int64(size) <= 4 {
+			e.data = t.b[p+8 : p+8+e.count*size]
+		} else {
+			start := int64(t.bo.Uint32(t.b[p+8:]))
+			end := start + int64(e.count)*int64(size)
+			if end > int64(len(t.b)) {
+				continue // ignore just the bad tag
+			}
+			e.data = t.b[start:end]
+		}
+		m[t.bo.Uint16(t.b[p:])] = e
+	}
+	return m, nil
+}
+
+// ascii returns the ASCII value of e, without padding.
+func (t *tiff) ascii(e ifdEntry) string {
+	if e.typ != 2 {
+		return ""
+	}
+	s := string(e.data)
+	if i := strings.IndexByte(s, 0); i >= 0 {
+		s = s[:i]
+	}
+	return strings.TrimSpace(s)
+}
+
+// rationals returns the RATIONAL or SRATIONAL values of e, or nil if
+// any has a zero denominator.
+func (t *tiff) rationals(e ifdEntry) []float64 {
+	if e.typ != 5 && e.typ != 10 {
+		return nil
+	}
+	v := make([]float64, e.count)
+	for i := range v {
+		num, den := t.bo.Uint32(e.data[8*i:]), t.bo.Uint32(e.data[8*i+4:])
+		if den == 0 {
+			return nil
+		}
+		if e.typ == 10 {
+			v[i] = float64(int32(num)) / float64(int32(den))
+		} else {
+			v[i] = float64(num) / float64(den)
+		}
+	}
+	return v
+}
+
+// pointer returns the offset e points to, for a sub-IFD tag.
+func (t *tiff) pointer(e ifdEntry) (uint32, bool) {
+	if (e.typ != 4 && e.typ != 13) || e.count != 1 {
+		return 0, false
+	}
+	return t.bo.Uint32(e.data), true
+}
+
+// decodeTIFF sets the fields of m from EXIF data b, and then from any
+// XMP packet in it.
+func (m *Metadata) decodeTIFF(b []byte) error {
+	t := &tiff{b: b}
+	switch {
+	case bytes.HasPrefix(b, []byte("II*\x00")):
+		t.bo = binary.LittleEndian
+	case bytes.HasPrefix(b, []byte("MM\x00*")):
+		t.bo = binary.BigEndian
+	default:
+		return fmt.Errorf("%w: bad TIFF header", ErrCorrupt)
+	}
+	if len(b) < 8 {
+		return fmt.Errorf("%w: truncated TIFF header", ErrCorrupt)
+	}
+	ifd0, err := t.ifd(t.bo.Uint32(b[4:]))
+	if err != nil {
+		return err
+	}
+
+	if off, ok := t.pointer(ifd0[tagExifIFD]); ok {
+		exif, err := t.ifd(off)
+		if err != nil {
+			return err
+		}
+		if local, ok := parseExifTime(t.ascii(exif[tagDateTimeOr])); ok {
+			m.Local = local.Add(parseSubSec(t.ascii(exif[tagSubSecOr])))
+			if off, ok := parseOffset(t.ascii(exif[tagOffsetOr])); ok {
+				m.Offset, m.HasOffset = off, true
+			}
+		}
+	}
+
+	if off, ok := t.pointer(ifd0[tagGPSIFD]); ok {
+		gps, err := t.ifd(off)
+		if err != nil {
+			return err
+		}
+		lat, okLat := degrees(t.rationals(gps[tagGPSLat]), t.ascii(gps[tagGPSLatRef]), "N", "S")
+		long, okLong := degrees(t.rationals(gps[tagGPSLong]), t.ascii(gps[tagGPSLongRef]), "E", "W")
+		if okLat && okLong && lat >= -90 && lat <= 90 && long >= -180 && long <= 180 {
+			m.Lat, m.Long, m.HasGPS = lat, long, true
+		}
+		if day, err := time.Parse("2006:01:02", t.ascii(gps[tagGPSDate])); err == nil {
+			if hms := t.rationals(gps[tagGPSTime]); len(hms) == 3 {
+				secs := hms[0]*3600 + hms[1]*60 + hms[2]
+				if secs >= 0 && secs < 86400 {
+					m.GPSTime = day.Add(time.Duration(secs * float64(time.Second)))
+				}
+			}
+		}
+	}
+
+	if e, ok := ifd0[tagXMP]; ok && (e.typ == 1 || e.typ == 7) {
+		return m.decodeXMP(e.data)
+	}
+	return nil
+}
+
+// degrees returns the signed degrees of EXIF degrees, minutes and
+// seconds dms with reference ref, which is pos or neg.
+func degrees(dms []float64, ref, pos, neg string) (float64, bool) {
+	if len(dms) != 3 || (ref != pos && ref != neg) {
+		return 0, false
+	}
+	d := dms[0] + dms[1]/60 + dms[2]/3600
+	if ref == neg {
+		d = -d
+	}
+	return d, true
+}
+
+// parseExifTime parses an EXIF date and time, such as "2023:07:01
+// 12:00:00", as UTC. Unset times, such as all zeros, report false.
+func parseExifTime(s string) (time.Time, bool) {
+	t, err := time.Parse("2006:01:02 15:04:05", s)
+	return t, err == nil
+}
+
+// parseSubSec parses the digits of an EXIF SubSecTime as a fraction of
+// a second.
+func parseSubSec(s string) time.Duration {
+	if s == "" || len(s) > 9 {
+		return 0
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0
+	}
+	for i := len(s); i < 9; i++ {
+		n *= 10
+	}
+	return time.Duration(n)
+}
+
+// parseOffset parses an EXIF OffsetTime, such as "+02:00", as seconds
+// east of UTC.
+func parseOffset(s string) (int, bool) {
+	if len(s) != 6 || (s[0] != '+' && s[0] != '-') || s[3] != ':' {
+		return 0, false
+	}
+	h, err1 := strconv.Atoi(s[1:3])
+	m, err2 := strconv.Atoi(s[4:6])
+	if err1 != nil || err2 != nil || h > 14 || m > 59 {
+		return 0, false
+	}
+	off := h*3600 + m*60
+	if s[0] == '-' {
+		off = -off
+	}
+	return off, true
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package photo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// A testEntry is an IFD entry for buildTIFF.
+type testEntry struct {
+	tag, typ uint16
+	count    uint32
+	data     []byte // in the TIFF's byte order
+}
+
+func asciiEntry(tag uint16, s string) testEntry {
+	return testEntry{tag, 2, uint32(len(s) + 1), []byte(s + "\x00")}
+}
+
+func rationalEntry(bo binary.ByteOrder, tag uint16, vals ...uint32) testEntry {
+	b := make([]byte, 4*len(vals))
+	for i, v := range vals {
+		bo.PutUint32(b[4*i:], v)
+	}
+	return testEntry{tag, 5, uint32(len(vals) / 2), b}
+}
+
+// buildTIFF returns EXIF data with IFD0 entries ifd0 and, if they are
+// non-nil, Exif and GPS IFDs.
+func buildTIFF(bo binary.ByteOrder, ifd0, exif, gps []testEntry) []byte {
+	size := func(ifd []testEntry) int {
+		n := 2 + 12*len(ifd) + 4
+		for _, e := range ifd {
+			if len(e.data) > 4 {
+				n += (len(e.data) + 1) &^ 1
+			}
+		}
+		return n
+	}
+	ifd0 = append([]testEntry(nil), ifd0...)
+	ptr := func(tag uint16) *testEntry {
+		ifd0 = append(ifd0, testEntry{tag, 4, 1, make([]byte, 4)})
+		return &ifd0[len(ifd0)-1]
+	}
+	var exifPtr, gpsPtr int
+	if exif != nil {
+		ptr(tagExifIFD)
+		exifPtr = len(ifd0) - 1
+	}
+	if gps != nil {
+		ptr(tagGPSIFD)
+		gpsPtr = len(ifd0) - 1
+	}
+	off := 8 + size(ifd0)
+	if exif != nil {
+		bo.PutUint32(ifd0[exifPtr].data, uint32(off))
+		off += size(exif)
+	}
+	if gps != nil {
+		bo.PutUint32(ifd0[gpsPtr].data, uint32(off))
+	}
+
+	var buf bytes.Buffer
+	if bo == binary.ByteOrder(binary.LittleEndian) {
+		buf.WriteString("II*\x00")
+	} else {
+		buf.WriteString("MM\x00*")
+	}
+	binary.Write(&buf, bo, uint32(8))
+	for _, ifd := range [][]testEntry{ifd0, exif, gps} {
+		if ifd == nil {
+			continue
+		}
+		base := buf.Len()
+		dataOff := base + 2 + 12*len(ifd) + 4
+		var data []byte
+		binary.Write(&buf, bo, uint16(len(ifd)))
+		for _, e := range ifd {
+			binary.Write(&buf, bo, [2]uint16{e.tag, e.typ})
+			binary.Write(&buf, bo, e.count)
+			if len(e.data) <= 4 {
+				var v [4]byte
+				copy(v[:], e.data)
+				buf.Write(v[:])
+				continue
+			}
+			binary.Write(&buf, bo, uint32(dataOff+len(data)))
+			data = append(data, e.data...)
+			if len(data)%2 == 1 {
+				data = append(data, 0)
+			}
+		}
+		binary.Write(&buf, bo, uint32(0)) // no next IFD
+		buf.Write(data)
+	}
+	return buf.Bytes()
+}
+
+// buildJPEG returns a minimal JPEG file with the given APP1 segments.
+func buildJPEG(app1 ...[]byte) []byte {
+	b := []byte("\xff\xd8")
+	b = append(b, 0xff, 0xe0, 0, 4, 'J', 'F') // a stand-in APP0
+	for _, seg := range app1 {
+		b = append(b, 0xff, 0xe1, byte((len(seg)+2)>>8), byte(len(seg)+2))
+		b = append(b, seg...)
+	}
+	return append(b, "\xff\xda\x00\x02\xff\xd9"...)
+}
+
+// sanFranciscoTIFF returns EXIF data for a photo taken in San
+// Francisco with the given Exif IFD entries.
+func sanFranciscoTIFF(bo binary.ByteOrder, exif []testEntry) []byte {
+	gps := []testEntry{
+		asciiEntry(tagGPSLatRef, "N"),
+		rationalEntry(bo, tagGPSLat, 37, 1, 46, 1, 5988, 100), // 37°46'59.88"
+		asciiEntry(tagGPSLongRef, "W"),
+		rationalEntry(bo, tagGPSLong, 122, 1, 25, 1, 0, 1),
+	}
+	return buildTIFF(bo, []testEntry{asciiEntry(0x010f, "Camera Co")}, exif, gps)
+}
+
+func TestDecodeExif(t *testing.T) {
+	for _, bo := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+		exif := []testEntry{
+			asciiEntry(tagDateTimeOr, "2023:07:01 12:34:56"),
+			asciiEntry(tagSubSecOr, "25"),
+			asciiEntry(tagOffsetOr, "-07:00"),
+		}
+		tiff := sanFranciscoTIFF(bo, exif)
+		for _, file := range [][]byte{tiff, buildJPEG(append([]byte(exifPrefix), tiff...))} {
+			m, err := Decode(bytes.NewReader(file))
+			if err != nil {
+				t.Fatalf("%v: %v", bo, err)
+			}
+			if !m.HasGPS || math.Abs(m.Lat-37.7833) > 1e-4 || math.Abs(m.Long+122.4167) > 1e-4 {
+				t.Errorf("%v: position = %v, %v, %v", bo, m.HasGPS, m.Lat, m.Long)
+			}
+			if want := time.Date(2023, 7, 1, 12, 34, 56, 250e6, time.UTC); !m.Local.Equal(want) {
+				t.Errorf("%v: Local = %v; want %v", bo, m.Local, want)
+			}
+			if !m.HasOffset || m.Offset != -7*3600 {
+				t.Errorf("%v: Offset = %v, %v", bo, m.Offset, m.HasOffset)
+			}
+			if !m.GPSTime.IsZero() {
+				t.Errorf("%v: GPSTime = %v; want zero", bo, m.GPSTime)
+			}
+		}
+	}
+}
+
+func TestDecodeExifGPSTime(t *testing.T) {
+	bo := binary.BigEndian
+	gps := []testEntry{
+		asciiEntry(tagGPSLatRef, "S"),
+		rationalEntry(bo, tagGPSLat, 3387, 100, 0, 1, 0, 1),
+		asciiEntry(tagGPSLongRef, "E"),
+		rationalEntry(bo, tagGPSLong, 15121, 100, 0, 1, 0, 1),
+		asciiEntry(tagGPSDate, "2023:12:31"),
+		rationalEntry(bo, tagGPSTime, 23, 1, 59, 1, 5950, 100),
+	}
+	m, err := Decode(bytes.NewReader(buildTIFF(bo, nil, nil, gps)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Lat != -33.87 || m.Long != 151.21 {
+		t.Errorf("position = %v, %v", m.Lat, m.Long)
+	}
+	if want := time.Date(2023, 12, 31, 23, 59, 59, 500e6, time.UTC); !m.GPSTime.Equal(want) {
+		t.Errorf("GPSTime = %v; want %v", m.GPSTime, want)
+	}
+	if !m.Local.IsZero() {
+		t.Errorf("Local = %v; want zero", m.Local)
+	}
+}
+
+func TestDecodeExifUnset(t *testing.T) {
+	bo := binary.LittleEndian
+	exif := []testEntry{asciiEntry(tagDateTimeOr, "0000:00:00 00:00:00")}
+	gps := []testEntry{
+		asciiEntry(tagGPSLatRef, "N"),
+		rationalEntry(bo, tagGPSLat, 1, 0, 0, 1, 0, 1), // zero denominator
+		asciiEntry(tagGPSLongRef, "E"),
+		rationalEntry(bo, tagGPSLong, 1, 1, 0, 1, 0, 1),
+	}
+	m, err := Decode(bytes.NewReader(buildTIFF(bo, nil, exif, gps)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.HasGPS || !m.Local.IsZero() {
+		t.Errorf("Decode = %+v; want no position or time", m)
+	}
+}
+
+func TestDecodeCorrupt(t *testing.T) {
+	tiff := sanFranciscoTIFF(binary.LittleEndian, []testEntry{asciiEntry(tagDateTimeOr, "2023:07:01 12:34:56")})
+	cases := map[string][]byte{
+		"truncated":  tiff[:20],
+		"bad IFD0":   append([]byte("II*\x00\xff\xff\x00\x00"), tiff[8:]...),
+		"bad header": buildJPEG(append([]byte(exifPrefix), "XX*\x00\x08\x00\x00\x00"...)),
+	}
+	for name, b := range cases {
+		if _, err := Decode(bytes.NewReader(b)); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("%s: error = %v; want ErrCorrupt", name, err)
+		}
+	}
+	if _, err := Decode(bytes.NewReader(buildJPEG())); err != ErrNoMetadata {
+		t.Errorf("plain JPEG: error = %v; want ErrNoMetadata", err)
+	}
+}
+
+func TestParseOffset(t *testing.T) {
+	cases := map[string]int{"+00:00": 0, "+05:30": 19800, "-03:30": -12600, "+14:00": 50400}
+	for s, want := range cases {
+		if got, ok := parseOffset(s); !ok || got != want {
+			t.Errorf("parseOffset(%q) = %d, %v; want %d", s, got, ok, want)
+		}
+	}
+	for _, s := range []string{"", "   :  ", "+5:30", "05:30", "+15:00", "+05:60"} {
+		if _, ok := parseOffset(s); ok {
+			t.Errorf("parseOffset(%q) succeeded", s)
+		}
+	}
+}
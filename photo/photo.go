@@ -0,0 +1,160 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package photo reads the time and place a photo was taken from its
+// EXIF and XMP metadata, and works out when, in UTC, it was taken.
+//
+// Cameras usually record the local time on their clock but not its
+// UTC offset. If the photo also has a GPS position, the time zone
+// there, from package latlong, gives the offset.
+package photo
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"time"
+
+	"github.com/bradfitz/latlong"
+)
+
+var (
+	// ErrNoMetadata is returned by Decode for a file with neither EXIF
+	// nor XMP metadata.
+	ErrNoMetadata = errors.New("photo: no EXIF or XMP metadata")
+
+	// ErrNoTime is returned by CaptureTime when the metadata doesn't
+	// say when the photo was taken.
+	ErrNoTime = errors.New("photo: no capture time")
+)
+
+// Metadata is the time and place metadata of a photo. Where a photo
+// has both EXIF and XMP metadata, EXIF values are used first.
+type Metadata struct {
+	// Lat and Long are the GPS position, in degrees, if HasGPS.
+	Lat, Long float64
+	HasGPS    bool
+
+	// Local is the time the photo was taken, by the camera's clock:
+	// DateTimeOriginal and SubSecTimeOriginal. Only its date and clock
+	// fields are meaningful; its Location is UTC. It is zero if the
+	// time is unknown.
+	Local time.Time
+
+	// Offset is the UTC offset, in seconds east, of Local, if
+	// HasOffset, from OffsetTimeOriginal or an XMP date with a zone.
+	Offset    int
+	HasOffset bool
+
+	// GPSTime is the UTC time of the GPS fix, from GPSDateStamp and
+	// GPSTimeStamp, or zero if unknown.
+	GPSTime time.Time
+}
+
+// Decode reads the metadata of a JPEG or TIFF file, or of an XMP
+// sidecar file. It returns ErrNoMetadata if there is none.
+func Decode(r io.Reader) (*Metadata, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var m Metadata
+	exif, xmp := split(data)
+	if exif == nil && xmp == nil {
+		return nil, ErrNoMetadata
+	}
+	if exif != nil {
+		if err := m.decodeTIFF(exif); err != nil {
+			return nil, err
+		}
+	}
+	if xmp != nil {
+		if err := m.decodeXMP(xmp); err != nil {
+			return nil, err
+		}
+	}
+	return &m, nil
+}
+
+// A Source says where a CaptureTime came from.
+type Source int
+
+const (
+	// FromOffset means the photo recorded its UTC offset.
+	FromOffset Source = iota + 1
+
+	// FromGPS means the time is the photo's GPS timestamp.
+	FromGPS
+
+	// FromZone means the camera's local time was read in the time
+	// zone at the photo's GPS position.
+	FromZone
+)
+
+func (s Source) String() string {
+	switch s {
+	case FromOffset:
+		return "offset"
+	case FromGPS:
+		return "gps"
+	case FromZone:
+		return "zone"
+	}
+	return "unknown"
+}
+
+// A CaptureTime is when a photo was taken.
+type CaptureTime struct {
+	Time   time.Time // in UTC
+	Source Source
+
+	// Zone is the time zone at the photo's position, if it has one
+	// and Source is FromZone.
+	Zone string
+
+	// Ambiguous is set if Source is FromZone and the local time was
+	// repeated or skipped by a DST change, so Time may be an hour (or
+	// so) off. See latlong.LocalConversion.
+	Ambiguous bool
+}
+
+// CaptureTime returns when, in UTC, the photo was taken. It prefers a
+// recorded UTC offset, then the GPS timestamp, then the local time read
+// in the time zone at the GPS position. It returns ErrNoTime if none of
+// those are available, or the error from latlong.LocalToUTC.
+func (m *Metadata) CaptureTime() (CaptureTime, error) {
+	switch {
+	case !m.Local.IsZero() && m.HasOffset:
+		return CaptureTime{
+			Time:   m.Local.Add(-time.Duration(m.Offset) * time.Second),
+			Source: FromOffset,
+		}, nil
+	case !m.GPSTime.IsZero():
+		return CaptureTime{Time: m.GPSTime, Source: FromGPS}, nil
+	case !m.Local.IsZero() && m.HasGPS:
+		c, err := latlong.LocalToUTC(m.Lat, m.Long, m.Local)
+		if err != nil {
+			return CaptureTime{}, err
+		}
+		return CaptureTime{
+			Time:      c.Time.UTC(),
+			Source:    FromZone,
+			Zone:      c.Zone,
+			Ambiguous: c.Ambiguous || c.Nonexistent,
+		}, nil
+	}
+	return CaptureTime{}, ErrNoTime
+}
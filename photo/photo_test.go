@@ -0,0 +1,71 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package photo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCaptureTime(t *testing.T) {
+	local := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	gps := time.Date(2023, 7, 1, 18, 59, 58, 0, time.UTC)
+	sf := func(m Metadata) Metadata {
+		m.Lat, m.Long, m.HasGPS = 37.7833, -122.4167, true
+		return m
+	}
+	cases := []struct {
+		name string
+		m    Metadata
+		want CaptureTime
+	}{
+		{"offset", sf(Metadata{Local: local, Offset: -7 * 3600, HasOffset: true, GPSTime: gps}),
+			CaptureTime{Time: local.Add(7 * time.Hour), Source: FromOffset}},
+		{"gps", sf(Metadata{Local: local, GPSTime: gps}),
+			CaptureTime{Time: gps, Source: FromGPS}},
+		{"zone", sf(Metadata{Local: local}),
+			CaptureTime{Time: local.Add(7 * time.Hour), Source: FromZone, Zone: "America/Los_Angeles"}},
+		{"zone ambiguous", sf(Metadata{Local: time.Date(2023, 11, 5, 1, 30, 0, 0, time.UTC)}),
+			CaptureTime{Time: time.Date(2023, 11, 5, 8, 30, 0, 0, time.UTC), Source: FromZone, Zone: "America/Los_Angeles", Ambiguous: true}},
+	}
+	for _, tt := range cases {
+		got, err := tt.m.CaptureTime()
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !got.Time.Equal(tt.want.Time) || got.Time.Location() != time.UTC || got.Source != tt.want.Source ||
+			got.Zone != tt.want.Zone || got.Ambiguous != tt.want.Ambiguous {
+			t.Errorf("%s: CaptureTime = %+v; want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := (&Metadata{Local: local}).CaptureTime(); err != ErrNoTime {
+		t.Errorf("no position: error = %v; want ErrNoTime", err)
+	}
+	if _, err := (&Metadata{Lat: 27.5, Long: -55, HasGPS: true, Local: local}).CaptureTime(); err == nil {
+		t.Error("ocean position: no error")
+	}
+}
+
+func TestSourceString(t *testing.T) {
+	for s, want := range map[Source]string{FromOffset: "offset", FromGPS: "gps", FromZone: "zone", 0: "unknown"} {
+		if got := s.String(); got != want {
+			t.Errorf("Source(%d).String() = %q; want %q", s, got, want)
+		}
+	}
+}
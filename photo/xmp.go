@@ -0,0 +1,157 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package photo
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// XMP namespaces used.
+const (
+	nsExif      = "http://ns.adobe.com/exif/1.0/"
+	nsPhotoshop = "http://ns.adobe.com/photoshop/1.0/"
+	nsXMP       = "http://ns.adobe.com/xap/1.0/"
+)
+
+// decodeXMP sets the fields of m that are still unset from the XMP
+// packet b.
+func (m *Metadata) decodeXMP(b []byte) error {
+	props, err := xmpProperties(b)
+	if err != nil {
+		return err
+	}
+
+	if m.Local.IsZero() {
+		for _, name := range []string{nsExif + "DateTimeOriginal", nsPhotoshop + "DateCreated", nsXMP + "CreateDate"} {
+			if local, off, hasOff, ok := parseXMPDate(props[name]); ok {
+				m.Local, m.Offset, m.HasOffset = local, off, hasOff
+				break
+			}
+		}
+	}
+	if !m.HasGPS {
+		lat, okLat := parseXMPCoord(props[nsExif+"GPSLatitude"], 'N', 'S')
+		long, okLong := parseXMPCoord(props[nsExif+"GPSLongitude"], 'E', 'W')
+		if okLat && okLong && lat >= -90 && lat <= 90 && long >= -180 && long <= 180 {
+			m.Lat, m.Long, m.HasGPS = lat, long, true
+		}
+	}
+	if m.GPSTime.IsZero() {
+		// Unlike the EXIF tag, XMP's GPSTimeStamp includes the date.
+		if t, off, _, ok := parseXMPDate(props[nsExif+"GPSTimeStamp"]); ok {
+			m.GPSTime = t.Add(-time.Duration(off) * time.Second)
+		}
+	}
+	return nil
+}
+
+// xmpProperties returns the simple properties in an XMP packet, keyed
+// by namespace and name. A property can be an attribute of an
+// rdf:Description or an element with only text in it.
+func xmpProperties(b []byte) (map[string]string, error) {
+	props := make(map[string]string)
+	d := xml.NewDecoder(bytes.NewReader(b))
+	var (
+		cur  xml.Name // element whose text is being collected
+		text strings.Builder
+	)
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				return props, nil
+			}
+			return nil, fmt.Errorf("%w: XMP: %v", ErrCorrupt, err)
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			for _, a := range tok.Attr {
+				props[a.Name.Space+a.Name.Local] = a.Value
+			}
+			cur = tok.Name
+			text.Reset()
+		case xml.CharData:
+			text.Write(tok)
+		case xml.EndElement:
+			if tok.Name == cur {
+				if s := strings.TrimSpace(text.String()); s != "" {
+					props[cur.Space+cur.Local] = s
+				}
+			}
+			cur = xml.Name{}
+		}
+	}
+}
+
+// xmpDateLayouts are the forms of XMP dates with a time, with and
+// without a zone.
+var xmpDateLayouts = []string{
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+}
+
+// parseXMPDate parses an XMP date and time, returning its clock time
+// as if in UTC and its offset, if it has one.
+func parseXMPDate(s string) (local time.Time, off int, hasOff, ok bool) {
+	for i, layout := range xmpDateLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		_, off = t.Zone()
+		y, mo, d := t.Date()
+		local = time.Date(y, mo, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+		return local, off, i < 2, true
+	}
+	return time.Time{}, 0, false, false
+}
+
+// parseXMPCoord parses an XMP GPS coordinate, "DDD,MM,SSk" or
+// "DDD,MM.mmk", where k is pos or neg.
+func parseXMPCoord(s string, pos, neg byte) (float64, bool) {
+	if len(s) < 2 {
+		return 0, false
+	}
+	ref := s[len(s)-1]
+	if ref != pos && ref != neg {
+		return 0, false
+	}
+	parts := strings.Split(s[:len(s)-1], ",")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, false
+	}
+	var d float64
+	for i, p := range parts {
+		v, err := strconv.ParseFloat(p, 64)
+		if err != nil || v < 0 {
+			return 0, false
+		}
+		d += v / []float64{1, 60, 3600}[i]
+	}
+	if ref == neg {
+		d = -d
+	}
+	return d, true
+}
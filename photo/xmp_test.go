@@ -0,0 +1,101 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package photo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+)
+
+const testXMP = `<?xpacket begin="" id="W5M0MpCehiHzreSzNTczkc9d"?>
+<x:xmpmeta xmlns:x="adobe:ns:meta/">
+ <rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+  <rdf:Description rdf:about=""
+    xmlns:exif="http://ns.adobe.com/exif/1.0/"
+    xmlns:photoshop="http://ns.adobe.com/photoshop/1.0/"
+    exif:GPSLatitude="48,51.5N"
+    exif:GPSLongitude="2,21,3E"
+    photoshop:DateCreated="2023-07-01T09:00:00">
+   <exif:DateTimeOriginal>2023-07-01T14:15:16.5+02:00</exif:DateTimeOriginal>
+   <exif:GPSTimeStamp>2023-07-01T12:15:10Z</exif:GPSTimeStamp>
+  </rdf:Description>
+ </rdf:RDF>
+</x:xmpmeta>
+<?xpacket end="w"?>`
+
+func TestDecodeXMP(t *testing.T) {
+	m, err := Decode(bytes.NewReader([]byte(testXMP)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.HasGPS || math.Abs(m.Lat-(48+51.5/60)) > 1e-9 || math.Abs(m.Long-(2+21.0/60+3.0/3600)) > 1e-9 {
+		t.Errorf("position = %v, %v, %v", m.HasGPS, m.Lat, m.Long)
+	}
+	if want := time.Date(2023, 7, 1, 14, 15, 16, 500e6, time.UTC); !m.Local.Equal(want) {
+		t.Errorf("Local = %v; want %v", m.Local, want)
+	}
+	if !m.HasOffset || m.Offset != 7200 {
+		t.Errorf("Offset = %v, %v; want 7200", m.Offset, m.HasOffset)
+	}
+	if want := time.Date(2023, 7, 1, 12, 15, 10, 0, time.UTC); !m.GPSTime.Equal(want) {
+		t.Errorf("GPSTime = %v; want %v", m.GPSTime, want)
+	}
+}
+
+// EXIF values win over XMP ones, which only fill the gaps.
+func TestDecodeExifAndXMP(t *testing.T) {
+	bo := binary.LittleEndian
+	tiff := sanFranciscoTIFF(bo, []testEntry{asciiEntry(tagDateTimeOr, "2023:07:01 12:34:56")})
+	xmpSeg := append([]byte(xmpJPEGPrefix), testXMP...)
+	m, err := Decode(bytes.NewReader(buildJPEG(append([]byte(exifPrefix), tiff...), xmpSeg)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(m.Lat-37.7833) > 1e-4 {
+		t.Errorf("Lat = %v; want the EXIF one", m.Lat)
+	}
+	if want := time.Date(2023, 7, 1, 12, 34, 56, 0, time.UTC); !m.Local.Equal(want) || m.HasOffset {
+		t.Errorf("Local = %v, HasOffset = %v; want the EXIF time without offset", m.Local, m.HasOffset)
+	}
+	if m.GPSTime.IsZero() {
+		t.Error("GPSTime from XMP not used")
+	}
+}
+
+func TestParseXMPCoord(t *testing.T) {
+	cases := []struct {
+		s    string
+		want float64
+		ok   bool
+	}{
+		{"37,46.998N", 37 + 46.998/60, true},
+		{"12,25,0S", -12 - 25.0/60, true},
+		{"10,30S", -10.5, true},
+		{"10,30E", 0, false},
+		{"10N", 0, false},
+		{"", 0, false},
+	}
+	for _, tt := range cases {
+		got, ok := parseXMPCoord(tt.s, 'N', 'S')
+		if ok != tt.ok || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("parseXMPCoord(%q) = %v, %v; want %v, %v", tt.s, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import "fmt"
+
+// The tiles are indexed by a quadtree. Its roots are a row-major grid
+// of the 256px (zoom level 5) tiles covering the world, and each node
+// is a big endian uint32 that is one of:
+//
+//   - nodeEmpty: no tile here or in any smaller tile inside it;
+//   - nodeLeaf|idx: a tile whose zone is given by leaf idx;
+//   - the index of the first of four children, the half-size tiles at
+//     (x, y), (x+1, y), (x, y+1) and (x+1, y+1), in that order, which
+//     is always greater than the index of the node itself.
+//
+// So a lookup descends at most once per zoom level, without searching.
+const (
+	nodeEmpty = 0
+	nodeLeaf  = 1 << 31
+)
+
+// pixelZone returns the index of the static zone leaf at pixel (x, y),
+// or oceanIndex if there is none.
+func (t *Table) pixelZone(x, y int) uint16 {
+	n, _ := t.tileNode(x, y)
+	if n&nodeLeaf == 0 {
+		return oceanIndex
+	}
+	return t.leafZone(uint16(n), x, y)
+}
+
+// tileNode returns the node of the largest tile containing pixel
+// (x, y) that is a leaf or empty, and the log2 of that tile's size in
+// pixels.
+func (t *Table) tileNode(x, y int) (n uint32, shift uint) {
+	shift = 8
+	n = t.node((y>>shift)*t.gridW + x>>shift)
+	for n != nodeEmpty && n&nodeLeaf == 0 && shift > 3 {
+		shift--
+		n = t.node(int(n) + (y>>shift&1)<<1 + x>>shift&1)
+	}
+	return n, shift
+}
+
+func (t *Table) node(i int) uint32 {
+	return be32(t.nodes[4*i:])
+}
+
+// quadtreeGrid returns the size of the root grid for degPixels.
+func quadtreeGrid(degPixels int) (w, h int) {
+	return (360*degPixels + 255) / 256, (180*degPixels + 255) / 256
+}
+
+// checkQuadtree verifies that every node of t's quadtree is in range,
+// that children follow their parents and that level 0 nodes have no
+// children.
+func (t *Table) checkQuadtree() error {
+	numNodes := len(t.nodes) / 4
+	if len(t.nodes)%4 != 0 || numNodes < t.gridW*t.gridH {
+		return fmt.Errorf("%w: QTRE section has wrong length", ErrCorruptTables)
+	}
+	var check func(i, level int) error
+	check = func(i, level int) error {
+		n := t.node(i)
+		switch {
+		case n == nodeEmpty:
+			return nil
+		case n&nodeLeaf != 0:
+			if n&^nodeLeaf >= uint32(t.numLeaves) {
+				return fmt.Errorf("%w: node %d: bad leaf index", ErrCorruptTables, i)
+			}
+			return nil
+		case level == 0 || int(n) <= i || int(n)+4 > numNodes:
+			return fmt.Errorf("%w: node %d: bad child index", ErrCorruptTables, i)
+		}
+		for c := 0; c < 4; c++ {
+			if err := check(int(n)+c, level-1); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for i := 0; i < t.gridW*t.gridH; i++ {
+		if err := check(i, 5); err != nil {
+			return err
+		}
+	}
+	return nil
+}
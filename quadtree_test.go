@@ -0,0 +1,167 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// buildQuadtree returns the QTRE nodes indexing the tiles of each zoom
+// level 0 (8px tiles) through 5 (256px tiles), given as 6 byte entries
+// of a tileKey and a 2 byte leaf index, sorted by tileKey. This is how
+// the generator and the tests list tiles.
+func buildQuadtree(degPixels int, levels [6]string) string {
+	// tile maps each tile to its leaf, and parent notes the larger
+	// tiles that have smaller tiles inside them.
+	tile := make(map[tileKey]uint16)
+	parent := make(map[tileKey]bool)
+	for level, tiles := range levels {
+		for i := 0; i < len(tiles)/6; i++ {
+			tk := tileKey(be32(tiles[i*6:]))
+			tile[tk] = be16(tiles[i*6+4:])
+			x, y := tk.x(), tk.y()
+			for l := level + 1; l < 6; l++ {
+				x, y = x>>1, y>>1
+				parent[newTileKey(uint8(l), x, y)] = true
+			}
+		}
+	}
+
+	gw, gh := quadtreeGrid(degPixels)
+	nodes := make([]uint32, gw*gh)
+	var fill func(i int, level uint8, x, y uint16)
+	fill = func(i int, level uint8, x, y uint16) {
+		tk := newTileKey(level, x, y)
+		if idx, ok := tile[tk]; ok {
+			nodes[i] = nodeLeaf | uint32(idx)
+			return
+		}
+		if level == 0 || !parent[tk] {
+			return // nodeEmpty
+		}
+		first := len(nodes)
+		nodes[i] = uint32(first)
+		nodes = append(nodes, 0, 0, 0, 0)
+		for c := 0; c < 4; c++ {
+			fill(first+c, level-1, 2*x+uint16(c&1), 2*y+uint16(c>>1))
+		}
+	}
+	for y := 0; y < gh; y++ {
+		for x := 0; x < gw; x++ {
+			fill(y*gw+x, 5, uint16(x), uint16(y))
+		}
+	}
+
+	b := make([]byte, 4*len(nodes))
+	for i, n := range nodes {
+		b[4*i], b[4*i+1], b[4*i+2], b[4*i+3] = byte(n>>24), byte(n>>16), byte(n>>8), byte(n)
+	}
+	return string(b)
+}
+
+// searchTiles finds pixel (x, y) the way lookups did before the
+// quadtree: by a binary search of each zoom level's tiles, largest
+// first.
+func searchTiles(levels [6]string, x, y int) (idx uint16, ok bool) {
+	for level := 5; level >= 0; level-- {
+		shift := 3 + uint8(level)
+		tk := newTileKey(uint8(level), uint16(x>>shift), uint16(y>>shift))
+		tiles := levels[level]
+		n := len(tiles) / 6
+		pos := sort.Search(n, func(i int) bool {
+			return tileKey(be32(tiles[i*6:])) >= tk
+		})
+		if pos < n && tileKey(be32(tiles[pos*6:])) == tk {
+			return be16(tiles[pos*6+4:]), true
+		}
+	}
+	return 0, false
+}
+
+func TestBuildQuadtree(t *testing.T) {
+	const degPixels = 2
+	r := rand.New(rand.NewSource(6))
+	var levels [6]string
+	for level := range levels {
+		keys := map[tileKey]bool{}
+		for i := 0; i < 40<<uint(5-level); i++ {
+			shift := 3 + uint(level)
+			x := r.Intn(360 * degPixels >> shift)
+			y := r.Intn(180 * degPixels >> shift)
+			keys[newTileKey(uint8(level), uint16(x), uint16(y))] = true
+		}
+		var sorted []tileKey
+		for tk := range keys {
+			sorted = append(sorted, tk)
+		}
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+		var buf bytes.Buffer
+		for i, tk := range sorted {
+			binary.Write(&buf, binary.BigEndian, tk)
+			binary.Write(&buf, binary.BigEndian, uint16(i))
+		}
+		levels[level] = buf.String()
+	}
+
+	f := &tableFile{degPixels: degPixels, nodes: buildQuadtree(degPixels, levels), numLeaves: 1 << 16}
+	checkQuadtreeMatchesTiles(t, f, levels)
+}
+
+// checkQuadtreeMatchesTiles checks that every pixel resolves to the
+// same leaf through file's quadtree as through a search of the tiles
+// it was built from.
+func checkQuadtreeMatchesTiles(t *testing.T, file *tableFile, levels [6]string) {
+	tb := &Table{degPixels: file.degPixels, nodes: file.nodes, numLeaves: file.numLeaves}
+	tb.gridW, tb.gridH = quadtreeGrid(tb.degPixels)
+	if err := tb.checkQuadtree(); err != nil {
+		t.Fatal(err)
+	}
+	for y := 0; y < 180*tb.degPixels; y++ {
+		for x := 0; x < 360*tb.degPixels; x++ {
+			wantIdx, wantOK := searchTiles(levels, x, y)
+			n, _ := tb.tileNode(x, y)
+			if ok := n&nodeLeaf != 0; ok != wantOK || ok && uint16(n) != wantIdx {
+				t.Fatalf("pixel (%d, %d): quadtree node %#x; tiles have leaf %d, %v", x, y, n, wantIdx, wantOK)
+			}
+		}
+	}
+}
+
+// Every pixel of the tile tileNode returns must have the same node,
+// since Batch reuses it for them.
+func TestTileNodeSize(t *testing.T) {
+	tb := Default()
+	r := rand.New(rand.NewSource(7))
+	for i := 0; i < 2000; i++ {
+		x, y := r.Intn(360*tb.degPixels), r.Intn(180*tb.degPixels)
+		n, shift := tb.tileNode(x, y)
+		mask := 1<<shift - 1
+		for j := 0; j < 20; j++ {
+			xx, yy := x&^mask|r.Intn(mask+1), y&^mask|r.Intn(mask+1)
+			if yy >= 180*tb.degPixels {
+				continue
+			}
+			if n2, _ := tb.tileNode(xx, yy); n2 != n {
+				t.Fatalf("tileNode(%d, %d) = %#x, %d but tileNode(%d, %d) = %#x", x, y, n, shift, xx, yy, n2)
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+// A Result is the zone at a coordinate together with how sure the
+// tables are of it.
+//
+// The tables are a raster of 1/degPixels degree pixels (about 3.5km
+// at the default resolution), so a coordinate within a pixel of a
+// border may be given the zone across it. A zone found in a large
+// tile far from its edges is certain; one found in an 8x8 bitmap may
+// not be.
+type Result struct {
+	Zone string // as returned by LookupZoneName, or see Nautical
+	ID   ZoneID // as returned by LookupZoneID
+
+	// Nautical is set if the coordinate is in no zone and Zone is
+	// the nautical zone for its longitude instead, as returned by
+	// LookupNautical. ID is then NoZone.
+	Nautical bool
+
+	// Level is the zoom level of the tile that decided the lookup:
+	// 5 for a 256 pixel square tile down to 0 for an 8 pixel one.
+	// LeafType is the type of the leaf it resolved to: 'S' for a
+	// single zone, '2' for a two-zone 8x8 bitmap or 'P' for a
+	// multi-zone one, or 0 if the tile was empty.
+	Level    int
+	LeafType byte
+
+	// Neighbors is how many of the 8 pixels around the coordinate's
+	// pixel are in a different zone, or in none, from 0 to 8. Pixels
+	// beyond the poles aren't counted. Ambiguous is whether it is
+	// more than zero: whether the coordinate is within a pixel of a
+	// border, and a human should check the answer.
+	Neighbors int
+	Ambiguous bool
+}
+
+// LookupResult is like LookupZoneNameErr but returns a Result saying
+// how the zone was found and whether it is near a border.
+func LookupResult(lat, long float64) (Result, error) {
+	return Default().LookupResult(lat, long)
+}
+
+// LookupResult is like the package-level LookupResult but uses t.
+func (t *Table) LookupResult(lat, long float64) (Result, error) {
+	lat, long, err := Normalize(lat, long)
+	if err != nil {
+		return Result{}, err
+	}
+	if err := t.Init(); err != nil {
+		return Result{}, err
+	}
+	x, y := t.latLongPixel(lat, long)
+	n, shift := t.tileNode(x, y)
+	r := Result{Level: int(shift) - 3}
+	zone := oceanIndex
+	if n != nodeEmpty {
+		idx := uint16(n &^ nodeLeaf)
+		r.LeafType, _ = t.leaf(idx)
+		zone = t.leafZone(idx, x, y)
+	}
+	r.Zone = t.zoneName(zone)
+	r.ID = zoneID(zone)
+
+	// A whole tile has one zone, so neighbors inside it needn't be
+	// looked up.
+	size := 1 << shift
+	if r.LeafType != leafOneBit && r.LeafType != leafPixmap {
+		if x&(size-1) != 0 && x&(size-1) != size-1 && y&(size-1) != 0 && y&(size-1) != size-1 {
+			return r, nil
+		}
+	}
+	w, h := 360*t.degPixels, 180*t.degPixels
+	for dy := -1; dy <= 1; dy++ {
+		ny := y + dy
+		if ny < 0 || ny >= h {
+			continue
+		}
+		for dx := -1; dx <= 1; dx++ {
+			if dx == 0 && dy == 0 {
+				continue
+			}
+			nx := (x + dx + w) % w // wrapping around the antimeridian
+			if t.pixelZone(nx, ny) != zone {
+				r.Neighbors++
+			}
+		}
+	}
+	r.Ambiguous = r.Neighbors > 0
+	return r, nil
+}
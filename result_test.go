@@ -0,0 +1,127 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestLookupResultFixture(t *testing.T) {
+	fix := fixtureTable()
+	// pixelLatLong returns the center of pixel (x, y) of the fixture.
+	pixelLatLong := func(x, y int) (lat, long float64) {
+		return 90 - float64(y) - 0.5, float64(x) - 180 + 0.5
+	}
+	cases := []struct {
+		x, y      int
+		zone      string
+		level     int
+		leafType  byte
+		neighbors int
+	}{
+		{100, 100, "Fixture/West", 5, 'S', 0},
+		{0, 100, "Fixture/West", 5, 'S', 3},  // ocean across the antimeridian
+		{255, 0, "Fixture/West", 5, 'S', 2},  // ocean to the east; nothing north
+		{266, 42, "Fixture/East", 0, '2', 0}, // East on both sides
+		{267, 42, "Fixture/East", 0, '2', 3}, // West to the east
+		{266, 40, "Fixture/East", 0, '2', 3}, // ocean to the north
+		{320, 80, "Fixture/East", 0, 'S', 5}, // the tile's corner
+		{300, 100, "", 3, 0, 0},              // open ocean
+		{319, 79, "", 3, 0, 1},               // diagonal to Fixture/East
+	}
+	for _, tt := range cases {
+		lat, long := pixelLatLong(tt.x, tt.y)
+		r, err := fix.LookupResult(lat, long)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := Result{
+			Zone:      tt.zone,
+			Level:     tt.level,
+			LeafType:  tt.leafType,
+			Neighbors: tt.neighbors,
+			Ambiguous: tt.neighbors > 0,
+		}
+		if tt.zone != "" {
+			want.ID, _ = fix.ParseZoneID(tt.zone)
+		}
+		if r != want {
+			t.Errorf("pixel (%d, %d): LookupResult = %+v; want %+v", tt.x, tt.y, r, want)
+		}
+	}
+}
+
+func TestLookupResultMatchesNeighbors(t *testing.T) {
+	tb := Default()
+	w, h := 360*tb.degPixels, 180*tb.degPixels
+	rnd := rand.New(rand.NewSource(1))
+	ambiguous := 0
+	for i := 0; i < 20000; i++ {
+		lat, long := rnd.Float64()*180-90, rnd.Float64()*360-180
+		if i%2 == 0 {
+			// Near a border, in western Europe.
+			lat, long = 42+rnd.Float64()*10, -2+rnd.Float64()*16
+		}
+		r, err := LookupResult(lat, long)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Zone != LookupZoneName(lat, long) || r.ID != LookupZoneID(lat, long) {
+			t.Fatalf("LookupResult(%v, %v) = %+v; LookupZoneName = %q", lat, long, r, LookupZoneName(lat, long))
+		}
+		x, y := tb.latLongPixel(lat, long)
+		zone := tb.pixelZone(x, y)
+		neighbors := 0
+		for dy := -1; dy <= 1; dy++ {
+			for dx := -1; dx <= 1; dx++ {
+				ny := y + dy
+				if (dx != 0 || dy != 0) && ny >= 0 && ny < h && tb.pixelZone((x+dx+w)%w, ny) != zone {
+					neighbors++
+				}
+			}
+		}
+		if r.Neighbors != neighbors || r.Ambiguous != (neighbors > 0) {
+			t.Fatalf("LookupResult(%v, %v) = %+v; want %d neighbors", lat, long, r, neighbors)
+		}
+		if r.Ambiguous {
+			ambiguous++
+		}
+	}
+	if ambiguous == 0 {
+		t.Error("no ambiguous results")
+	}
+}
+
+func TestLookupResultErrors(t *testing.T) {
+	if _, err := LookupResult(0, 200); err != nil {
+		t.Errorf("LookupResult(0, 200) error = %v; want wrapped longitude", err)
+	}
+	if _, err := LookupResult(-91, 0); !errors.Is(err, ErrInvalidCoordinate) {
+		t.Errorf("LookupResult(-91, 0) error = %v", err)
+	}
+	if _, err := new(Table).LookupResult(0, 0); err != ErrNoTables {
+		t.Errorf("LookupResult on zero Table error = %v", err)
+	}
+}
+
+func BenchmarkLookupResult(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LookupResult(37.7833, -122.4167)
+	}
+}
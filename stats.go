@@ -0,0 +1,152 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+// TableStats describes the size and shape of a Table, for tracking its
+// footprint across data releases and comparing resolutions.
+type TableStats struct {
+	// Tiles is the number of tiles at each zoom level that resolve
+	// to a leaf, from the 8 pixel square tiles of Tiles[0] to the 256
+	// pixel square ones of Tiles[5].
+	Tiles [6]int
+
+	// Nodes is the number of quadtree nodes, including empty ones
+	// and those with children.
+	Nodes int
+
+	// StaticLeaves, OneBitLeaves and PixmapLeaves count the leaves
+	// by type: single zones ('S'), two-zone 8x8 bitmaps ('2') and
+	// multi-zone 8x8 bitmaps ('P').
+	StaticLeaves, OneBitLeaves, PixmapLeaves int
+
+	// SharedTiles is the number of 8x8 tiles that reuse the bitmap
+	// leaf of an identical tile elsewhere rather than having their
+	// own, and SharedBytes the bytes of leaves and leaf offsets that
+	// saves.
+	SharedTiles, SharedBytes int
+
+	// DataBytes is the size of the data lookups read: the quadtree,
+	// leaves, leaf offsets and zone metadata.
+	DataBytes int
+
+	// HeapBytes is how much of the Table is held on the heap: the
+	// table file as read by LoadTable, plus anything decompressed or
+	// computed from it. It is zero for the compiled-in Table, which
+	// is looked up in place in the binary's read-only data. Caches
+	// filled by lookups, such as ParseZoneID's, are not counted.
+	HeapBytes int
+
+	// FileBytes is the size of the table file, and Compressed whether
+	// its body is gzip-compressed.
+	FileBytes  int
+	Compressed bool
+
+	// CompressedBytes is the size of the table file with its body
+	// gzip-compressed, as the generator's --compress writes it. If
+	// the file isn't Compressed, the first Stats call compresses it
+	// to find out, which takes a while for large tables.
+	CompressedBytes int
+}
+
+// Stats returns the TableStats of the default Table.
+func Stats() TableStats {
+	return Default().Stats()
+}
+
+// Stats returns t's TableStats, which are computed on each call except
+// for CompressedBytes. It returns the zero TableStats if t is unusable.
+func (t *Table) Stats() TableStats {
+	var s TableStats
+	if t.Init() != nil {
+		return s
+	}
+	s.Nodes = len(t.nodes) / 4
+	s.DataBytes = len(t.nodes) + len(t.leaves) + len(t.leafIdx) + len(t.zones)
+	s.HeapBytes = t.heapBytes
+	s.FileBytes = len(t.data)
+	s.Compressed = be16(t.data[6:])&tableFlagGzip != 0
+	if s.Compressed {
+		s.CompressedBytes = s.FileBytes
+	} else {
+		s.CompressedBytes = t.compressedLen()
+	}
+
+	refs := make([]int, t.numLeaves) // tiles resolving to each leaf
+	var walk func(i, level int)
+	walk = func(i, level int) {
+		switch n := t.node(i); {
+		case n == nodeEmpty:
+		case n&nodeLeaf != 0:
+			s.Tiles[level]++
+			refs[n&^nodeLeaf]++
+		default:
+			for c := 0; c < 4; c++ {
+				walk(int(n)+c, level-1)
+			}
+		}
+	}
+	for i := 0; i < t.gridW*t.gridH; i++ {
+		walk(i, 5)
+	}
+
+	for i := 0; i < t.numLeaves; i++ {
+		typ, data := t.leaf(uint16(i))
+		switch typ {
+		case leafStatic:
+			s.StaticLeaves++
+			continue
+		case leafOneBit:
+			s.OneBitLeaves++
+		case leafPixmap:
+			s.PixmapLeaves++
+		}
+		if refs[i] > 1 {
+			s.SharedTiles += refs[i] - 1
+			s.SharedBytes += (refs[i] - 1) * (1 + len(data) + 4)
+		}
+	}
+	return s
+}
+
+// compressedLen returns the size of t's table file as the generator's
+// --compress would write it. It compresses the file on the first call,
+// which is slow.
+func (t *Table) compressedLen() int {
+	t.gzipOnce.Do(func() {
+		f := &tableFile{
+			degPixels: t.degPixels,
+			nodes:     t.nodes,
+			numLeaves: t.numLeaves,
+			leaves:    t.leaves,
+			leafIdx:   t.leafIdx,
+			zones:     t.zones,
+			info:      t.info,
+		}
+		var n byteCounter
+		f.writeTo(&n, true) // byteCounter never fails
+		t.gzipLen = int(n)
+	})
+	return t.gzipLen
+}
+
+// A byteCounter is an io.Writer that counts the bytes written to it.
+type byteCounter int
+
+func (n *byteCounter) Write(p []byte) (int, error) {
+	*n += byteCounter(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+)
+
+func TestStatsFixture(t *testing.T) {
+	fix := fixtureTable()
+	got := fix.Stats()
+	want := TableStats{
+		Tiles:        [6]int{2, 0, 0, 0, 0, 1},
+		Nodes:        len(fix.nodes) / 4,
+		StaticLeaves: 2,
+		OneBitLeaves: 1,
+		DataBytes:    len(fix.nodes) + len(fix.leaves) + len(fix.leafIdx),
+		FileBytes:    len(fix.data),
+
+		CompressedBytes: len(encodeTable(fix, true)),
+	}
+	if got != want {
+		t.Errorf("fixture Stats = %+v; want %+v", got, want)
+	}
+	if got := new(Table).Stats(); got != (TableStats{}) {
+		t.Errorf("zero Table Stats = %+v", got)
+	}
+}
+
+func TestStatsSharedLeaf(t *testing.T) {
+	// The fixture plus a second 8x8 tile, at (280, 120), with the
+	// same one-bit leaf as the one at (264, 40): East on the left,
+	// West on the right.
+	var tile [6]byte
+	binary.BigEndian.PutUint32(tile[:], uint32(newTileKey(0, 35, 15)))
+	binary.BigEndian.PutUint16(tile[4:], 2)
+	levels := fixtureLevels()
+	levels[0] += string(tile[:])
+	f := &tableFile{
+		degPixels: 1,
+		nodes:     buildQuadtree(1, levels),
+		numLeaves: 3,
+		leaves:    fixtureTable().leaves,
+	}
+
+	var zbuf bytes.Buffer
+	if err := f.writeTo(&zbuf, true); err != nil {
+		t.Fatal(err)
+	}
+	var bodyLen int
+	for _, compress := range []bool{false, true} {
+		var buf bytes.Buffer
+		if err := f.writeTo(&buf, compress); err != nil {
+			t.Fatal(err)
+		}
+		size := buf.Len()
+		if !compress {
+			bodyLen = size - tableHeaderLen
+		}
+		tb, err := LoadTable(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := tb.lookupPixel(281, 120) + " " + tb.lookupPixel(284, 120); got != "Fixture/East Fixture/West" {
+			t.Fatalf("pixels (281, 120) and (284, 120) = %s; want Fixture/East Fixture/West", got)
+		}
+		s := tb.Stats()
+		if s.Tiles != [6]int{3, 0, 0, 0, 0, 1} || s.OneBitLeaves != 1 {
+			t.Errorf("compress=%v: Tiles = %v, OneBitLeaves = %d", compress, s.Tiles, s.OneBitLeaves)
+		}
+		if s.SharedTiles != 1 || s.SharedBytes != 1+12+4 {
+			t.Errorf("compress=%v: SharedTiles = %d, SharedBytes = %d; want 1, 17", compress, s.SharedTiles, s.SharedBytes)
+		}
+		if s.FileBytes != size || s.Compressed != compress {
+			t.Errorf("compress=%v: FileBytes = %d, Compressed = %v; want %d", compress, s.FileBytes, s.Compressed, size)
+		}
+		if s.CompressedBytes != zbuf.Len() {
+			t.Errorf("compress=%v: CompressedBytes = %d; want %d", compress, s.CompressedBytes, zbuf.Len())
+		}
+		// LoadTable's copy of the file, plus the decompressed body.
+		wantHeap := size
+		if compress {
+			wantHeap += bodyLen
+		}
+		if s.HeapBytes != wantHeap {
+			t.Errorf("compress=%v: HeapBytes = %d; want %d", compress, s.HeapBytes, wantHeap)
+		}
+	}
+}
+
+func TestStatsDefault(t *testing.T) {
+	s := Stats()
+	if s.HeapBytes != 0 || s.Compressed || s.FileBytes != len(defaultTableData) {
+		t.Errorf("default HeapBytes = %d, Compressed = %v, FileBytes = %d", s.HeapBytes, s.Compressed, s.FileBytes)
+	}
+	tiles := 0
+	for _, n := range s.Tiles {
+		tiles += n
+	}
+	if tiles != Info().Tiles {
+		t.Errorf("Tiles sum to %d; DataInfo says %d", tiles, Info().Tiles)
+	}
+	if s.StaticLeaves != ZoneCount() || s.StaticLeaves+s.OneBitLeaves+s.PixmapLeaves != Info().Leaves {
+		t.Errorf("leaves: %d static, %d one-bit, %d pixmap", s.StaticLeaves, s.OneBitLeaves, s.PixmapLeaves)
+	}
+	if s.CompressedBytes == 0 || s.CompressedBytes >= s.FileBytes {
+		t.Errorf("default CompressedBytes = %d, FileBytes = %d", s.CompressedBytes, s.FileBytes)
+	}
+	if s.SharedTiles == 0 || s.Tiles[0] == 0 || s.Tiles[5] == 0 {
+		t.Errorf("Stats() = %+v", s)
+	}
+}
+
+func BenchmarkStats(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Stats()
+	}
+}
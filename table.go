@@ -0,0 +1,217 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+)
+
+// A Table is one dataset mapping pixels of the world to time zones.
+// The package-level functions use the compiled-in default Table; other
+// Tables, loaded with LoadTable, let several datasets be used side by
+// side in one process.
+//
+// A Table is looked up in place: its quadtree and leaves are substrings
+// of the table file and are never unpacked into other structures.
+//
+// A Table is safe for concurrent use. Its zero value has no data and
+// reports ErrNoTables.
+type Table struct {
+	err       error // why the table is unusable, if it is
+	degPixels int   // pixels per degree
+	gridW     int   // quadtree roots per row
+	gridH     int   // rows of quadtree roots
+
+	// Sections of the table file; see format.go.
+	nodes     string // uint32 quadtree nodes; see quadtree.go
+	leafIdx   string // uint32 offset into leaves, per leaf
+	leaves    string // packed leaves
+	zones     string // zone metadata, if any; see zoneinfo.go
+	info      string // provenance, if any; see info.go
+	numLeaves int
+	numStatic int // leaves [0, numStatic) are static zones
+	heapBytes int // bytes of the file and sections on the heap; see Stats
+
+	data        string // the whole table file, for Version
+	versionOnce sync.Once
+	version     string
+
+	zoneIDsOnce sync.Once
+	zoneIDs     map[string]ZoneID // for ParseZoneID
+
+	gzipOnce sync.Once
+	gzipLen  int // size of the file compressed; see Stats
+}
+
+// defaultTableData is the table file generated by TestGenerate in
+// gen_test.go; see the Makefile. It is stored uncompressed so that
+// the default table is read straight from the binary's data.
+//
+//go:embed z_gen_tables.bin
+var defaultTableData string
+
+// defaultTable holds the *Table used by the package-level functions.
+var defaultTable atomic.Value
+
+func init() {
+	defaultTable.Store(mustParseTable(defaultTableData))
+}
+
+// mustParseTable is like parseTable but returns an unusable Table
+// that reports the error, rather than the error itself.
+func mustParseTable(data string) *Table {
+	t, err := parseTable(data, true)
+	if err != nil {
+		return &Table{err: err}
+	}
+	return t
+}
+
+// Default returns the Table used by the package-level functions: the
+// compiled-in one, unless replaced by SetDefault.
+func Default() *Table {
+	return defaultTable.Load().(*Table)
+}
+
+// SetDefault makes t the Table used by the package-level functions,
+// so that a long-running program can pick up a newer table file
+// without restarting. The swap is atomic: each lookup uses either the
+// old Table or t throughout, and lookups already running finish
+// against the old one. SetDefault returns t.Init() and leaves the
+// default unchanged if t is unusable.
+func SetDefault(t *Table) error {
+	if err := t.Init(); err != nil {
+		return err
+	}
+	defaultTable.Store(t)
+	return nil
+}
+
+// Version returns the Version of the default Table.
+func Version() string {
+	return Default().Version()
+}
+
+// Version returns a short identifier of t's data: the first 12 hex
+// digits of the SHA-256 of its table file. Tables loaded from the same
+// file have the same Version, so it can be reported alongside lookups
+// to say which dataset answered them. It returns "" for an unusable
+// Table.
+func (t *Table) Version() string {
+	if t.Init() != nil {
+		return ""
+	}
+	t.versionOnce.Do(func() {
+		sum := sha256.Sum256([]byte(t.data))
+		t.version = hex.EncodeToString(sum[:6])
+	})
+	return t.version
+}
+
+// Init reports whether t is usable. The checks it reports on are done
+// when the Table is created, so Init is cheap.
+func (t *Table) Init() error {
+	if t.err != nil {
+		return t.err
+	}
+	if t.degPixels == 0 {
+		return ErrNoTables
+	}
+	return nil
+}
+
+// LookupZoneName is like the package-level LookupZoneName but uses t.
+func (t *Table) LookupZoneName(lat, long float64) string {
+	zone, _ := t.LookupZoneNameErr(lat, long)
+	return zone
+}
+
+// LookupZoneNameErr is like the package-level LookupZoneNameErr but
+// uses t.
+func (t *Table) LookupZoneNameErr(lat, long float64) (string, error) {
+	lat, long, err := Normalize(lat, long)
+	if err != nil {
+		return "", err
+	}
+	if err := t.Init(); err != nil {
+		return "", err
+	}
+	return t.lookupPixel(t.latLongPixel(lat, long)), nil
+}
+
+// LookupZoneNameStrict is like the package-level LookupZoneNameStrict
+// but uses t.
+func (t *Table) LookupZoneNameStrict(lat, long float64) (string, error) {
+	if long < -180 || long > 180 {
+		return "", ErrInvalidCoordinate
+	}
+	return t.LookupZoneNameErr(lat, long)
+}
+
+// latLongPixel maps a normalized lat and long to pixel coordinates.
+// The south pole and any floating point rounding at the far edges
+// land on the last row or column.
+func (t *Table) latLongPixel(lat, long float64) (x, y int) {
+	x = int((long + 180) * float64(t.degPixels))
+	y = int((90 - lat) * float64(t.degPixels))
+	if x >= 360*t.degPixels {
+		x = 360*t.degPixels - 1
+	}
+	if y >= 180*t.degPixels {
+		y = 180*t.degPixels - 1
+	}
+	return x, y
+}
+
+func (t *Table) lookupPixel(x, y int) string {
+	return t.zoneName(t.pixelZone(x, y))
+}
+
+// leaf returns the type and data of leaf idx.
+func (t *Table) leaf(idx uint16) (typ byte, data string) {
+	off := be32(t.leafIdx[4*int(idx):])
+	end := uint32(len(t.leaves))
+	if int(idx)+1 < t.numLeaves {
+		end = be32(t.leafIdx[4*int(idx)+4:])
+	}
+	return t.leaves[off], t.leaves[off+1 : end]
+}
+
+// leafZone returns the static zone index that leaf idx has at pixel
+// (x, y), or oceanIndex.
+func (t *Table) leafZone(idx uint16, x, y int) uint16 {
+	switch typ, data := t.leaf(idx); typ {
+	case leafOneBit:
+		return oneBitTile(data).zone(x, y)
+	case leafPixmap:
+		return pixmap(data).zone(x, y)
+	}
+	return idx
+}
+
+// zoneName returns the name of static zone idx, or "" for oceanIndex.
+func (t *Table) zoneName(idx uint16) string {
+	if int(idx) >= t.numStatic {
+		return ""
+	}
+	_, name := t.leaf(idx)
+	return name[:len(name)-1] // NUL-terminated
+}
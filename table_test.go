@@ -0,0 +1,198 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fixtureTable returns a one pixel per degree table with three
+// leaves: the 256px tile at the origin is Fixture/West, the 8x8 tile
+// at (320, 80) is Fixture/East, and the 8x8 tile at (264, 40) is a
+// one-bit tile whose left half is Fixture/East and right half is
+// Fixture/West. Everything else is ocean.
+func fixtureTable() *Table {
+	f := &tableFile{degPixels: 1, nodes: buildQuadtree(1, fixtureLevels()), numLeaves: 3}
+	f.leaves = "SFixture/West\x00" +
+		"SFixture/East\x00" +
+		"2\x00\x00\x00\x01\x0f\x0f\x0f\x0f\x0f\x0f\x0f\x0f"
+	var buf bytes.Buffer
+	if err := f.writeTo(&buf, false); err != nil {
+		panic(err)
+	}
+	t, err := parseTable(buf.String(), false)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// fixtureLevels returns fixtureTable's tiles as buildQuadtree takes
+// them.
+func fixtureLevels() (levels [6]string) {
+	tile := func(level uint8, x, y, idx uint16) string {
+		var b [6]byte
+		binary.BigEndian.PutUint32(b[:], uint32(newTileKey(level, x, y)))
+		binary.BigEndian.PutUint16(b[4:], idx)
+		return string(b[:])
+	}
+	levels[5] = tile(5, 0, 0, 0)
+	levels[0] = tile(0, 33, 5, 2) + tile(0, 40, 10, 1) // sorted by tileKey
+	return levels
+}
+
+func TestTableFixture(t *testing.T) {
+	tb := fixtureTable()
+	if err := tb.Init(); err != nil {
+		t.Fatal(err)
+	}
+	cases := []struct {
+		x, y int
+		want string
+	}{
+		{0, 0, "Fixture/West"},
+		{255, 179, "Fixture/West"},
+		{320, 80, "Fixture/East"},
+		{327, 87, "Fixture/East"},
+		{328, 80, ""},
+		{264, 40, "Fixture/East"},
+		{267, 47, "Fixture/East"},
+		{268, 40, "Fixture/West"},
+		{300, 100, ""},
+	}
+	for _, tt := range cases {
+		if got := tb.lookupPixel(tt.x, tt.y); got != tt.want {
+			t.Errorf("lookupPixel(%d, %d) = %q; want %q", tt.x, tt.y, got, tt.want)
+		}
+	}
+
+	// Pixel (320, 80) is at 140E, 10N.
+	if got := tb.LookupZoneName(9.5, 140.5); got != "Fixture/East" {
+		t.Errorf("LookupZoneName(9.5, 140.5) = %q; want Fixture/East", got)
+	}
+}
+
+func TestTablesSideBySide(t *testing.T) {
+	fix := fixtureTable()
+	lat, long := 37.7833, -122.4167
+	if got := fix.LookupZoneName(lat, long); got != "Fixture/West" {
+		t.Errorf("fixture LookupZoneName = %q; want Fixture/West", got)
+	}
+	if got := Default().LookupZoneName(lat, long); got != "America/Los_Angeles" {
+		t.Errorf("default LookupZoneName = %q; want America/Los_Angeles", got)
+	}
+}
+
+func TestSetDefault(t *testing.T) {
+	orig := Default()
+	defer SetDefault(orig)
+
+	if err := SetDefault(new(Table)); err != ErrNoTables {
+		t.Errorf("SetDefault(zero Table) = %v; want ErrNoTables", err)
+	}
+	if Default() != orig {
+		t.Fatal("SetDefault replaced the default with an unusable Table")
+	}
+
+	// Lookups running during the swap see one Table or the other.
+	fix := fixtureTable()
+	stop := make(chan bool)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+				}
+				if got := LookupZoneName(37.7833, -122.4167); got != "America/Los_Angeles" && got != "Fixture/West" {
+					t.Errorf("LookupZoneName during swap = %q", got)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 100; i++ {
+		SetDefault(fix)
+		SetDefault(orig)
+	}
+	if err := SetDefault(fix); err != nil {
+		t.Fatal(err)
+	}
+	close(stop)
+	wg.Wait()
+
+	if got := LookupZoneName(37.7833, -122.4167); got != "Fixture/West" {
+		t.Errorf("LookupZoneName after SetDefault = %q; want Fixture/West", got)
+	}
+	if Version() != fix.Version() {
+		t.Errorf("Version() = %q; want the new Table's %q", Version(), fix.Version())
+	}
+}
+
+func TestVersion(t *testing.T) {
+	v := Default().Version()
+	if len(v) != 12 {
+		t.Errorf("default Version = %q; want 12 hex digits", v)
+	}
+	loaded, err := LoadTable(strings.NewReader(defaultTableData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := loaded.Version(); got != v {
+		t.Errorf("Version of reloaded default data = %q; want %q", got, v)
+	}
+	if got := fixtureTable().Version(); got == v || len(got) != 12 {
+		t.Errorf("fixture Version = %q; default's is %q", got, v)
+	}
+	if got := new(Table).Version(); got != "" {
+		t.Errorf("zero Table Version = %q; want empty", got)
+	}
+}
+
+func TestZeroTable(t *testing.T) {
+	var tb Table
+	if err := tb.Init(); err != ErrNoTables {
+		t.Errorf("Init on zero Table = %v; want ErrNoTables", err)
+	}
+	if _, err := tb.LookupZoneNameErr(0, 0); err != ErrNoTables {
+		t.Errorf("LookupZoneNameErr on zero Table = %v; want ErrNoTables", err)
+	}
+}
+
+func TestLookupZoneNameNoAllocs(t *testing.T) {
+	n := testing.AllocsPerRun(100, func() {
+		LookupZoneName(37.7833, -122.4167)
+		LookupZoneName(-16.8, 179.95)
+	})
+	if n != 0 {
+		t.Errorf("LookupZoneName allocates %v times per call; want 0", n)
+	}
+}
+
+func BenchmarkLookupZoneName(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LookupZoneName(37.7833, -122.4167)
+	}
+}
@@ -0,0 +1,300 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package video
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxMoovSize is the largest moov box Decode reads. Real ones are at
+// most a few megabytes, even for long videos.
+const maxMoovSize = 64 << 20
+
+// A box is an ISO base media file format (QuickTime atom) box.
+type box struct {
+	typ  string
+	data []byte // contents, after the header
+}
+
+// readMoov returns the contents of the top-level moov box of the file
+// in r, skipping over the other boxes, such as mdat, without reading
+// them.
+func readMoov(r io.ReadSeeker) ([]byte, error) {
+	for {
+		var hdr [16]byte
+		if _, err := io.ReadFull(r, hdr[:8]); err != nil {
+			if err == io.EOF {
+				return nil, ErrNoMetadata
+			}
+			return nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+		}
+		size := int64(binary.BigEndian.Uint32(hdr[:]))
+		typ := string(hdr[4:8])
+		hdrLen := int64(8)
+		switch size {
+		case 0: // to the end of the file
+			if typ != "moov" {
+				return nil, ErrNoMetadata
+			}
+		case 1:
+			if _, err := io.ReadFull(r, hdr[8:16]); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+			}
+			size = int64(binary.BigEndian.Uint64(hdr[8:]))
+			hdrLen = 16
+		}
+		if size != 0 && size < hdrLen {
+			return nil, fmt.Errorf("%w: %q box of size %d", ErrCorrupt, typ, size)
+		}
+		if typ != "moov" {
+			if _, err := r.Seek(size-hdrLen, io.SeekCurrent); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if size == 0 {
+			b, err := ioutil.ReadAll(io.LimitReader(r, maxMoovSize+1))
+			if err != nil {
+				return nil, err
+			}
+			if len(b) > maxMoovSize {
+				return nil, fmt.Errorf("%w: moov box too big", ErrCorrupt)
+			}
+			return b, nil
+		}
+		if size-hdrLen > maxMoovSize {
+			return nil, fmt.Errorf("%w: moov box too big", ErrCorrupt)
+		}
+		b := make([]byte, size-hdrLen)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, fmt.Errorf("%w: truncated moov box: %v", ErrCorrupt, err)
+		}
+		return b, nil
+	}
+}
+
+// boxes splits b into the boxes it contains.
+func boxes(b []byte) ([]box, error) {
+	var bs []box
+	for len(b) > 0 {
+		if len(b) < 8 {
+			return nil, fmt.Errorf("%w: truncated box header", ErrCorrupt)
+		}
+		size := uint64(binary.BigEndian.Uint32(b))
+		typ := string(b[4:8])
+		hdrLen := uint64(8)
+		switch size {
+		case 0:
+			size = uint64(len(b))
+		case 1:
+			if len(b) < 16 {
+				return nil, fmt.Errorf("%w: truncated box header", ErrCorrupt)
+			}
+			size, hdrLen = binary.BigEndian.Uint64(b[8:]), 16
+		}
+		if size < hdrLen || size > uint64(len(b)) {
+			return nil, fmt.Errorf("%w: %q box of size %d", ErrCorrupt, typ, size)
+		}
+		bs = append(bs, box{typ: typ, data: b[hdrLen:size]})
+		b = b[size:]
+	}
+	return bs, nil
+}
+
+// child returns the contents of the first box of type typ in b.
+func child(b []byte, typ string) ([]byte, bool, error) {
+	bs, err := boxes(b)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, bx := range bs {
+		if bx.typ == typ {
+			return bx.data, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// mp4Epoch is the start of the times in mvhd boxes.
+var mp4Epoch = time.Date(1904, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// parseMvhd returns the creation time in an mvhd box, or the zero time
+// if it is unset.
+func parseMvhd(b []byte) (time.Time, error) {
+	if len(b) < 4 {
+		return time.Time{}, fmt.Errorf("%w: truncated mvhd box", ErrCorrupt)
+	}
+	var secs uint64
+	switch b[0] {
+	case 0:
+		if len(b) < 8 {
+			return time.Time{}, fmt.Errorf("%w: truncated mvhd box", ErrCorrupt)
+		}
+		secs = uint64(binary.BigEndian.Uint32(b[4:]))
+	case 1:
+		if len(b) < 12 {
+			return time.Time{}, fmt.Errorf("%w: truncated mvhd box", ErrCorrupt)
+		}
+		secs = binary.BigEndian.Uint64(b[4:])
+	default:
+		return time.Time{}, fmt.Errorf("%w: mvhd version %d", ErrCorrupt, b[0])
+	}
+	if secs == 0 || secs > 1<<40 {
+		return time.Time{}, nil
+	}
+	return mp4Epoch.Add(time.Duration(secs) * time.Second), nil
+}
+
+// parseXYZ returns the string in a QuickTime ©xyz box: a 2 byte length,
+// a 2 byte language code and the text.
+func parseXYZ(b []byte) string {
+	if len(b) < 4 {
+		return ""
+	}
+	n := int(binary.BigEndian.Uint16(b))
+	if n > len(b)-4 {
+		n = len(b) - 4
+	}
+	return string(b[4 : 4+n])
+}
+
+// parseMetaStrings returns the string values of a QuickTime meta box's
+// metadata items, keyed by name, such as
+// "com.apple.quicktime.location.ISO6709".
+func parseMetaStrings(meta []byte) (map[string]string, error) {
+	// In MP4 files, but not QuickTime ones, meta is a full box with
+	// a version and flags before its children.
+	if len(meta) >= 4 && binary.BigEndian.Uint32(meta) == 0 {
+		meta = meta[4:]
+	}
+	keysBox, ok, err := child(meta, "keys")
+	if err != nil || !ok {
+		return nil, err
+	}
+	ilst, ok, err := child(meta, "ilst")
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if len(keysBox) < 8 {
+		return nil, fmt.Errorf("%w: truncated keys box", ErrCorrupt)
+	}
+	var keys []string
+	b := keysBox[8:] // version, flags and count
+	for len(b) >= 8 {
+		n := int(binary.BigEndian.Uint32(b))
+		if n < 8 || n > len(b) {
+			return nil, fmt.Errorf("%w: bad keys entry", ErrCorrupt)
+		}
+		keys = append(keys, string(b[8:n]))
+		b = b[n:]
+	}
+
+	items, err := boxes(ilst)
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[string]string)
+	for _, item := range items {
+		idx := int(binary.BigEndian.Uint32([]byte(item.typ)))
+		if idx < 1 || idx > len(keys) {
+			continue
+		}
+		data, ok, err := child(item.data, "data")
+		if err != nil {
+			return nil, err
+		}
+		// A 4 byte type, 1 for UTF-8, and a 4 byte locale.
+		if ok && len(data) >= 8 && binary.BigEndian.Uint32(data) == 1 {
+			m[keys[idx-1]] = string(data[8:])
+		}
+	}
+	return m, nil
+}
+
+// parseISO6709 parses an ISO 6709 point, such as "+37.7833-122.4167/"
+// or "+3746.998-12225.002+012.3/", with degrees, degrees and minutes,
+// or degrees, minutes and seconds, and an optional altitude.
+func parseISO6709(s string) (lat, long float64, ok bool) {
+	s = strings.TrimSuffix(strings.TrimSpace(s), "/")
+	var parts []string
+	for len(s) > 0 {
+		if s[0] != '+' && s[0] != '-' {
+			return 0, 0, false
+		}
+		end := strings.IndexAny(s[1:], "+-") + 1
+		if end == 0 {
+			end = len(s)
+		}
+		parts = append(parts, s[:end])
+		s = s[end:]
+	}
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, false
+	}
+	lat, ok1 := parseISO6709Angle(parts[0], 2)
+	long, ok2 := parseISO6709Angle(parts[1], 3)
+	if !ok1 || !ok2 || lat < -90 || lat > 90 || long < -180 || long > 180 {
+		return 0, 0, false
+	}
+	return lat, long, true
+}
+
+// parseISO6709Angle parses a signed ISO 6709 angle whose degrees have
+// degDigits digits, followed by optional minutes and seconds digits.
+func parseISO6709Angle(s string, degDigits int) (float64, bool) {
+	sign, s := s[0], s[1:]
+	intLen := strings.IndexByte(s, '.')
+	if intLen < 0 {
+		intLen = len(s)
+	}
+	var deg, minutes, seconds float64
+	var err error
+	switch intLen {
+	case degDigits:
+		deg, err = strconv.ParseFloat(s, 64)
+	case degDigits + 2:
+		deg, err = strconv.ParseFloat(s[:degDigits], 64)
+		if err == nil {
+			minutes, err = strconv.ParseFloat(s[degDigits:], 64)
+		}
+	case degDigits + 4:
+		deg, err = strconv.ParseFloat(s[:degDigits], 64)
+		if err == nil {
+			minutes, err = strconv.ParseFloat(s[degDigits:degDigits+2], 64)
+		}
+		if err == nil {
+			seconds, err = strconv.ParseFloat(s[degDigits+2:], 64)
+		}
+	default:
+		return 0, false
+	}
+	if err != nil || minutes >= 60 || seconds >= 60 {
+		return 0, false
+	}
+	v := deg + minutes/60 + seconds/3600
+	if sign == '-' {
+		v = -v
+	}
+	return v, true
+}
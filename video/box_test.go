@@ -0,0 +1,92 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package video
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseISO6709(t *testing.T) {
+	cases := []struct {
+		s         string
+		lat, long float64
+		ok        bool
+	}{
+		{"+37.7833-122.4167/", 37.7833, -122.4167, true},
+		{"+37.7833-122.4167+012.345/", 37.7833, -122.4167, true},
+		{"-33.8700+151.2100", -33.87, 151.21, true},
+		{"+3746.998-12225.002/", 37 + 46.998/60, -(122 + 25.002/60), true},
+		{"+374659.88-1222500.1/", 37 + 46.0/60 + 59.88/3600, -(122 + 25.0/60 + 0.1/3600), true},
+		{"+37.7833/", 0, 0, false},
+		{"37.7833-122.4167/", 0, 0, false},
+		{"+3760.0-12225.0/", 0, 0, false},
+		{"+97.0+000.0/", 0, 0, false},
+		{"+1.5+000.0/", 0, 0, false},
+		{"", 0, 0, false},
+	}
+	for _, tt := range cases {
+		lat, long, ok := parseISO6709(tt.s)
+		if ok != tt.ok || math.Abs(lat-tt.lat) > 1e-9 || math.Abs(long-tt.long) > 1e-9 {
+			t.Errorf("parseISO6709(%q) = %v, %v, %v; want %v, %v, %v", tt.s, lat, long, ok, tt.lat, tt.long, tt.ok)
+		}
+	}
+}
+
+func TestParseMvhd(t *testing.T) {
+	want := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	secs := uint64(want.Sub(mp4Epoch) / time.Second)
+
+	v0 := make([]byte, 100)
+	binary.BigEndian.PutUint32(v0[4:], uint32(secs))
+	v1 := make([]byte, 112)
+	v1[0] = 1
+	binary.BigEndian.PutUint64(v1[4:], secs)
+	for _, b := range [][]byte{v0, v1} {
+		if got, err := parseMvhd(b); err != nil || !got.Equal(want) {
+			t.Errorf("parseMvhd(version %d) = %v, %v; want %v", b[0], got, err, want)
+		}
+	}
+	if got, err := parseMvhd(make([]byte, 100)); err != nil || !got.IsZero() {
+		t.Errorf("parseMvhd(unset) = %v, %v; want zero", got, err)
+	}
+	if _, err := parseMvhd(v0[:6]); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("parseMvhd(truncated) error = %v; want ErrCorrupt", err)
+	}
+}
+
+func TestBoxes(t *testing.T) {
+	b := append(mkbox("free", []byte("abc")), mkbox("skip", nil)...)
+	bs, err := boxes(b)
+	if err != nil || len(bs) != 2 || bs[0].typ != "free" || string(bs[0].data) != "abc" || bs[1].typ != "skip" {
+		t.Errorf("boxes = %+v, %v", bs, err)
+	}
+
+	large := []byte("\x00\x00\x00\x01free\x00\x00\x00\x00\x00\x00\x00\x13xyz")
+	if bs, err := boxes(large); err != nil || len(bs) != 1 || string(bs[0].data) != "xyz" {
+		t.Errorf("boxes(64-bit size) = %+v, %v", bs, err)
+	}
+
+	for _, bad := range [][]byte{b[:5], b[:len(b)-1], []byte("\x00\x00\x00\x04free")} {
+		if _, err := boxes(bad); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("boxes(%q) error = %v; want ErrCorrupt", bad, err)
+		}
+	}
+}
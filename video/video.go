@@ -0,0 +1,212 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package video reads the time and place a video was recorded from
+// the metadata of its MP4 or QuickTime (MOV) file, and works out its
+// time zone and local and UTC creation times.
+package video
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/bradfitz/latlong"
+)
+
+var (
+	// ErrNoMetadata is returned by Decode for a file with no moov
+	// box, such as one that isn't an MP4 or QuickTime file.
+	ErrNoMetadata = errors.New("video: no moov box")
+
+	// ErrCorrupt is returned by Decode when the file is malformed.
+	// Errors wrapping it describe what was wrong.
+	ErrCorrupt = errors.New("video: corrupt file")
+
+	// ErrNoTime is returned by CreationTime when the metadata doesn't
+	// say when the video was recorded.
+	ErrNoTime = errors.New("video: no creation time")
+)
+
+// Metadata keys written by Apple devices.
+const (
+	keyLocation     = "com.apple.quicktime.location.ISO6709"
+	keyCreationDate = "com.apple.quicktime.creationdate"
+)
+
+// Metadata is the time and place metadata of a video.
+type Metadata struct {
+	// Lat and Long are the recording position, in degrees, if
+	// HasGPS, from the ©xyz box or Apple location metadata.
+	Lat, Long float64
+	HasGPS    bool
+
+	// Created is the creation time in the mvhd box, or zero if it is
+	// unset. The spec says that it is UTC, but many cameras write
+	// their local time; see CreationTime.
+	Created time.Time
+
+	// Local and Offset are the local time and its offset in seconds
+	// east of UTC, if HasOffset, from Apple creation date metadata.
+	// Only Local's date and clock fields are meaningful.
+	Local     time.Time
+	Offset    int
+	HasOffset bool
+}
+
+// Decode reads the metadata of an MP4 or QuickTime file. It seeks past
+// the media data rather than reading it.
+func Decode(r io.ReadSeeker) (*Metadata, error) {
+	moov, err := readMoov(r)
+	if err != nil {
+		return nil, err
+	}
+	var m Metadata
+	if mvhd, ok, err := child(moov, "mvhd"); err != nil {
+		return nil, err
+	} else if ok {
+		if m.Created, err = parseMvhd(mvhd); err != nil {
+			return nil, err
+		}
+	}
+
+	if meta, ok, err := child(moov, "meta"); err != nil {
+		return nil, err
+	} else if ok {
+		strs, err := parseMetaStrings(meta)
+		if err != nil {
+			return nil, err
+		}
+		if lat, long, ok := parseISO6709(strs[keyLocation]); ok {
+			m.Lat, m.Long, m.HasGPS = lat, long, true
+		}
+		if t, err := time.Parse("2006-01-02T15:04:05Z0700", strs[keyCreationDate]); err == nil {
+			_, m.Offset = t.Zone()
+			m.Local = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+			m.HasOffset = true
+		}
+	}
+
+	if udta, ok, err := child(moov, "udta"); err != nil {
+		return nil, err
+	} else if ok && !m.HasGPS {
+		if xyz, ok, err := child(udta, "\xa9xyz"); err != nil {
+			return nil, err
+		} else if ok {
+			if lat, long, ok := parseISO6709(parseXYZ(xyz)); ok {
+				m.Lat, m.Long, m.HasGPS = lat, long, true
+			}
+		}
+	}
+	return &m, nil
+}
+
+// A Source says where a CreationTime came from.
+type Source int
+
+const (
+	// FromOffset means the video recorded its local time and UTC
+	// offset.
+	FromOffset Source = iota + 1
+
+	// FromUTC means the mvhd creation time was read as UTC.
+	FromUTC
+
+	// FromZone means the mvhd creation time was read as local time
+	// in the time zone at the video's position.
+	FromZone
+)
+
+func (s Source) String() string {
+	switch s {
+	case FromOffset:
+		return "offset"
+	case FromUTC:
+		return "utc"
+	case FromZone:
+		return "zone"
+	}
+	return "unknown"
+}
+
+// A CreationTime is when a video was recorded.
+type CreationTime struct {
+	UTC time.Time
+
+	// Local is the same instant in the time zone at the video's
+	// position if it has one, or else at the recorded offset.
+	Local time.Time
+
+	// Zone is the time zone at the video's position, or "" if it has
+	// none.
+	Zone string
+
+	Source Source
+
+	// Ambiguous is set if Source is FromZone and the local time was
+	// repeated or skipped by a DST change. See
+	// latlong.LocalConversion.
+	Ambiguous bool
+}
+
+// CreationTime returns when the video was recorded. It prefers Apple's
+// creation date, which has a UTC offset, and otherwise uses the mvhd
+// time: as UTC, as the spec says, or, if mvhdIsLocal, as local time in
+// the zone at the video's position, as many cameras write it. It
+// returns ErrNoTime if the needed fields are missing, or the error from
+// the latlong lookup.
+func (m *Metadata) CreationTime(mvhdIsLocal bool) (CreationTime, error) {
+	var c CreationTime
+	var loc *time.Location
+	if m.HasGPS {
+		var err error
+		if loc, err = latlong.LookupLocation(m.Lat, m.Long); err != nil && err != latlong.ErrNoZone {
+			return CreationTime{}, err
+		}
+		if loc != nil {
+			c.Zone = loc.String()
+		}
+	}
+
+	switch {
+	case m.HasOffset:
+		c.Source = FromOffset
+		c.UTC = m.Local.Add(-time.Duration(m.Offset) * time.Second)
+		if loc == nil {
+			loc = time.FixedZone("", m.Offset)
+		}
+	case m.Created.IsZero():
+		return CreationTime{}, ErrNoTime
+	case !mvhdIsLocal:
+		c.Source = FromUTC
+		c.UTC = m.Created
+		if loc == nil {
+			loc = time.UTC
+		}
+	case loc == nil:
+		return CreationTime{}, ErrNoTime
+	default:
+		lc, err := latlong.LocalToUTC(m.Lat, m.Long, m.Created)
+		if err != nil {
+			return CreationTime{}, err
+		}
+		c.Source = FromZone
+		c.UTC = lc.Time.UTC()
+		c.Ambiguous = lc.Ambiguous || lc.Nonexistent
+	}
+	c.Local = c.UTC.In(loc)
+	return c, nil
+}
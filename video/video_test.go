@@ -0,0 +1,156 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package video
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+// mkbox returns a box of type typ containing data and then children.
+func mkbox(typ string, data []byte, children ...[]byte) []byte {
+	for _, c := range children {
+		data = append(data, c...)
+	}
+	b := make([]byte, 8, 8+len(data))
+	binary.BigEndian.PutUint32(b, uint32(8+len(data)))
+	copy(b[4:], typ)
+	return append(b, data...)
+}
+
+func mvhd(t time.Time) []byte {
+	b := make([]byte, 100)
+	binary.BigEndian.PutUint32(b[4:], uint32(t.Sub(mp4Epoch)/time.Second))
+	return mkbox("mvhd", b)
+}
+
+func xyz(s string) []byte {
+	b := []byte{0, byte(len(s)), 0x15, 0xc7} // length, language
+	return mkbox("\xa9xyz", append(b, s...))
+}
+
+// appleMeta returns a QuickTime meta box with string items.
+func appleMeta(items map[string]string) []byte {
+	var keys, ilst []byte
+	keys = append(keys, 0, 0, 0, 0, 0, 0, 0, byte(len(items)))
+	i := 0
+	for k, v := range items {
+		i++
+		keys = append(keys, mkbox("mdta", []byte(k))...)
+		data := mkbox("data", append([]byte{0, 0, 0, 1, 0, 0, 0, 0}, v...))
+		ilst = append(ilst, mkbox(string([]byte{0, 0, 0, byte(i)}), data)...)
+	}
+	return mkbox("meta", nil,
+		mkbox("hdlr", make([]byte, 24)),
+		mkbox("keys", keys),
+		mkbox("ilst", ilst))
+}
+
+// movie returns a file with an ftyp, a media data box and then a moov
+// box with the given children, as phones write them.
+func movie(moovChildren ...[]byte) []byte {
+	var b []byte
+	b = append(b, mkbox("ftyp", []byte("qt  \x00\x00\x00\x00qt  "))...)
+	b = append(b, mkbox("mdat", make([]byte, 1<<16))...)
+	return append(b, mkbox("moov", nil, moovChildren...)...)
+}
+
+func TestDecode(t *testing.T) {
+	created := time.Date(2023, 7, 1, 19, 0, 0, 0, time.UTC)
+	m, err := Decode(bytes.NewReader(movie(
+		mvhd(created),
+		mkbox("udta", nil, xyz("+37.7833-122.4167+010.000/")),
+	)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.HasGPS || m.Lat != 37.7833 || m.Long != -122.4167 || !m.Created.Equal(created) || m.HasOffset {
+		t.Errorf("Decode = %+v", m)
+	}
+
+	m, err = Decode(bytes.NewReader(movie(
+		mvhd(created),
+		appleMeta(map[string]string{
+			keyLocation:                "+48.8583+002.2945+035.000/",
+			keyCreationDate:            "2023-07-01T21:00:00+0200",
+			"com.apple.quicktime.make": "Apple",
+		}),
+		mkbox("udta", nil, xyz("+37.7833-122.4167/")),
+	)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(m.Lat-48.8583) > 1e-9 || !m.HasOffset || m.Offset != 7200 ||
+		!m.Local.Equal(time.Date(2023, 7, 1, 21, 0, 0, 0, time.UTC)) {
+		t.Errorf("Decode(Apple) = %+v", m)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	if _, err := Decode(bytes.NewReader(mkbox("ftyp", []byte("isom")))); err != ErrNoMetadata {
+		t.Errorf("no moov: error = %v; want ErrNoMetadata", err)
+	}
+	truncated := movie(mvhd(time.Now()))
+	truncated = truncated[:len(truncated)-10]
+	if _, err := Decode(bytes.NewReader(truncated)); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("truncated: error = %v; want ErrCorrupt", err)
+	}
+	badChild := movie(mkbox("mvhd", nil)[:4])
+	if _, err := Decode(bytes.NewReader(badChild)); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("bad child: error = %v; want ErrCorrupt", err)
+	}
+}
+
+func TestCreationTime(t *testing.T) {
+	wall := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	sf := Metadata{Lat: 37.7833, Long: -122.4167, HasGPS: true, Created: wall}
+	cases := []struct {
+		name        string
+		m           Metadata
+		mvhdIsLocal bool
+		utc         time.Time
+		localHour   int
+		zone        string
+		source      Source
+	}{
+		{"utc", sf, false, wall, 5, "America/Los_Angeles", FromUTC},
+		{"local", sf, true, wall.Add(7 * time.Hour), 12, "America/Los_Angeles", FromZone},
+		{"no position", Metadata{Created: wall}, false, wall, 12, "", FromUTC},
+		{"offset", Metadata{Local: wall, Offset: 3600, HasOffset: true, Created: wall}, true, wall.Add(-time.Hour), 12, "", FromOffset},
+	}
+	for _, tt := range cases {
+		c, err := tt.m.CreationTime(tt.mvhdIsLocal)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !c.UTC.Equal(tt.utc) || c.Local.Hour() != tt.localHour || c.Zone != tt.zone || c.Source != tt.source {
+			t.Errorf("%s: CreationTime = %+v", tt.name, c)
+		}
+	}
+
+	if _, err := (&Metadata{Created: wall}).CreationTime(true); err != ErrNoTime {
+		t.Errorf("local without position: error = %v; want ErrNoTime", err)
+	}
+	if _, err := (&Metadata{}).CreationTime(false); err != ErrNoTime {
+		t.Errorf("no time: error = %v; want ErrNoTime", err)
+	}
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// ErrInvalidRadius is returned by LookupWithin and NearestZone for a
+// radius or distance that is NaN, infinite or negative.
+var ErrInvalidRadius = errors.New("latlong: invalid radius")
+
+// earthRadius is the mean radius of the Earth, in meters.
+const earthRadius = 6371008.8
+
+// A Candidate is a zone that may be the one at an uncertain location.
+type Candidate struct {
+	Zone string // "" for no zone, such as the ocean
+	ID   ZoneID
+
+	// Fraction is the fraction of the area of the circle of
+	// uncertainty that is in the zone, from 0 to 1.
+	Fraction float64
+}
+
+// LookupWithin returns the zones within radiusMeters of the given
+// latitude and longitude, such as those a GPS fix with that accuracy
+// might be in, each with the fraction of the circle it covers. They
+// are sorted from the most likely, and their fractions sum to 1. The
+// part of the circle with no zone, if any, is a Candidate with an
+// empty Zone.
+//
+// The circle is a geodesic one on a spherical Earth and may cross the
+// antimeridian or a pole. A radius of zero, or one so small that it
+// falls between samples, returns just the zone at the coordinate.
+// LookupWithin returns the errors LookupZoneNameErr would, and
+// ErrInvalidRadius.
+func LookupWithin(lat, long, radiusMeters float64) ([]Candidate, error) {
+	return Default().LookupWithin(lat, long, radiusMeters)
+}
+
+// LookupWithin is like the package-level LookupWithin but uses t.
+func (t *Table) LookupWithin(lat, long, radiusMeters float64) ([]Candidate, error) {
+	lat, long, err := Normalize(lat, long)
+	if err != nil {
+		return nil, err
+	}
+	if math.IsNaN(radiusMeters) || math.IsInf(radiusMeters, 0) || radiusMeters < 0 {
+		return nil, ErrInvalidRadius
+	}
+	if err := t.Init(); err != nil {
+		return nil, err
+	}
+
+	dp := float64(t.degPixels)
+	w, h := 360*t.degPixels, 180*t.degPixels
+	angle := math.Min(radiusMeters/earthRadius, math.Pi) // in radians
+	phi0 := lat * math.Pi / 180
+	cosAngle := math.Cos(angle)
+
+	// Sample each pixel row along a few lines of latitude, so that
+	// a circle only a few pixels across is still measured fairly,
+	// and sum the length of each line inside the circle, weighted by
+	// the cosine of its latitude, per zone.
+	dLat := angle * 180 / math.Pi
+	y0 := int(math.Max(0, math.Floor((90-lat-dLat)*dp)))
+	y1 := int(math.Min(float64(h-1), math.Floor((90-lat+dLat)*dp)))
+	lines := int(math.Ceil(16 / (2 * dLat * dp)))
+	if lines < 1 {
+		lines = 1
+	} else if lines > 64 {
+		lines = 64
+	}
+	weight := make(map[uint16]float64)
+	for y := y0; y <= y1; y++ {
+		for j := 0; j < lines; j++ {
+			phi := (90 - (float64(y)+(float64(j)+0.5)/float64(lines))/dp) * math.Pi / 180
+			// The circle spans longitudes long±dLong along this line,
+			// where cos(angle) = sin(phi)sin(phi0) +
+			// cos(phi)cos(phi0)cos(dLong).
+			c := (cosAngle - math.Sin(phi)*math.Sin(phi0)) / (math.Cos(phi) * math.Cos(phi0))
+			var a, b float64 // pixel x span, which may run off either edge
+			switch {
+			case c > 1 || math.IsNaN(c):
+				continue
+			case c <= -1:
+				a, b = 0, float64(w)
+			default:
+				dLong := math.Acos(c) * 180 / math.Pi
+				a, b = (long-dLong+180)*dp, (long+dLong+180)*dp
+				if b-a > float64(w) {
+					a, b = 0, float64(w)
+				}
+			}
+			t.addSpan(weight, y, a, b, math.Cos(phi))
+		}
+	}
+
+	var total float64
+	for _, v := range weight {
+		total += v
+	}
+	if total == 0 {
+		x, y := t.latLongPixel(lat, long)
+		zone := t.pixelZone(x, y)
+		return []Candidate{{Zone: t.zoneName(zone), ID: zoneID(zone), Fraction: 1}}, nil
+	}
+	cands := make([]Candidate, 0, len(weight))
+	for zone, v := range weight {
+		cands = append(cands, Candidate{
+			Zone:     t.zoneName(zone),
+			ID:       zoneID(zone),
+			Fraction: v / total,
+		})
+	}
+	sort.Slice(cands, func(i, j int) bool {
+		if cands[i].Fraction != cands[j].Fraction {
+			return cands[i].Fraction > cands[j].Fraction
+		}
+		return cands[i].ID < cands[j].ID
+	})
+	return cands, nil
+}
+
+// addSpan adds to weight[zone], for each static zone index or
+// oceanIndex, the length in pixels of the part of the span [a, b) of
+// row y in that zone, times scale. The span may extend up to a world's
+// width past either edge, and wraps around the antimeridian.
+func (t *Table) addSpan(weight map[uint16]float64, y int, a, b, scale float64) {
+	t.spanRuns(y, int(math.Floor(a)), int(math.Ceil(b)), func(p, run int, zone uint16) {
+		weight[zone] += (math.Min(b, float64(p+run)) - math.Max(a, float64(p))) * scale
+	})
+}
+
+// spanRuns calls fn for each run of pixels p to p+run-1 in [p0, p1) of
+// row y that are in the same zone, a static zone index or oceanIndex.
+// Pixel p is pixel p mod the world's width, so the span can wrap
+// around the antimeridian. Tiles with a single zone, or none, are one
+// run rather than a pixel each.
+func (t *Table) spanRuns(y, p0, p1 int, fn func(p, run int, zone uint16)) {
+	w := 360 * t.degPixels
+	for p := p0; p < p1; {
+		x := ((p % w) + w) % w
+		n, shift := t.tileNode(x, y)
+		zone, run := oceanIndex, (x>>shift+1)<<shift-x
+		if n != nodeEmpty {
+			idx := uint16(n &^ nodeLeaf)
+			zone = t.leafZone(idx, x, y)
+			if typ, _ := t.leaf(idx); typ != leafStatic {
+				run = 1
+			}
+		}
+		if x+run > w {
+			run = w - x
+		}
+		if p+run > p1 {
+			run = p1 - p
+		}
+		fn(p, run, zone)
+		p += run
+	}
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLookupWithinFixture(t *testing.T) {
+	fix := fixtureTable()
+	cases := []struct {
+		name              string
+		lat, long, radius float64
+		west              float64 // expected fraction in Fixture/West
+	}{
+		{"inside", 0, 0, 200e3, 1},
+		{"on the eastern edge", 10, 76, 200e3, 0.5},
+		{"across the antimeridian", -20, -180, 300e3, 0.5},
+		{"around the north pole", 90, 0, 500e3, 256.0 / 360},
+		{"south pole", -89.9, 10, 1000e3, 256.0 / 360},
+	}
+	for _, tt := range cases {
+		cands, err := fix.LookupWithin(tt.lat, tt.long, tt.radius)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		got := map[string]float64{}
+		sum := 0.0
+		for _, c := range cands {
+			got[c.Zone] = c.Fraction
+			sum += c.Fraction
+		}
+		if math.Abs(got["Fixture/West"]-tt.west) > 1e-9 || math.Abs(got[""]-(1-tt.west)) > 1e-9 || math.Abs(sum-1) > 1e-9 {
+			t.Errorf("%s: LookupWithin = %+v; want %v Fixture/West, the rest ocean", tt.name, cands, tt.west)
+		}
+	}
+
+	// Inside and around Fixture/East's 8 degree square.
+	cands, err := fix.LookupWithin(6, 144, 300e3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cands) != 1 || cands[0].Zone != "Fixture/East" || cands[0].Fraction != 1 {
+		t.Errorf("inside Fixture/East: %+v", cands)
+	}
+	cands, err = fix.LookupWithin(6, 144, 600e3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cands) != 2 || cands[0].Zone != "Fixture/East" || cands[1].Zone != "" || cands[0].Fraction < 0.5 {
+		t.Errorf("around Fixture/East: %+v", cands)
+	}
+}
+
+func TestLookupWithin(t *testing.T) {
+	// A small circle well inside a zone.
+	cands, err := LookupWithin(48.8566, 2.3522, 2000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cands) != 1 || cands[0].Zone != "Europe/Paris" || cands[0].Fraction != 1 {
+		t.Errorf("Paris within 2km = %+v", cands)
+	}
+
+	// Strasbourg is on the Rhine, the border with Germany.
+	cands, err = LookupWithin(48.5734, 7.7521, 10e3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]float64{}
+	for i, c := range cands {
+		got[c.Zone] = c.Fraction
+		if i > 0 && c.Fraction > cands[i-1].Fraction {
+			t.Errorf("candidates not sorted: %+v", cands)
+		}
+		if id, _ := ParseZoneID(c.Zone); c.ID != id {
+			t.Errorf("candidate %q has ID %d", c.Zone, c.ID)
+		}
+	}
+	if cands[0].Zone != "Europe/Paris" || got["Europe/Berlin"] < 0.05 || len(got) != 2 {
+		t.Errorf("Strasbourg within 10km = %+v", cands)
+	}
+
+	// The whole world is mostly ocean, even with territorial waters
+	// in zones.
+	cands, err = LookupWithin(0, 0, math.Pi*earthRadius)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cands[0].Zone != "" || cands[0].Fraction < 0.4 || len(cands) < 400 {
+		t.Errorf("whole world: %d candidates, first %+v", len(cands), cands[0])
+	}
+}
+
+func TestLookupWithinPoint(t *testing.T) {
+	for _, p := range []Point{{37.7833, -122.4167}, {-16.8, 179.95}, {27.5, -55}} {
+		for _, radius := range []float64{0, 1} {
+			cands, err := LookupWithin(p.Lat, p.Long, radius)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := Candidate{Zone: LookupZoneName(p.Lat, p.Long), ID: LookupZoneID(p.Lat, p.Long), Fraction: 1}
+			if len(cands) != 1 || cands[0] != want {
+				t.Errorf("LookupWithin(%v, %v, %v) = %+v; want %+v", p.Lat, p.Long, radius, cands, want)
+			}
+		}
+	}
+}
+
+func TestLookupWithinErrors(t *testing.T) {
+	for _, radius := range []float64{-1, math.NaN(), math.Inf(1)} {
+		if _, err := LookupWithin(0, 0, radius); err != ErrInvalidRadius {
+			t.Errorf("LookupWithin radius %v error = %v; want ErrInvalidRadius", radius, err)
+		}
+	}
+	if _, err := LookupWithin(100, 0, 10); err != ErrInvalidCoordinate {
+		t.Errorf("LookupWithin(100, 0) error = %v", err)
+	}
+	if _, err := new(Table).LookupWithin(0, 0, 10); err != ErrNoTables {
+		t.Errorf("LookupWithin on zero Table error = %v", err)
+	}
+}
+
+func BenchmarkLookupWithin10km(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		LookupWithin(48.5734, 7.7521, 10e3)
+	}
+}
@@ -1,30 +0,0 @@
-// Auto-generated file. See README or Makefile.
-
-package latlong
-
-func init() {
-	degPixels = 32
-	zoomLevels = [6]*zoomLevel{
-		5: &zoomLevel{
-			gzipData: "H4sIAAAAAAAA/yTPP2ukZRQF8HOf592sJtmdnUyyO7szk5lNMpnJzIgsaGF3a7E4oCD4CcRCkVWLoOEiJojgHxQE/yCCn0D8ArcRtfATWFso2GillTxnm1/xvufeex4Cu7ggcEPexHcEeniFwC3Zl3tyIPebvoWfCL8utcE15ZpyTbmm/EDewS+ED+VdeU+O5NieJ3wiD+VUzvAP4fflkTyWc7tH+MLuEr6UZ3LVDOBXIiqeJkI9Qw1Dbwy9MdQ21DbUNtQ29u2YiIPWKu7YC0SMWpNQw1DDmNqICLUKtQr1ibXubpqp66krqSupK6krOWhXUrfytj1HZLtiAJ6ioc0atvEjDWv8RcOmfY8tXNHiutzGi7S4gS9psWcTWgzkfbtNi7nt0GJhA1osmwn7mJY37ZCWQzyg5TF+o6Uy2TIFxd5nQZUz/M2CU31f2zcs/jg+ZfEenmXxgc1YfNoyrqSf4HcWn+M/Fj+VK/uQxdfNKPYBS+zgJZZY2rcscWbvsMTGvmZJWLCkSSWzym17jyV3ZB8vs+QYS5ac2BssObU3WfIIf7DkHP+y5AJ/suTKrlhybZ+wZNtfUewLVrSdFZ19xIprcheXrNi3OSum9horjux1VrRtFW1Pdc16ta9YvZOa8oM25WN7ldUP7S1WP7EHrD6XS1uy+so+Y/WNvcsasHPW0J7Qnrhml6yxZZ+zxmN2wRq7+J41buEH1ujLUdsfuhLaH9qfSqaSqf45xJA1Z/aQNZXMR8mFLVhzZU+y5sbO2QH2Njv08Aw7tFd0OLMn2GGtv8p4z07Zed9O2PkIQ3Y+tofsfCKnUnlXPpSMPTmQK/z8fwAAAP//lMWldFwEAAA=",
-		},
-		4: &zoomLevel{
-			gzipData: "H4sIAAAAAAAA/ySSy2ueaRnGr/t53/f50ulkmsQkzXFCBxwzObVf0pxnmsuZNOkhTfrlSMCFBVcKUvCAbuztoRUURStaWysKuhMX6sKd3OKioPgX6FZQoS7qRnQjz5XNb/G99/27rvtJCA7iF4T34AXhfegQ3q9fBsSzr2/jk4S/UxiaDE2GvsYofkdEmTE0WKShSzwnvoEXNJR5Q9sOaZgX37UDGt6zURq28G8atsUdG6Fhz4Zp2LduGg7EQ/GokAl/prHCcxqVSCWyt6RQWVQfLpYUys+bxUz52ZFHfkexuYlyupwup3eX/q4r/IJY3sf0Pqb3MR/Fl2g+hp/RfFyctks0nyuXets+TnM18RVxVVwT9QJ+TdwQKX5QOvumeF3UFa4r/JZ4W9RFflr6h5X+oStCV4SuiKHSMEbwhBZqFeoT75asUIdQVsgZcsY+/kOLA/EYL2lxgpdMSHjOhEosKQkX8Akm9Ih9+CUTLuL3TCiJCW/iU0yYsR0mlPSEkp6wJC6L6zbKhG38nQk3xNIh6T8h4UC5h+JRof76iefxBya+LnaL6kClU+kcFSfwWyZOGZmoJrxsK0yUn3K65l2TPmtHTH4Nf2LyDZHilg0wuba8Y11Mvm8XmaIbj5jiDbEP95iiHydMMSBO2y5TlMkKvbjHCn3iIE5YYRjPWGHGdllhVpwTr9gpK1y166ywKC6JmzbIijJQBg4VA+XhlN1ixVlUrNguBs7bEiuuWi8rnu1u4S+suC3etPOsvDSvvHSufECU2WV2mX3S3mLlU+hm5fL7PNqs/CpeZ+Vrxe/r4vslxT8QlehnKbfE2+KOvcbK79iHWMUFBCu9VRXKDeXGMJ6yikmbYBUzJTGUG3Oi0kPpofQgXrEKZcWOzMVfowffZo1ecRDfYY2L+AxrjOApa0yinzWmMMsaxV9jTiz+GsvWYo0V8T38izU28Io13hfvFj978Jg1e8V+UTZexShrykAZuI7/sea1skt5KA879oy1a9eH8YC1j+LXrP0yZlh7G9OsfaHYXE5fFGXzTcusXYYYwyHr0HysYpJ1rOGPrGOzpETHvs4GE/g5G8zhTTZYQmKDMtngo/grG5TJBtv4Gxvcxn/ZYEfcte+zwZ79lA0O7QkbnNi32HAYe2w4Ik7hHTachbGh/JSZG/Y5NpSfm/gHG14X5ecd/JMN5afMPLJvsqH8PoT7bHwJU2xck37XfsjGO+J+YYzhG2xiXFzAW2xiuczHVkmJbVG7oa0oWxlmX2ZGEstWxhJ6mbFin2XGqrgmrovFk3FDLLaMXXFP7Mh5Zj62R8w4ta8yE/YTZpp9kZmVPWXmCB4ycwIfY+YVXGJmW1y3zzNz2+4z845NM3NX3BPv2iQzlUKl8EA8KVk8ta8wu9mPmf01+xGzK8XH8DVmX8KHmX3H2swupx/bA2Y/KQyU3dBuJPsuc5y3h8xxCb9hjstYYI4rYluULZbt08yxZl9gDjUP+UP+OLbvsYWy1UL5vYWOTbKFffFAPJs5KeQ41tmi5ql57tpH2OKeeGyP2fJ5DLHlHZtny/fFg8IYwa/Yign8gK3QTCxgiF0ov3dxHGvsYsdm2eVj2GBXjNvbPKev5zCOzf8HAAD//6otxqIGCQAA",
-		},
-		3: &zoomLevel{
-			gzipData: "H4sIAAAAAAAA/yTWeYxe9XX/8fN97r3PPmObxQkh2Hg3eOGM1xnbwDc/4FfaRjgGY2Nqe7zbM94XbGyDOZideMcGL2xmX0IDTatKlVqdqKmQGqWL2v5RdVGlKmpSJakaWqlJqrY67/nnpUdw7zmfc+793rGKz5aPNck0HPk9T45pkvm4ABfiYhnWJPfhUvwGLgvzRPlcU54kyzTlqUjNTM1MzbxQ/khTXsT1VDPuMu4y7jLusul4C96KM5BqRjWbH9WsH0eqLZW1muwbuAzPyBea7CyeC52OTkeno9PR6TiyDaemDyCVnRmdav5S+orWZJx8rjUZjzfjBJyIUb8mU3GmfKw1mYWz8esyrDV2WBNLN2pNjssXWpOn8Ayeo8tIr8v4ZrpBa7kpr2ott2S+1jIZ2HmNndfyNJyOt+CtOAPJwBOpZTLkpfK7Wstb0nKt5SEkTyZJpnume36N7m+lHq3lt0MjiZHErsHr5Htas7GRyr6K46Kv3YwkNBIaCY2ERkIjoc1EElq8aTVbF6lsPT6aHtCaHcajkdNIa6cirZ2NnEZau4JkttfxDXyP5O/jB6EzhTOFM4UzhZPfJ0Qe3pMa70bN70uTtOZLkWy+Ma3Tmm/CrekurTk5nWz+JJ6JPE5CJ6FfpTt5/CP5gdb8Y/mBFlJipCokUhVyDV4v39NCxuIN8rkWnNlC+mSVFjJHjmkhc3Eezse1abkWMoiRsJBIWMjB9IAWcjzdqIU8I19oIZGt4E0r5HX5dy3k2yT5FD8LcxmpcoUk5D0sMglz7K3I5MzkzF/COB1FnoATcRJOxinILHl2zJJvQ0Xy5/WRPG+O5HkLMkXehsySH41Z8mFkrvwUMl0+hczI+1zkK8ik+R35Ly3yu/gevo8f4If4EX5LfqpF/iTk/S94/wve/8KY3ZjdmN2+jPEuFXYTjsPxyGaMzfAlLIzNGJsxNsP3sOB7WPA9LPgeFnwPC1sgr2hh/fK2FrYiTdDC2JjxxI292QZke8b2bE9szB6L/djjeAyfQDZmz+Jz+CJ+E0/gSYxvVGEXkK1y7gpjqxbfq8LYobFD+zT2xokrOHGFf1n+WAu/AcfJJi18PN6ME3AiTpJPtPDJOAWnyZ9o4dPxFmQbviJ9XQtfiWzD2YOzB05r4UOxAWcPfjCm9kPITvzJdJ0WfhyfQvbg7MFPy4+04BQXzox+Fd/CD5jxQ/wIP5afaslZLiUmLeUreJOs01JiulJiulJiolJmYp8c05JzXUrMVUo/LpbtWsqD6etaygpciWvTnVpKTFrKBtyIMWkpezDOSCmH8QgexcfwmPyvlhKTlvI8voAn5Udayjl8JX1FS74SpcTXteSvUsnXoORrUOax8ntaZibNTJpvRCbNTJqZMc+S72qZmTQzY16IzJiXp6xl3pCGtcxPyv9omcmWyZa/GXnyCSRhJmG+IH+rJWe85G9WmUcSXk1NLa2UeVraVyOPxZtWGqks3qvSpuBUJKHNjTxGHluCKyKVsXN7GFenuVrautSvpZHWNuIm3JqWaWnbcEc8BduJu/CR2LwdxEN4JFVa2mPyfS3tcXxa/kZLTmJpzG48F2N2Y3Y7hafxDJ6PPRjb4GyWNrKB9+NttA8w3tLS2YbfhGyDs1ZyykpnG86TcsU+ZDPOO+mLkM1w7kpndh9O92rpe9KQls5czkR+DJ9AQxI6z8vJ6fE3uuQcVZyXSmbLd7WS21CxDyNJxRmpZBEuxiXy+1rJ7RgnpZJV6UGtZDD1ayVD6V6tZBgPyp9rJYfwUTwu39dKnsLn5F+0kufxVLpeK3k5dbSSyFlJ/AWvJNJW+UZ5Vqv8VbwJx+EEWa9VnoiTcDJOl5Va5VvwVpyBzJVXRtr8EJI8xztT5f3yZ1rlA0jO/Ewaq1UmYX4ByZlP4xm8KP+sVb6El/HNyM+5qIxUNhVnRga7TTZoZSSxObhQLmtli2SfVrZEhrQyUtnD+Fu4GgfTKq1sS1qslbFnG05rtOLNr3jzK9uNe5CJjInscKq04hRUvP+VHcMn0PDpmNeY2p7FEzGjnURmN2Y3ZrcL8byMDRgbMDbAvw8r/n1Y2dvpS1r5BPmGVs42nG042/BZyE58XuzB5+MCZDPeH5vxAVyMS/ChdJ9Wzmaczfg6HE73aOXbkc34PmQbznTOdP4cPo/nYxZnIuc9dCbyV2MKTlDlb6RrtfI38SrGdHWJ97AufXJZ67Iy3ad12ZBWaV32y19pXY6kltblhPyd1uU0nsGzIe9wPWvcm6mQH0y/ofVMnbw26mSq5c1pgdbzrnSP1vNuPCB/ofV8CB9Fk3/Ten4SjyN980k8hWTIZMgvpY7WeUvrFvus2ypcLYXWbQ3GVuu2JbrbUPS1YdyOe9MYrRsdjY72YhqrdaO+XU5trduV0CfIu1r3abJS6z4bV0iP1p1ePihztM4TrPv6tFTrTkffisPy31r3nfIPWvfd8pda9wPR1x/Bg3gIj8hPtO5HkSROEr9Ihkt4JV2rDYlvSEMmyrvakPhuNCSyNWQ2RraGrMRI2JAt0qcNie4N2ZfGaEP242H5iTbkCB5Fk59rQ2IbjTxeXBt5atTM1M/Uz9TPq6Jypn5eg1ulq408jHuiV6ZLpkumS35cfqaNTJccMzZsohzQhk2Wh7Rh8fVr2C14fxqvDVsevWww8hv1jfq2MzW0YbuQLnYQD+Fj0cWeiC52NnW0YefxAr6Mr4R+s5zShk/AlTGF08vp5fTyHdHF6eh09N24B/fiPqS7H4nufhSPRQYnCee0KXFempy4psyRS9qUuXi/XKdNia02ZZ30aVPW4wbciJGqKdtwCIdxO0bOpuzEfXhA/kmbEqmaclh+pk3OclNO4ik8jS+RbSThy6lXm/JKulabebKc0mZW2a/N3IdzkLR5pVTazHTMdMwH5VfazIfwcfm5NjmtzUzlfCEqZ+oblW2anNOmzZQz2rTborLRy+hl9LK5OA8XyA5tGn3tIYw3sGmDMlObtg43yAxt2la5UZu2A0loJLT9eACfk3/Upr2Q6tq0F5E92CvyS236lMjmU5Gcfiv2yQpt+kL5HW16v3yoTV8ms7Tp9+MDuAJJ6KvwYVwbCZ20TlonrW/ETbglMjv5fRi3I7P4Tnw+0jrJ/bT8UJt8K5p+Fl8mf0zRksjckhl4m/yhtiQyt2RA/kBbslxmaUsexBUYmVuyCh/GNTJTW7IOI2FLNuN++VNtySPyK21luuRlMlpb+X5cHddn7s3clXfH9XkP7kMq5AMY+2/lE/LX2jKVF7RlfbJUWzYfV0QSWyWztWWxnxbPt2XbcAh3Sk1bRn3bi9Q0avrsqOnzopovlOXa8kW4TJra8tUyTlu+BtfiIO6Imk5l3yPTteV7MSq3pU/e0rbMwajclgWyXNuyEPtxABfhKknalqjflkGMp9+WXVLTtsS3os0XuC2RuS0n5YfalvPyS23LhTDTMdMx0zFTP1M/L5G92s4PyPXazitFtJ3pmDdKXdt5E27GbXKDtvMQ0j3vxj14LD2i7fyk/L22bUF0sYV4pzyobaOyUdkG5Vpt2zrcEJV5Cm2LLbXtpPyHtu20/FjbdgbPyX9q2y7GLHZJfqFtp74vlvu07ZvkJm37Fpmobd+K23B3VGP/bfbfdio7lf1s1HQq+3lqXsCL8gvtSLw/Hcnym9qRr+EaUe1I9OpI9OrIVowuHTkhP9aOnMaRCpfkX7WT58ge7eS52C8vaicP4B3yqXYylfNdsk07ebVM1k5eK+O1kwdxY/TKdMz0yvTK9Mon8RTSN59JNe3ks3gOyWBLoqPR0ehodLRfl9e0Y3Q0etmwjNEOO+8YdYw6dpE6I9Wupovasffw/XRUOz5XntGOz0O6OHtzuvi9OCht7fjmmMLp4ttxB9LRz+I5ZIdOL6eXR6+uRJeuxCa7MoCLcDFG9y5PrSvRvSt343qZoF2J7l2J7l3ZjtGrKy9hTNeV6NjNdOG5dPO6uDdTIXN9jjxdK9N57doi+UC7drus1q59Tfq1a/fIvdq1TXG9Dckk7drutE+7ti/t0q7tTwe0azFd176VntWufRJ6mQ5r1xdEX++Xp7Xr1PS7oprfjVT2/4+/htT3HTJFu74TdyEdfW90dPr6fryY+rTrl/CT9IT2SJXOa49E3x5ZJE9rjyyR1dojt+Md+DX5jvbIoEzQHlmH63EDbsTNMkl7ZAtuxUjSI1fSDO2R18Ms6bj2ZHpleuUclTP18//Du/BupELenXZpT6ZOfiNN1x67Ll3RHrseY1c9NoDUtDvkfu0xahp1bJt8SXtsCLdHTaOajVR7B387tmHfDp36fn16Rnt8gXymPU5Np6ZT0+/BzTJWe3wLUtmp7FT2d9N07ZWo0CsbZK72yiYZq72yGbfgsEzRXtmOcW+vvIqv4RtUeBOvhplqmWp5m3xZezP3sp/ezL2ZK40rjSttS1xpW5G77Eqapr02cuVb+Da+gyS390JfIou11++U72ivb4p7fTNS04dwGKPmKFkki3WULMa4ZpQM4cj/vZrm6KjMNfl2eVlH5TuQK/NW3IaX0kwdlS8jd9k7+G7o/ZJ1lA/gYhnQUb4Eb8erSXWUx5WjZaFkHS39OIBx/eh8Nd2qo/PbaZaOtnnymY62hbJIR1s/DoTOf/f4PUbi9xhZKHfqmMzvvIDf/BcqjLGR3/0h947x+bgA++VuvYb34RoZkLv/LwAA///H+sjdNB0AAA==",
-		},
-		2: &zoomLevel{
-			gzipData: "H4sIAAAAAAAA/yTYd5icxZXv8VOamY7TPXmmJ+ooACIjMohQrxIChIQCCIQQGRkFlAAhwFDvLuu1vd507+7dXV/veoMNNhlswzUm1IsSaJRRIouckwSYzH3Ol38+zzyjnjq/c6re6m6pi3PlTnXxIrlZh4j9PES+/3mJ/I8OkaW4zPT8q+df/VKZr0P8MlyO1+C1ZuCVgVeG+bgAF+IiXCKP6ZDACoEVwi9M8gz5Pk9cYK+JC3ER8leR18fvX/8fMleHxF/if+J/4X/LXK2jozo6qhNLWCfXov1tHR3V0VGdXyaPaR356zz/Gqbbv4Zz5R6tC7P4mdfTVx1p69Lhsk/r0hE4EvczI38b+Ss6qqOjOjLXxX/H/8Bf4n/if5kZa2b7uR6tl2lyp9bLdJyBM/EcPBdn4Vy0KvXyc5mv9fJ/8RdoFevdENmn9a4Ox7purXfjTD9Fpmm9n4pnI2syn3rmU+9ZM+GvwimyTuvDqegxwbE4DsfjBDzf1mF69UyvPrBa+IX8XuvDv+MmN1Prw2bcYqZDXK/Wpw2WNlUchsNxBDKl9Fg8wbKlHhMznme9xPOR6uxCfaR6pGLcambUyqiVUSsbaWt+vwsZ62fH4ThX0QbZX47RBjlAVmuDHIQHyzptkMPwcBwj07RBxuJsvEDu1AZ2qoGdapDVbqY2yBpci+twEDfg026GNsinrlcbXF72aYOzHhscSdx0nIEz8RycJRu1wZPTk9OT05PNn2J5/KlIQj8OyenJye43sPsNnpx+kxunDX4zbrNUnmyJTa8hqcN6bEDSJkNdjzYkisOQ/MlYy5nQRULaQNpA2kDaQNpwIpI2eExwCk7Fs3EaTscZOBPPwXNxFp6H5yNdB7rmlDZwShvCE9Z1WIVr3MXaENbjBptA2IhbkDmEvbgPP8E/2xxSZpIyjbQPmQnntiE90aaRjsGT8GScYpNJp+LZOMOmFJlSZEqRKcWD8RBkVnEMnoQn4xTrK07Fs3EaTscZOBPPwXNxFp6H5+NsZFaRmURmEplGZBqRsxGZSfzMeo/MIWMOGWcjYw4Zc8iOt36zE5BpZImraE5KslFzUpZfaI4nLscTl5NReCAeIes0J6PR40SZpjk5DSfh6XgGnomTcY7cqTmZK+drTqKbqTnJcJW7WHOyHgfRusvJFrS+cvIBfoj7XK/mXA6Lsk9zrg+tu5xTHIbjrS83ASfiaTgJT8cz8EycKp9rzp1rc+CJzrnz8HycjRfgHLzQ9EyMZz/Hs5/zzMofhkzJJzgWx+F4ZCb+QpuJZzL+IrwYL0Hm4wdtGn4DMh/PfLgTcp75+I9tJn4vMqWEKSV5ZErcCbnkWDwOT5SPNZeMwVMwcV2aS8biWTaTZAoyn+RsnIbTcQbORGbC3ZLjbsmFI/Ao6zdMxkutr3AZXiE3ay5cifNwPi7Ax+yEhMwmEJ6yfgOdpgXrKCV5SvKU5CnJU5Kn43A8zpL3NZfOtWzpRXgxXoKX4mUmz3uO5z0XSR5HI8kjyeNlli1ejvPkXzQXf4ALkMzxcSR5JHkkeTZU3tJcpni8zT87AcmfTbQZZqchmTNLled5zPM85nke8zyPeTkSj5V1mpfjcDJatrxcIf+ieZmHV6N9tszLcrRsebFsedmEm9FOVF5ewdfwdXwD38R3TCeuV/PO4RCsw3pswBxa13mnOML1aN7tjwfgKDwQrfe8m4nnoM0h75gDT1me5yvv6d0fhUcjvXv69YttAn4ZP9OpX4vr3Nma91stP++qeZ6gvKdH/56l9e/jB/ihmdg9k08GLH9CLwm9JPTCE5TnCconp7puzfPJLZ+Mw/FIXzwj+eRc6yihL56UfDhA1mo+jMLDZJXmw+F4rMzTfDgOj8cT8EQcg5Plbs2HmbJG84F9D0wgMIFwjSzQPM9RnucoH560CYSN7hLNh03INMJb7nTNh3es3/Aufolf4df4DX5rps61az6t2GTSTuzCGnYjU0oPwFF4sE2M9+J8yqzigdZ1PFiC5iNdRzqN9BXpK56Ds5Aeee7ycTFeaz3Gx6y7GJFO41PWXVyPg0i/cYv1G+k6vmZdx9fxDaT3TKy7jB6zAhaxhHSd0XXWb33xROezYTgcR+B+uD8yh4w58Ck3z7twnnfhfDYGT8KT8RS0KRXkCFmlBRmNx+IJcrEWxKZUkLNwtqzRgthMCrIMbTIFecydqgWxyRR46gtiEyjIVrSuC/KWm60FeRttAgX5Vr7Tgqt37VpwDZjDPBawiCUsY7vs04KzfgvuAByFB8kzWnCHuB4tuEPxWLQJFPwh8gct+EPxMKRHfxzSqadTf4FkWvD2flHwdqcVPP3ypBc8/Xr69Y8j/fon3Xla8JvQ7rSCp0dPj4mTb7WQDMFOS5t0YQ27kY4SukjsE1QhIXkgbTgcT7ScYQySNky1HQkXW2aezQLPZiGQNixHMoc7nddCuMdN1kK4Fx+1/IFeAr3w/BbCKn4edD/QQtiAG9HusULYgy/jK/gq0nV4Hd9AJhA+wo9xL+7DT/BT/Az/jF/ZxMLX+A0yw1RseimTTOuxAXNYlG+0kJawjK12TtI27LDZpkw4PdAmnB5mZyM9HJlzPN5OQjwBmW1ktpHZxkvQ3u8K8UpkztwMhXiHzTYy25i547UQV7n5WohrcbObpoW4BbciM4x2Exa4DQqRKcUvZFALkX4zOs3oLqOjjI4yesnoIjsE6YJP3QWe96KU5Ggt8km7KCfKKi2K9VLk+S2KdVSUS+VmLcpluEwWaFGW4zV4LVoXRVmPG900Lco23OFmaFF24i58EV/CPfimm61F2ecatCh/lkEtunrZoUVnd1fRjcCReIA8o0V3pOvRojsK7aYq8r24yKffoqcLPwZPQjriaS16OvKXIB35y5Eu/I3ykBY9vXh68RusC09Hnvz+LderRf82ktl/bZn9N/gtfmcmgg7pKGnAHObR7qhi0oH0m9BjcqgrazE5Ql7VYkKnfE4u8jm5mNA136aLgR7DqXK5FsMkuVuL4XSk63Ae2mkshutwBdJp+L2brMXwJ3e8FkPEDFdb72ENrsV1yEzC026SFsNum0Z4Bp/F5/B5fMldpcXwCr6Kr6Gd4WL4EJle+Aw/tykFO9XFdAgypbSARSxh1WaVNmEztmArtiFTTXttnmkf9uMADsWRNud0P9wfmXw6Cg/Eg5C9SNkFboAi/9dUTM+W97WYTjMjuxBPRubPnVCMzJ/boBiX4XJcKWdpMd5guxDZi8hexD+4c7QY2ZHIzCMzjxtt5nETbsYtuBW3IfsSdyJ7EdmL+KJNPrIjkR2JbzjRYnwT38K3kR2J7Ag3TDFjLzImn5WxEbtchxazGnbjMJtVNhxHIBPm/ilmTDI7xCaZMc/sMDwcj0C7l4rf30uZTbjEp4uSHIeJXK4lGYvjcKLcrSU5DSfh6XgGnomT8Sy0HSnJ2TgNp+MMPBdn4Xk4WzIt8c2lxHeWEp9nSrIEl+IyXI52q5fkUXe8luQJN0tLsho3uElako24CTfjFtyKO3EX7sZn0HatJHvwZXzdiZbkDXwT35XNWuL+LMknpmuVN7XkBlyHltz+8qyW3MGurCV3GB6OR8qrWuKTT8nZ5Et8/im5E3EMsgt8/in5E+RHWvIn4hg8CU/GU9DLJVryCY7FcTgeJ+BEPA0n4el4Bk6WWVryZ+EUnIpn4zScjjNwJrJHfAYr8emr5O9x52jJ34v34f34//CP+DCyX57d8czcP4svuBla8szffyaDWvL23lRKCsiEkzZsxw5k5slQVByGw3EE7mc7krAvCfuSsC/JaNuRhH1JjsKj8Rg8zvaCm7/EzV9KElfQUmDygZkHZhjOs5mE8/ECuVRLYQ7OtfMcLsKL8RJkeuFKnIcLcREuk3/SUrhWlmkpXIcr8Hp5SEthJd6AzDYw2/CEm6elsArXuZO0FJ7ETW6MloLdVyXeR0phO+7AXW6hlsLrsklL4R18z852eB8/wA/xU9uFwL4E9iVtdJ1aSptsF1J2JLU7qpQy+ZSZp8w8tVuoxK1eSk+w2fJ/sKX0LFfRUmSekUnGM3EyMtV4oU0yzkWmx6e7UmRicbH8XEtxqU0sMrfI3CJzi9fLVVqKTCmutWnE9TaNOIgbcCMypbgZmVJkSnG3zSfau22JG74UX8SXcA++jEwvMrf4sd0Jca+Z5W1KGbPKKsisuM9LGbPKRtqUMiaWMbHsAGRu2bE2sew4PB6ZXjbZdWlZ7E4oy8l4CtoNUJbxOAFnyywti02vLDarsth8yhLd+VoWm0lZBnETbsZd7kIti33eKMtr7hgty+tonZblI9egZfkYP0P7RFEWexcruyFYhzaBMjdk2bVhO3agzaHshqNNoOwOdGUtu0PwcHlVy+5Y9K5Ny9yBZe/lbC37BOnR06O/AOegnZyyvxgvwSttAv4q+bmW/XxcgAtxES6zyfjl+IjNxz+KzMpnboGW/RO4xs3Rsn/SHadlv8Um5rfiTpubZ3qe6flX8FW0Z63smaF/zxW17N/HD/BDZJ6eSSZMMqnaDJMmbEZmmDA97rpycoBNLBmFzDBhhnyyLfO9tcz31nKS2CSTsTgOx5uB2YZTbKrhVGTOgTkH5hzs3aEcmHBgwoEJh0tttuEyvMNN0XK4E3/nztFy+D3+AR/Eh/BhZM5hlU01PGXz5JtsmW+yZb7JlvkmWw5v2dzC28gMAzMMzDAww8AMw0fIJPmUWw5f4lf4Ndq3hnIq6JBpp5zbtEE+1HJatGmnrdhpc+aWK6c17MYe7MU+7McBHIqKw3Ck7Ui6H+6P7F16GNq7Ujk9Gjn5KbsT2Z3IjkT2Ik5B9oJ7shzZhXilneQ4D9mL+AA+YtOOj+ITNvPIzONqHHQXaTluwI24BV9wzVqOLyK7EJl5/Bj34j78BLkH+DRbzphhVrDpZUwyY5JZGzKrjN75dFrm02k5s34bxcuPtFHsvaBRzkLrtFGedxO0UV6Xp7VR3pYt2igfoCVplH34jezSRvkWvzNdvXyojdxFja4du+Q5bXQ9OBItSaM7Ao9xddroyeDJwOeiRj9XrtVGf7H8XBv9JXinO0MbuSsauSsa/Vpc5y7VRr/eHauNfhA34GbL7+nCv4Av4ivWkX8VX0N69G9Zd55O/Tv4Lr6H7yMT8B/iR8gcEmddJ0OwDplDkrMJJEwjYQ4Jc0h6sQ/7kZkkR9k0kqORyQQmE5gMd0JjuNAmE+g9PGWdBvoN9BvoJZA/kD+QP5A/fGppw2f4rSUM35kpCdNOS5KSMx2O9uw0pqMtSXokkjCSis8PjZEzE8kWn7QkkVSRVHEjki2+6lq0MZIwkjDuxX34Z0sVv5CXtTF+jWTjnb0x4yxlIyxPNhLJlh1heTJLWOEkV3gvrnCeK2KpKpznitztpmhF7sF78RE5UivyKD6Gj6M9sxVZhZvcsVqRLW6CVmQr7sZn8Fl8Dl92LVqR9/Bj2aIV2YvWXcWJfKgV59A6qrhOtI4q7ihX0gpPQcWPlV9rxY9D+75Q4ftCheeiwntuxV+Id7kztOLpy9ORpxdPL578nvz+JdmqFf+WZfNv4zv4LpLZv48f4IdIfv+pvKcV/xl+gV+anPkKZ7uS0EVypHWR0AvnthLs21Al0EW4VypaCffhA1KnlfA7fBj/hOQPO+VrrYRn5AWthOdxj+UPdn4q4SP83DIE8gTyhK/lA62Eb/Bb/M5M7XmspPXYgGROmX9K8vRoy5weY3K2K3G8zT9OQLqIdBEvkp9rJd5jXUQ6inQU6SXSS6SLSBeRLiL54yuWnKegEvda5rgP6SWSP3OyVysZmTMyc/4rnPxKRs7sGNemVT6FVuVU9Ggnpyrj0DJX5Qw8Ey1/VSx/VSxzVe5HS16Vh9GSV3kWqmLJq/K8bNWqvIB70PJX5WN5T6vyOX4lH2hVvsbvZK9WOeFV7v8q93/VKVoXVc52lW/KVb4dVznnVU8qTx5PHv8npLqnun8Zqe6p7r+0up4MngzezkA1ycmHWk3IwN1bTcjAWa0GL1GrgWkE280q57MaHrGK4XEZrdWwWzZoNTzn8loN9gRVwyv4mk0gvI5U5NRVA71z0qqctCp3aTWlLietGqkbExwr12g1jsPxOAEn4v2WJJInkidulEatRlLFZy1VJFt8Hl9AEkayxY/wz5Ytfo5fIJkjabMhaNmaxLI1SYJj0bI1yUS0WTXJbW6oNskdUtEmuVfqtEkeQcvWJC+6vDaJVWySL9H2pck1yEfa5OyTSZMrYRkbsYJVbMJmbMEO05PNnyHnaZMng7/dMniS+Lssg78b70GyebL5DTJamzwJ/S5L6HfjM0hm/xLuwZfxFbQZNvl91oX/BD/Fz5BO/TeyV5uSnPWY5JHukjZ+b2egibPXFGx/mwLzDJOsl3A60lc4E6fJLG0K03EG/sr6DfQY6JFT2hToK9BXoK/wpqUN7+H7SP7wMdoZaErrLVVada3alLbwcyuSMx1uJ4Gz2pQehAfjIWjfcZriePk7bYpT5J+1Kf5a2rUp3oa342/wt3gHkjaSljPcFHcimeObrl6bImk5sU2c1ab4laXKSJs1YA7zSPKM5Fm7y2lT1oF0kTHtzPI3yxnyz9osZ+JknIqWuVnukkO1We6TOm2W+/ERtCeuWTagZW4Wy9ks78oebRZL2ywfoaVtli/QMje7FrRUzdx+zdx+zc4+tzS7/ZBsnlT+bJyG05Fsnmz+HmnQZk82znOzfxTJxqlu9u9YKv++fKXNSUVe1Oakim2WISEJ57CZc9icDEOShLPkf2lzmIIkCSQJJAlXyr9pc5iH5OFdu5kT2BxIEh7DNZYnrMV1SELOZ3PYhJtxC27Fbfg0bscduBPtCW0Ob+Db1mOg0/CFTTil05ROUzpN6TStWXdpN/ZgLw7gUByOTIDvhs18N2yOk20OkZlEZhKvlOXaHG+33iMTiPfZjsT7kTnEx+UQbY4RV8vB2sxpb467pVebI/njm/i5JY/kz0ietVrmjPyc5GZOcnPWifSSkZlvcC1yhvydtoglbJHZ8g/aIhfghTJDW2S+/Ju2iKVt4Wy3yAPSoC3yoNRpizyED+MTcoi2yFo5WFtkvYzWFhnEDbhRerVFtuMufMfltcU1yl5tcS0upy2uE/vkdW1x/Wjf71rcIa6qLd4m2eLJ6cnpSehJ6BfIbdri/8cS+l/hr/E2JD/nv8WT3/8Of4904R+x/P5RpBdPL34dPol05OnF04vfgTuRvjx9JXSU0FHS7WrakvQgfSUDOBQPlS+1JZxnHYXzcY71FS6Xc7Ul2GlpCfPwKpxvnQb6DYvkAW0J91oX4U+WOZA8rLe04TVLEl7HN/Bd+UpbONUtKXlS8sRzbaqR6vEKuUVb4pU4D6+yWpG6kYqRivFB/KNVjA8jGSIZIhniIL5tdeM7SAZOZkvWKdu1JevCmmzXVpkjk7VVrpG/1Fa5To7TVrE9bZVf4a/xNrwdLUmrPIgPoeVp5TS2ij0vrfKc1Gur2OeZVj5RtMor+Lqs11axbK3crq3OkrS6XrTdafUXWB5/oTyqrf5a+Vdt9dfJ1drKiWr1VPdU99T1z1gt/yxS1z+PVPd7rKJ/DanOXdqadCDVE+aQdGMPkifYHrWG82WxtoY5lieQKszFi5A84T68Hx9Au0lawyo5WFsDCQMJAwkDCcMLlie8aqYkSameUj3twwFX09Z4nmWIJIkkiZfIT7Q13ibN2hp/axXjHXgn3oV34z1ITu691vgHyxYfxIeQtPEJSxvJHAdtH+MLljO+YgkjOTk5bWJ52uQi+Ym2yQr5o7aJZWiTO/BOvAetSps8hLZymzyJtn4bt1Ob7MCduAufkSHaJi/Jem3jjmpzvc5pm+sz/Ryr6y/EuUgSv1Cmahvvtm3+PjlM2/z9+Hv8owxom38Y/4SP4eMYMUMSehJyz7R58vjnTW6YtoQ8CXmSfjNcbBnCfMsQFslMbQsr5HZtC7+SgraF26VJ28Jv8A60PWoLd+O9ljCQOZAkkCE8hSQJG3AjbsLNuAW34jZ8GkmbkjYlbUra1J6ytjhXZmtbJG1caGkjmSOZ468tYbwNSR5JHn+L5I9kjmSOD4jTtvigHK5tkfyR/JGEkYRxl+WJpMp6LElmSdrlYpmt7XKl/Le2yzyZqu1ynczUdlmBVqtd7hbRdrkH7T2iXdZLTttlo3Rru2xHW79dXsQ9Mkrb5WV8BV81nX1XaufOaXez3c+03VPRz0fq+tukQ9v97fgbpLqnur8X78OHZEDbfSZt2u6ftDz+KSSbJ5vfhJtxC27Fbfg0kt+T35Pfv4RkTsic1Mnz2p7Y7d3OrdUeSM55aw9XW/KwGJfgUqSjcJelDXQRyBwewUeR/OEJJGcgTyBPSkVup/a0hgfJl9oer7S6kQycova4ABci1eMNslTb443yK22Pv5UDtT3egSSJ94vT9kieSJ4YLUMkT1xtM4xrcANuRBJGEsYdSM7IrLJR8qm2ZwfKp9ohlqFDluFyvAavxetlqXbISrSEHXITWrYOeVTatENWSU47ZC0+iU/hehxE29MO2Yrb8GncjjtwJ+6WIdrBzdYhz+ML+CK+hHtMt7/s1g5nvXQ4enEHmZ6OPB15Mnsycz47vM2zw5Pcb5Lh2sHp6uB0dSQ1+UQ7km5kteRg+VI7wtUyVTvCYmT9wPqB9QPrh5vlHO0IqZypHeERGakdgSrhMXwcI2b4BK6Tfu0Iu+Qg7Qi7zZQMKRnSfnlbO1LF/S1PeqR8oR2RPJyfjkiSyB7FYNUjGeIaKWlHZP24XXq0I+5AakVqxWfwWXzOzGpWK+uz6hnVM6pnR8k72il2nju5fzrlB3gVLsRFeI38VDvlOpminbICb5EztVMek5HaKVGGaqdYqk4ZlOHaKVtwK1rOTtmBL8go7XTqhminO0C+0E5/uSzRTk9F3sU6/WKr5ZfgcqS6p7q/Qe7TTn8j3oQ/RPL4xyyJfxxJ5VdJSTv9alxrCT05PQn905bKk9DbDDs9CZMeeVs7k17sQzInw3Ckyf3TGRYgyQPJA2nD9UjaQNpwM9pudnKiOsOt+FdI2kDaQNqwwRKGjUjasNMScq46uXM6uXM6I3sUqR6pHlcgGSJ1OT+dkYoxs1qRWpH1I+vHp6VFO+N23IHPW61sf+s3Gy3vaJdcKku0Sy7Dy/EKvEr+SrvEMnTJDWh1u8TqdsmtaLvTJVa3S9ZIv3aJVeySnWgntkvsxHa5xP2rdvlLbU1OSJefx88/QGr5+bgAFyLVPXU9dTkPXT6ziv4JpK5/SoZrl1+Pg7gFyePJ43fhbiRVYie2KyFbIFsgA/vexY53hZVoO97FjneFv7Ak4a/kB9oVVlv1QIawFtchedjlrkCGdH+rlVIxpWKkYrwML8crcKFcoV1xEZKEfe+KZGD3uyIZIhki1SPVI3XZ/a64zepyBrris/icmVn1miyQK7QmC3ERWpWa2AmvyY14M1qVmvwU/wZ/hn+Lf4d/j/+A/4iZDNMap6Ima9Gy1bhJapyTmjtCvtAaZ6PmprgbteZJ4q9G8nAz1DgDNf+XcrzWPEl8tPU9Vdjrmt+KrJzY2a4lHlk/mep+pLWwUO7VWlhqK4dlyPrhJvwhUovnusZzXQvUDT+2uuEn+I9yl9bCaqse1uGTaCewFgbRnrta2IE70U5gjTNQS4+Ul7SWkpDzUEvH4jgzXm054zXyG63Fa3GFzNFavB5XIqniP8iPtRZJEtfgWiRVJFUkT9wqI7QWtyFJMjJklqFb7InrluXyG+0Wq9ItKzHgrXK8dstPZJJ2y8/w7+XH2i1223TLJtyMVqVbtqNV6Zbd+AzaOezm6e72S+RW7fZLkbr+OqSup66nrv+RVfR/jT9GkniSeCp6KvrnZT/tDlfb+mGZrRxYM7BmYM3AmuxXd3gK1+NGWyFswl2ss8ct0+7wsluh3ZHV4nK8BpkSe9EdWZlpd8f1tkIcxA3IypGV42YZoT3i3C+1RwpupfZI0f2T9sh1cqv2yM0yR3vkFrxVfqc98lOZpD3yN/gz/Fu0Wj2yhdVsAj3uGHew9nhxf6E9frGt5lnTs6ZPbTXPmv6v8cfIap7V/HbZT3v8DjNhtcAK4QZbIdyIN+EP8ae2QmCF8LitEJ7AVbga1+BaXIfUCjafnrABN+Im3Cpd2hPIkJIhPcmMdBTJE1fg9XiLZYgkicwnbrEVIuvEbfg07rQ1M9bMbM1eZt4rK/B6XIk3ynTtFZtYr/wUN0uX9jLhXsff+kVyv/b6q5HX+5vxFuSv/N/gz/Ap6dRevx4HcQOyMtPuTVg5sGawp6M3LMVluByvwRUyUXvD9Uj1QPVA9UDyQMWwCW0mvcy2N2zDp9Hm3Muce5lzbzrJjdLe9EJ3i/ZGkkSScPJ7Ofm98TqrG8kQyRBXIkkiSSIZIhkiGdiL3kjF7Gg3WnvZi97v9yKjbnY6znH/W/vEqveJ1eqTFXg9/lCma5+slaO0T2zlPmer9bE7fexOH/vS5xfjNeK1z/O3fiXegDeinZ8+z2p+HW6Rmvb5rbjNTFifPepjj/rYo77AyuE6GaN9gTUDa4ab8GZbObB+YH12pC+sx0HcgJutSqBWsGewj33pY1/60hNxDFI9PRlPcaO0Lz0VPSYmu9YXl1m2SLa4Aq9HEkYS8uz0RbKxU31xk2WI5InkYb/6MjJkVMysSj+70y9L5ETtF1u5X2zlfrE1+9mdfhmUmva7Y9wB2u888reev/L8FfPvZ/79/kl7vX8K1yMrsAv9fruZsFrCagmrhYWWJCzC5bZmuAF/aCsH66g/5a9S/oop9adjcRyOxwk4EU8zIyvHm2ydyGpxta0W1+BaXIdUiVtkf+3nbPdnVMwSd6T2Z2NxHI7HCTgRT8NJeDqegZPxLHekDoglGZDFkuiALJWTdUCs0wEmPyAr0RIOyM14C1qqAbGTPOCOcKN1wI3GI/EotJwDznIOeKp4qnjWZ48G2KMBz2p+M25BVk5YmX0ZYC8GwlIZqwM8FwNhBbIOz8JAyuvZiwEmPBAXyyk6EKnImRzIeE3Ga5jhUCYwVOyVQ2UJrpATdKhcjzfK/9GhzmY71E3AiWizHUpfQ/3V9ld+uXgd6q+1v/LXIet41vEr8QYzSdwROjQZawZen/KbdALaTg2Ni2y1yL9m/Gs2HnlNNhFPc0eoir1Gnb1G3Vgch+NxAk5EXu9tZSWnJuPcQarJeOQ1yWnuUNXAa8LVuBiX4FJchtavphPs9ZGfo91amo2332T2+2EyX+7XYbJITtRhnp/9AuQ3gd+E73++GhfjElxqRl4T+atovxnOmsNlAS6SU3U4Kw/3/BwXyKk6Qhai/WYE/zqCu2ukfO9SGa8juclHcpOP9Evk/v8fAAD//8flFONUSwAA",
-		},
-		1: &zoomLevel{
-			gzipData: "H4sIAAAAAAAA/yTbdZQWR9bH8apO8GGmxt2QMWaAQWO76eq4uyvE3T1AV+MS3D0QIkjcE0hXR4jLxj2EADGIENf33O/7z+dwWPLU795bXd39zKzZVW1VK82uaht+iV/h12LI/xpuUxeaXcMv8Sv8Gr8R48/k36Sb1IWmg7YY4T64L+4nhp+rDaaD3VOdbjrYvfA/+F/cG0O0GOG+uJ8Y8wnxFvk0x+ek/E26WfTyNx3VF2qD6agkc0fN34T8TbgF+XsydIy3yt/EUlfHWOrqyGd2TPn7lL/hMzup29Ua00ndIVJjJy3ZOul9xPBOdbfpFN6Fq3GNaPeQf2n3xL3wP/hf3BtD5DNthNK3TjGfE/M58VpcJyb7q52mU3qXJElX4xpci+vE7EBdYTplB+kK01ktVmtMZ7UEl+IyXI63isG+aqfpHBwg/z7kX0b7y58j/ia+U/4mvgtX4xpci7JiZzdEXWg6u6G4G+6Oe6B0oHOyq640nZMOslbSETshqyeslYzT5aZzMh4n4ESchJPFdIU6ynROV+JtuApvxzuQVJ5UntUzVsn2w2m6u+mi5X/tEnRRO02XQDrWJTgIL9flpktwBY7B8fLv7VD1oOkS8ffROPmbiL+Pb1YbTZd4BI7EURijwwRH4xgci+NwPE7AiTgJJ4uOdd1uuDvugXviUn2s6eKW4XK8VUw66krTJemEnTGSSpN9kCqSK/EqjNFhgmOlOnrYxQ/F3ZDPz/aTXmX74wHI52R8TjZa/ttsDMrndFWj1FGmq56ujzVd9QycibNwNs7BhbhM72O66pX8+Ut9jOkayP7pGnRWO01XptY16IrdMAe74z66wnQNLkdJ1TUgQ7BOvWK6htdIkvBmHIlkC8fheNGSxJLELpYkdgkuRbLZ5bhKslkSRiSMOmBHJE/0X0kS7Y0hWoyQtJHMomtE2kj63zU+Xj1jusanqo2ma3wakjy+Fq8T3SRJ6CbjCsngViJ5kr1kdebblZl2TW6TPiSr8Ha8A+/Eu3A1rsG1YkqSlBX9LfpM09XPwJk4C+dLN/wCXIiLkDyePP4rMSNVxnSyK5CE7JluStbqpk5UG003dRKejKfgqXgaXos3qqNMN3UT3owjRC1z6aa360rTLeiKMotuwR64J+6Fl+oK0y24DC9HydMtuBlH4EgkYbACV6lXTLeQtCF5QvKE1+H1SBI7UZ9putnbJA+7pZslVUSqqBuSKiIJJ0w39kM39kO3iCTRKFk3itFhgqSKSBWfLHnYLd3i0/EMjCVJ7DDB0fikWmO6xetxgxppusVPiW6MPtZ0c2OR/G4uypS7uQX4hdTituBW/EZqSXIwTypKqCv5j9SVXCtVJNfh9XgD3og3IZUm9DyhxmQxLsGluAyX461I7clKvF3mktwhpnQjPQXpQ3ozjsCRSB9S+pBSu6d2P17q9ROQDng6wA7v5lcgHfBfSr3+K/wa6UNGH7LuSB8ydl1GN7JLpcbsMqQzGVVn7LSM2jOpIkeNVUeZHDUOx+MEnIiTcDIuxMfVGpOjNqiTTI6SinJUil7UUl2Olipy9AqUPZmjP8NN+LkY5KudJofrJYfrJSe4WFeYHO6POdwfc4Ir8Sq8GmVP5nCl5ARjcCyOw/E4HxfgEvWbyQmW40q8De+S2oPVuEYMT1XPmJzwNJT7aU44GsfgWByH45GehPQkpCfhE9KTkM6ET2GKXrRj9Zkmx9IZS2e4ZnO4ZnPsZl1pcuwXuEWMcjEP6VJEf6JL8FK8Rv1gcqJr8Tq8GUdgrEtNTuSQnkSLpPZoMdKZiM5Et+IKpEsRXYpW4e14hxgfKf2Jj8Jh0od4OJ6FM6Ub8SycjXNwLs7D+bgA6Z6LZc84hwnSK0eXHP1xn0kfuN5zErnf5SR7Y4gWr5KqE+pNxuEkqTqZjFPwFpyK03AGzsQ5OBdXS73JI7I3kkfxMTGlA+npeAZSezoJJ+MUvAWn4jScjjNET9WeSrnec/xK/ETq9dTrN4mZwXwsQPrA9Z7DnS4nu1Jqz+hANhJHIX3IxuMEnIizpLpsAVJptgbX4j1qu8nJ7sX78H58AB/Eh/Bhtd10V+eojaa7WijqkfpY012Pwun6TNNdz0CZY3d9O94hcn/sztNU90Dydw+uwpE4Cm9Rv5nuwVRci4/KisFjYjhcPWO6h6wesrpldetkRTtD72O6W9ayn+pK0z36r/rSdI/2xhAtkiG6HFmda6c71073aLKsG01B8kTkiabhE+oV0z0+V1aPz0OSxC+pkaZ7/IqaZ7rH/+PPb+JboiOhu00SulViIlPunhRKTxIyJImsnozGMTgWx+F4nIATkZwJ/UnWS6pkAz6FKXp8Bp8V07OlhymZ05clbUrm9H/4Jr6Fb4ue5J6uZgWSNiNzdrV0L7sGr0WqYB92z0jODuyekTBjgtnj+ARKnlx1rnrG5KoL1UaTqy7ChSipcrU8FefqW3EFyr7K1c/hRnwd38D/4Zv4Dr6L76HsitwgF/PQ4G5qp8kNdsf/qC9NbhDhvrrC5Ab74eUo521uMAJHorxj5gaTcZ3abnID6gqpKzwPz0eqY9/mhtL53JAaefLPtfJkm8s9Ipd7RK6lLkst9gPJaT/Ej/Bj/ASpK6KWiFqiPSV/tBdSUURFEbWw83OjK1CuwdzoarwOR0kt0Rgci9QYjccJOBEnIbVHU1Cu2dz4cKk3PgKPxKOQ2uMX1UiTy5WSG3+MW0Un12+um4WLcQkuxWekD+5lfAVfxXel6kRhri4yuckeUmlCBxKqTqg6ORQPw8PxSDwKj8ZjkJ7w3JjLdwW53B1y0wulivQivBgvwUuR6riactOPRD9dH2ly/QykLk9dfrkk97fiRn2QyfXU5d+SKvzb+A5SXUZ1WSDVZbvgrtgBO2In7IxdsCt2wxzsjnQpy0OD7JmMjmV0LGPn81aem9G3jM5kV0hnuPvkcvfJ5TkzN6NL2WrZ/9katd3kqWPV0yZPHYfH48l4Cl6ozjN56iJcqNaZPCVXRJ7aInJPydNy8uTp2Xofk6fn4Fychwv0MJOnl+pjTJ5ejrfiMyhdzdP/6EqTp/8VA6k0LyhTO01eUI6DcDAOwaG4G0o38jgB8jgB8oL98QA8CA/Gw/iXx/HnS/Fy9YPJC67AK/EqvBqvwWvxerwBpZN5wRSUe01eeLR6zuSFJ6rY5IXnS99COhbSMU6PvPAjpG82lo5xN8yzs6U/dg7OxXk4H+meXYr0zdI3+yw+L92zL+DL+Aq+iq8hvY20dDWit1E+FmAhFmExliAd5ozK44zKiw5FehgdjccinYzkPS6PN9a8iP5wzuRxL86L6Ux8Ep6Mp0mXYnoVXyy9ii/BS/EyvBKvwqvxGrwWr0M6HK9Vz5q8eB2yPzm18uLPcJNaafLiz/kz/Y+/UheZPEeHHR12C3ER0mFHDx09dK+jXPV57m38CX/F3/B3/AP/xL/EhH4mfaWTST/sj+04AAfiEOlnQm+TY5AOJydIhxP6nFwmpsdJD1P6mdLP9BQ8FelqegFeiPQzpVcpveL0y+P0y0s/xk1Il9IvkF6lW5GO+ZGyY/0opHuejnE25nk6xqmY5/+VqjNqz6g9o95skFSUDUbqzYbibrg7HogHIbVn8tZp1PEqNkadgqfiGeppY9Tl6kxj1BX4mppnjPoIt6IkN1oyGz1Hn2iMlqvM6IX8WWZttFxHRr+AL+JL+CpKRUb/ov41Rv8qBkoXGRNoDHAX3BU7YEfshGVYqXYaE7TgQPWlMcEx6n1jAqnRcCIZ3q9NeKJ6yJjwJLxUagypLqQ6ThITfozUGG5DKrUj9d7GWOq1M6VGOwtnIx2wc3EezscFSE/sIlyMS3Ap0iv7nPTE0hNLN+w/YkSlEZVGFVJjRL1Rq1QatWFf7If9cQDSjehyqZ3vwUx8utQeD5fa47OQDnC9m3gR0g2eSQxXtHFyrhqe4Y2jdkftjtodtTtqd9TuqN1Ru6N2R+2Oep2ct4bvVw3frxpOBuNeRDlpjXtdn2wMZ4LhTDCObiRa/WNMIvd0k+RgHhrMxwIsRDqW0LGkCZvxeNkhyYmyN5KT8GQ8BS/GS8SUjqVn4JlIr9KHlDcmpVdcy8ZTHd/QGk9F/ll8DjciNfqX8GWkRk+NnmvBM31PvRn1Zh2xExapv43JirEES7EMqTSj0uxwmX52pFSaHYVHI1dHRgeyE5CqM6k6X0mN+WoYDsfL1dMmX12lzjT5PKvkqyXqWZOv5Dk2X8m9IF9tws9xM8rOydcn60NNvj4Fr9d7m3x9A8Y4Rp9p8vVMfb7J17NwNs7B5fook897TT5nRb7+Hn/AH3En/qv+MfmBQelDPmdCftCE0of8QPqQHxyFVBpSXUh1PFHkh9QVPq28yQ9flCrCl5Aa7Yk6NPmWWixVWKqwVGHH8uelktkuQ/LbW3EFrsTbUJ7e8+1r+DpSqZW7YT7nQL6lrigX85AaOQfyOQfyI2qMqDE6UaqLTsKTkUr5Di2fJ4r8+FSpOj4NT8czkLnH18qU4+uQnsSLpScxneG+n8+ZkO+Ol2445uvoiTsdz8Dr9W4m342QnrhRSK+cw2n6AJPvpuMMnImzcDbOlT3g5uF8XIALcYn00NFJJ+/m+S7Dp5F+OvrpPpdOus34BW7BrbgNv8Sv8Af1ksl3P6FcifnuN/wd/8A/xUTJLDiR8pPO2AW7YjdkdgmzS5hdUij7MynCYmTHclLlc1LlJw3YiPJ8mJ8chkw5OQKZb3KazJTzKp/zKj+VJ8D89HQ8A8/E4XgWXo3MN2Wy6fMy2XSTTDb9HDfjF7gFt+I2/BLZCZ6d4E/X+5p8fwYyfX8D3og34c04AkfiKIzR4Rgcqy80+X4qsgc8e8Avlol7pu+Zvl+FTN8/p88y+X4jPo8v4Iv4Er6qTzb5/k2Ztf9GdzD5frvM2u/A7/B7ZCd4doL/WeTUzeeMzc+YUdYss8iYEWdsfsZcMubCzyMK1LXqaVOgblBnmwJ1I96Et6h1pkBNxWkosyhQjyhvClSGT+PzaqQpUHIWFSiZToHajD+J+mo90BToa/RupkBfi9eh9LBAz8Xb9VGmQEuXCrR0qUBvxFf0yaZAv6ErTYF+Ez9D6UmB/lcMFHZVb5uCIA/lvlMQlGIZSk8Kgj7qS1MQtGIb9sV+eDDKGVUQnIQn46m6whQEZ+IwHI5nIT3kpyQFPMUVhPQzvA7pZEj3OL0LQroX0r2QvnGSF3CSF3CSF4T0LfxFPWIKwl9FSw/tNUj3LN2zdM/Ol+7ZBUgP7dP4DNJP+4J00r6IL+HLSIctHbb/Q/ps6bPdhFt0B1Ng6bn9DX/HP/Fv/AeZSMREIo0BdpC5RB2xEzKvqBvmYHfMReYYFSFzjJgj95QC7ikFUTMyu4jZRYfJvKLTdTdTwJ2lgDtLAT9hL4hG686mIC5Sg0xBXKwWm4KYuXDvKIiflVnETIH7SEEsZ0tBvAW3InNxzMIxBU74ArcK75Ruu7twNa7BtbgOn8T1uAGfwhSZnWM6juk4psNPWwocU3BMwe1AOQ0KErqa0NWEjnFKFyStSH+S/fEAPBAPQvqW0LfkVOlbchoOV5tNQXKOdC+5HK9AOpnQyXSKdC/llEg5JVL6yYldkD4oO5yn0IL0GXwW6XBKh3n3LODds4DTu4ATuyClz54+e/rMqVvAiVrg6aSnk/4ZfYEp8M/ic7gRn0e65zdL3/wXSA+zXaVvGR3L6FhWjCUod7oCTtGCjB5m9DCjh9mh0quMjmWcFRl9y86QjmX0LTsL6Ru/7VCQSd8K1Y3qaVOoRqizTSFnaaGSN+5C9T3+gD/iTlFfqA81hfpSPdAU6svwcrwSpSeFepy+0BTq8TgXpT+F+m68Bx/HJ/BFfYEp1C+hXOmF+i18G9/D9/ED/BA/wo/xc93BFOrN+AVuwR1iIF0tDDpjF+yK3TAX87AEpduFnM+FvCMXBn1wkPrSFAaDMUR5IygMpNuFwelqsykMzsAz8SyUq74wuATlNC4M6HwgO7YwpP/hTUj/wweUN4XhC9LtkFnwTV0h79eFvF8XhkzHMgt7kT7OFNqLUe5ohZbOcw4X2vn6QFNoF+BCXISLcQkuxWW4HJmRZUb2Fa1MoX0VX0fmwk8TCjmTCzmTCzmTCy09jzqql0whZ2khJ2dhRG+jUqS3Eb2N2nSxKYz6Yj/sj+1It6N9pc/Rfrg/HoD0PzpR+h+dhMwiGo50PpK7YSFv9IW80RfGN0u341Gy5+Ppap0pjGcg/Y/vR/off4vbcQd+Jzq67S7Te5hCdzlegVciU3AxOpwos3CTcDJOwVuQSTlm5Oi8uxfvw/vxAXwQH0I5WwrdRnweX5LpuPfVa6bQfYSbkbk45uLkOa2QJ/bChBklXTBf5pIUYCFWyXSSauyNDdiITdiMzDFhjnxzWJgMxd1wd9wD98S98D/4X9wbD5ZpJofgoXgYHo5H4JF4FDLl5By5ypLzZNY81Rcml8qUE+bOb+kUJreq7aYwZe7pCGTi6WJk4ukDyNw98/WX4KXIZD2T9Yk+wRT60TgGx+I4HI9M1jNTfzcyNf+izMgzKf+OTMe/i+8hs/Mf4IfIHP3H+Al+ip/hJvwcmbX/AplvxnyzTtgZmXXWFbthDnbHXMxD9kBWq7aZwqwO67EH9kT2Q8YeyNgDGXsgG4RMM2NqPOcXZifIvLKzZV4ZU+P7k0Ke+QszmVQR35wUqSvwSrwKr8Zr8Fq8Dq/HG/BGvAlvxhEYq7NNkXI4ASfiJJyMshOK1J3qWVOk7sLVuAbvxwfwYeVNEW8cRUp2S5EeriNTpM9C2SFFvFMU8U5RpK/HG1B2TpEejeNwPE7CyThdX2iK9AycibNwNs7TB5oi/Zg+xhRxDy3S6/UFpkg/gxu1MkX6dXwD/4df6w6mSH+D36I8pxUFBuVkLgpKsVUXm6KgDftiP/wvWvW+KQoi3Af3xf1wfzwAD1EfmKLgTN3NFAXDUO6GRcGFusIUBRehzL2Ib5OKQuYSMouQWYTMIqT/IT3nnlhk6ba9XB9niuwVeCXSYRsjHbb01tJbO1f6ZumefRAfwoeRfnK/K7JPIP209NM+jy8gvbVvqddMkX0b6a2Vp98iS1cjuhrlYwEWYoXaZoqiSmzARmxC+hzR52hvPEh6GB2M9DM6HI/Ao6Sr0dF4DB6LxyGdj+h5dC6eh+cjnY8vlc7HlyFTiEeqcaYonquGmaL4PnWAKYrlaaQofhCZAnfGovgT/BQ/w034OW7GrShPLEXuWpmRY0bcGYu4Mxa5KXp3U+Ruwak4DeXkLHKLcQkuxWW4HG/FFbgS1+I6vBvvwXvxPmTijom7VKbsPD4nk3VM3DFxx8Tdi/gSvoz/U6+aIiend5F7F+XULUqYdcKskxJdYoqScplswtwT5p60Yhsy8YSZJkwzYZoJ00yYZsI0k9PxDJQnmSJ+f76I358v4veri5Kr8RoxvVTmmDLNlGmmq9UJpihdg2txHTLNlGny7lOUMi/PvPhWqohvpYr4xqnIj9bnmSI/BsfiOByPE3CyTNAzWT9D72WK/ExkRp4ZeWbhmYV/VGbhmYjnNPOvSof9a/g6voF03r+JbyH9565X5LfgVvxKpuC/xm/EjOlkxTKXjOlkpcgUsgPxSOlwxiwyZpHR/+xiuWoy6Xyxkq4WK6eGmWI1F5eqE0yxWoYP4kNquCnm7lDMTwCL1cf4CW5Ws02x2oq/qEdMsfpV1Ffr40yxlg4Xa+lYsZ6Fc/Weplgv0/81xXo53orSz2L9AMq7Z7H2mOEL6lVTrKU/xfoz3IRbcCtKl4qDHOyOuZiHBvOxAKvVNlMctGAflDtFcbA/HoDHqg9McSD3+mLejIp5JyoOLsarcYHubopDOhmOQjoZzsP5uAAXIr0NN0nHws+RHob0MNymrjTFlu7Z6/Qlpthejzegk37aBOmtnSRdtZNxCt6C9NzOkW5bem7n4XxcgAtxES5FZmHv1RebYsssrDzpFduH8RFkOnY9psik+GasmG/Giu1zerApthuRqVl5ny2226Tz9ktkXhHziip0iSmOKpGJ8CZVHDEL7h3F3C+KuV8UR0fiUXg0HoPMKzoO5R22OGJeEfOKLsFL8TK8HK9AOW2KI6bJvaM4ZppxjMwuZnbx/fiwXBfxI/goPoYblDfFcYoen8eX5Hrh9yiK+T2K4vgD/FgtNMUx+yFmD8TsgfhL/Ap34HeikyeEYncVXiO7wl2L7BbHbnHsFjdKn2SKnZxvxW6i7AfHbnHsFjcVp+F0nIHsAXebTN+twtW4Btfi3bJD3D0ob3PF/Ay32DFx96Jcre4llLeAYt7git3H+Alukv3g5Ewrdt/gt7gdd6A8gRQn7JCkUPZGUoXyJF+csE8SecYr5n2tODlA5p6wNxL2RnKyXLPJKcj1m1wps+bOUpwy35T5pg6ZcroIFyMTT5l4ynxTppm+gEww/UgmmDLHlDmmzDFldp55eWbkR8hE/EhkOj5Gh+P0IabYMx0/S65HT/89/fd029NtT5/980if/Tv4LtJt/6l02H+GdNtvxW34JX6F9Jm3p+LMSIezfCxAOp/Red6hijM6z/tRcSb39+LsWDwOOS0zOs93d8V8d1ec0flM7uzFmfS/REnnS9RYNcyUqMX4sDrBlKjH1XBTop7AFF9W3pSot9Q8U6LexnfwXXwP38cv1GxToraI3INK9PX6JFOib8Ix+hBToifoi0yJnojT9FBTomfqPU2JnoXyDFbC/ahEr9OnmRJ+m6VES4dL9Lv4Hsq7bYn+ED/RHUyJ/hSlnyVBZ+yCXbEbdtclpiSowhq1zZQEtViH9dgDe6L0uYS7UklwoPrAlAQH4aF4GB6Ocq8vCU5GOeVKgsvwcrwCx+jOpiSk2+ESXIp0PnwEH0f6H9L/0CNTCF/BV/ENZBbhh2qhKQk/Q6YQblVXmhLuaCWhnGAl3NdK7HUyC8tc7A14IzIjy0TsdGQidq1MwTILey8yEX5br8Q+h0yHd5aSiN5GdI/7SAn3kRLuIyWRPBeV8MZREtGr6BQ8TfrD/0esJEp0oSmJRuMYHCvGN8qOjW/Cm3GUOtKUxGOQrsZ0NaY/8WtIT+LN0gFHBxwdcONkB7qJ+lRT4qYgtTu5X5e4ebgYl+BSXIbU6z6X/eY24w6kA3wzVsI3YyV8M1aSdFHfmZKkK+ZKf5I87C1dShqwEVuwD9K95EDpT3IY0qXkDLwY5f2rJKUz6UjpQ0pPUnqSTkA6k9KT9A3pRkpPUnri6YmnG3480hNPT/wtOFM642chneHEK/Gf6S6mhJ9BlPBNUQnfFJXwTVFJRtUZVWc9pN6sJ/ZC6uU0K+E0K8moNDsID8ZD8FCkA5xmJTxFl2SXIB3IJskOySbrUlOqpBulSmov5WQrVZkabkqV3DtK1Qv4Ir6EL+Mr+Cq+phaaUn22Ps6U6nNQTq1SPVmfakr1FJRrpFTPwCX6DFOql6K8+5fy84hSnqVL9XrcgE+hdK+Ub1FK+clCqZbuleo/8E/8C/8WgwZdYkoDebsvDZqxBffVxaY02A/3R+lPKT81KA2G4YV4EUqvSgO6FNKlkC5xIpWGdCmkS+GL0oHwJXwZX8FXkf7YG6UznBullv7YiUh/LJ2xs3GudMbOw/m4ABfiIlyMdNLSSbsMl+OtSPc4bUrt57qLKbWb8QvcgltxG36JX6FclaWWPlv6HMk1WBrR24iu8vRbGtHViL7x/UZpJLurlPOnNL5ZOhaPwJE4ChMcjXSV59XSWM7w0tjjc9LbeCPS55gOu+P14abUnYAXS1fdJXgpTpKuuqn6eFPq6KqbibOQvjn65uibo288E5a6Z1HefEvdG/g/fBPfwreRrroPpFfuQ/wUP8NN+DXKKVfKb+mUup/xL/WuKU3kqi9NctR3ppRTrpRTrjQZJP1MBuMQHIp74J64F/4H/4t7Y4gWI9xffWhKEzklSpOD8RA8FA/Ds2R2yfl4ATJNTstSTstSzsnSlHnxnFmaMqn0aZlLulHmkj6PLyBXRMq8/LkyKX8eno8X4C0yI89E/AZtTKl/ClP0mCFz4RuJUs7SUv+xet2Uevrs6bP/BuWZvJTfkCnlWbHU/yrd9r/h7/gnMgX/N/6D/4qZQo0B7oK7YgeUs7o0Y3YZU8uasBlbpedZG/bFftgf23EAMtmMyWb78jf7IVPLDsAzZUbZMGRS2cV6F1OmEjXOlHFil/EdSJl6Wi00ZfoGfZwp0zfiND3clOmZ+nRTpmfhbJSzpUwvxEUo/S/TT2GKHjN8GjfqY0yZfkG9acr0W+p1U8YTZpl+T3cxZfpD/uYT3MTffI6bUSZSxtNmmf4ef8Bf1LumTP+GMp2yQKHGAHfBXVHuj2VBN8zBFl1iygLpeVnQhgej7O0yvv0uC87G8/AC6V5I9zjJy8Kl0j2+6ygLn1TXmLJwPT4l/QxT9JghfbbH64NNmT0BT8STUJ4Vy3hWLOO0L7NTZQqWWVhmYZfq/UyZXYaPI121b0j3LL21byNdtXTVforfSj/tTp1nyiwdi+hY1FG6EXVCuhTRmYieRENwKO6Gu+MeuCfSt0hOhrKI7vHz3LLoHOlYRN9i+sY3DGXxIqSHMb1y9MHRB3eKPtyUuelStVuoh5gytwiXSL1uA6a6ypQ5j59LdU7uSmWOGt0OqdF9h9/jL/grylVcxllaltABnhjLErkSyxKqTg7Eg5DqOPHKkjNxGFJdSl28U5elj8n008fxCaQ6T3WeuvxMqcXPQqrzi6UiT3We6jx1+Qypzm9GavTU6KnOUx2nU5n/Q8y01MLTYBlnS1nWIvmzPshu54Qp43mvjOe9suwsqSU7G6Wuck6JciV1lSupq1w9ietR6irXUlG5nqqHmHI9AxfiIr2fKdeLcQk+p9405ZwA5VqeGcr1l/gVylVczlVcrqWK8iBHl5hy3iLLgyaUO1F5MEyycQ2W801jebgIFyM5uRLLuQbLub7KLTntNMlmpyNp7SLcqPNNOddRuf1CvW7K7RYkm/1BbTLl9kfciT+JkZwh5REJIxJGzXiA5IwORDJHZGb/l8dL1AmmPJa9Uc7OL2fnl7uTJSE7v5x9Xu68pHIZviN53Lv4Hr6PH+CHKHexcvZ/uduOJHekdT/jL/gr/iEm5E9aUO445Uk/3BdlP5Szz8uTc/E8kT1fnlJLul6qSDcgFbHby9nt5ezqck8tnlr80/gMPovPI1X4L/ErJL8nrSet/xP/EjOSZyTP+mArkpydXJ5doLuaCnZyhRqjhpkKvoepUONwPE7AibgQpa4K9aRaaCrUetyAUl2FlrO3Qj+Kj+Hj+AQ+ietxAz6Fb6rXTQX3vgr9rs43FfpT/vwZbsIv8Sv8Gr/FHbqLqdDSjQr9s/rWVOjf+PPv+Af+iX/hv+o7U8G1UxFINyqC4XoXU8FVU8FVUxEulhpDKuWbkAqulwqulwruLxX2HclpSWs/wA/xI/wYSW5JbndKNvsTktPK7CosebhSKiLZXRXR2TKX6ByR66IiniSp4sk4H5+UVFwjFe5UfbipcKfhSDXAVPCtb4Ubg2NxuWR2t+IKXIn34mPqY1Ph1qs3TIVLUfZhhZNztcJtxW1ILW6H5Hc/iImS/IlG2WMVSRtSS7Kf7mYqUjKnZE7XI8k9yf0NktmP0UeYCr9UDzEVfhkux/slm38AH8SH8GF8BB9FqvBPSH7/JFKRpyLvkbr8Z7gJqdFTo6cu/x1+j9Tof1M7TIX/Hf/AP/Ev/Bv/Ebm/VHCtVfB0WpFJByrVaLXKVKopapipVLfgfJSeVHLtVOpTVXdTqaUblfo+9bGp1FJLpc5QToNKLWkr9Rco53BlsKv6zlQGHVBWrAxZK5yArBKySijXaSU7udKylj0NWdHeIyvae5HVLatbVresbrepb02l/UWMlNphKiONrM4ermQPV8ZTZHX2amUsp0SlGy5ruXPULqbSXYvXIas7VnSP4mPI6o7Vncyr0m2Wdd2PuBN/wl8lg5O5VPKsUpl0Q/KwJyvZjZXsxkrO58qUhOzMSk82fxaS0JPQXy/Z2J+V/h71jqn0j0se9lil/1xW96TypPIkYYdU+r/Vj6bS/4P/iplCjbsiaTmrK9ktVeyTKjUFJVsVO6RKS6oqfS1Kqir2SZUeiXerd0wVe6ZKP4gPodxHqtg5VfozlJxVWhJWaUlYFeyK8pRbFXRCef6pCiLcB+WcrGJfVYVkY19Vsa+qLNks2dhLVZZU7KUq9lKVfVi9YarsI5jh00g2Szb7s6SyZLN/IgkjEvIEXsVzRVUkz2lVPIFXxWMkTzwWycbve1TF85CcnJZVsez/Ks7MKnZjlTsLyexuxJvwZhyB69Q3por9WeUeVC+bKkcV7lF8DOUZqYpdWuV2SnInc69yMveqhOR8C1GVkJyn6KqEDrMnq9IRKjVV6UgchTFSESdnFWdmlSehJ6F/QPJ4UnmS+GfwWSSPJw8nVRX7sCojD+/XVTwhVPHEW5XtK0k4r6rVaHW1qVZjcKJaZarVJJyMU3CqGmaq1Wycg/PUCaaaHVutz1K7mGotaav1fNVuqvUilWOqOdOq9ZO4Hp9DSVut5Zqq1pvxW9yOO1BqqdZSSzX7tpp9W82+rea9tTqQuqrZvdWBVFQdUFGYqNRUh1QUjsVxOB6nq1tNdUgVIVWwt6vtMKnCDkcqslRkR0hFlrrsQqnLUp29R71squ0DupOptg/iQ/goPoZUbamanV9tNyH12u+lOvsD/ohUbamaa6Gaa6Gaa6Ga7w+ro1ak6oh645nqRFMdUwv7vzpej7KLqp2c/9XuNKRGdn61oy5HXY5a3P24QdK6p5DkbiO+IMk5e6udXK3VSUfsItk4jas5javZ/9WcxtU8S1dzJlcnIZI5nS6Z0xlI/nQWzpHppNTCtVDNE3U1V0S1pxZ/hlThz0Qq8jdLFZ6KPBX5tTIjT12euvyTMhG/HqmF87zab5eKOMmr/V9SEed5dUZ1WSfsjHJ6V2e5SI3c/as5z6uzSOrK9kF2I9dXjZqmTjQ1ar46wdSoBbgUl6HUWKOPV91NjT4BT8Qb1S6mhqupRkstNVpqqdH3ouy6Gr0B5WmnhieHGv00PoMb1S+mhmutRm9R35oavRW3oVRdo3/D3/FP9aOpCXLU96aGK6smMJiPvbA3NmAjNmEztmIbSk9qAulJTSA9qQnpQzgb56pbTU24WN1iavjWqMbSAXuyVG1PwVPxNKQbXIM1lm7Y+6R2ez/SDUs37FNI7fZ5pANcdzV2s1Rqv8Kv8Rv8FumJpSeWbkRdpBsRPYm6I93gDbeGK7GGp6CaKEQqjeX8qYlH4xgci3L+1MRT8BakJ/EiNcfUxEukG45KHTW6zyWz2yJ53E/4M/6C8gRSk3SVVEk3LJQ8SREWYxmWYwVWYhVWYw3WYh3WY2/pAG/ENVzLNQnVpeRPSZ7OxXm4UPL7EyS/pwq/Tmbhn8ON+Dy+gFTnNyMV+d9lRa61msxIRVk+ki0jW9aT/7UXkpArrlZNVFebWq61WiVJatViNcfUqiV4qzrB1GrJVsuVVcs1VaslYa3+QuebWr1V7TC1ehvKaVwbdFU/mtogV31vaoM8zMcCrNQFpjaowQb+ZSM2YR/d0dQGsitqA4vkDCeoqaY2JFW4VLSHqSJTaw/HI/BIPAqPxmPwWDwOqYJrodaS35Kc+0it/Uky2J/xF/xVjKgo6oY5SC1RIRZhMZZghVQXUWlEpVE9/xX1RtQYtSKVsv9r2eG17PDaWE74Wu5Qtezz2piq4xUykXgl3oarRCcnQK07BanRvSwzcu9Jje59/Bw3I1U7Kk0MlkryhJwJ2RI5jWu579Qm++MBeDyegCeK3Ilq09mSMJ2H83EBLkSqSKkiXYbLkfz+BNVmav2J+Ir6n6n176s/TS27vdbLtVzr2Wme5J558eRW66kio4osHwuwEIuwCquxBmuxDqk6a5SqM2rPqDSj0uxEnWvq1HQ11dSpWWqOqVMLcREuxiUoFdVp2ZN1+mTVZuq0nMN1+nQ8A29EufPW6RH4ls43dfptlHrrggr1iakLJHNdUI01WIt1WI+9UJLXBS3YB+U5p463krpgP5SK6gKpqC6klnCmpA2piCurLiR/eCuuwFXqFlPHVVZnyc8VVMfTXZ2VPVZn31KfmTr7HpLfyqlbF1EFO78uImfUINki0kak5Q2ljqeyumgfJDPfgtZFpI1kp9XFZI5JG8/GOTgfF+AiNdPUxbK76hyZ3Smqg6lz8sxTx1NcnRspyd0oJL97Te9q6tw7kt/Jrqtz8oRZ537AH3EnUpeTM6EuKZfqkhqsRSpNemBPpEbeaOp4o6nje9E6flekjp+N1vH73nUp1aUzkLpSakkX4xKkLk9dnt3lT5fq/Bl4s1Tnqci/gq8idfn3pC6uqTpPdZ4q2Pl13B3qst6SNiMz34LW8TPBOn4mWMfve9QryVav5uICNdPU60NVkanXh+HheARKwnp9pupg6nnTqddn4zk4Ep1qN/U6wdE4BsfiOByPE3Ai3q1yTL2+B6XGev2G+szUB2XqR1PPdVEfSC31XBH1QSvKE3U9z1f1wW64O+6Be6LMpZ6fFNSH1BjOlxpDKrVUaqnLUpcdhsORuiz5Lansa/iu+tPU2+8llf1BjMjJdVEf9ZRsUS8kM9dFPfeIeu4R9Twp1fOzs3p+IlAfz1ZTTX28VFLFcp3WxyvxNtGR05HTnSepuCPUu5swUa2m3o1XfUy9myJp3S04FaehvK/Vu0W4GJfgUlyGy/FWXIEr8TZchbfjHXgn3oWrcQ2uxXtUpal3r0vHHB1L6FIiZ0h9Uok1SMeSAdKNZCDuobaYeq6sen7ToJ7fNKjnJ271Kb1K5yAdS5fhctHTK66men+2zNGfgyOkS55e+dE4Bsci3fOTcDLSN09F/m6pxVORpyL/Bv4P38S38G0xo9KMGjNqzGqxDqmXn9rXZ0Ol0mw33B2pPdsT98ID+Zf0gZ/N9VBz1VTTg6u1h3pUTTc91GPqGNNDvaMWmB7qXXWx6aH3V0Wmhz4AD8SD8GA8BA9F6VUPfa7qYHroC9Uupoe+CK/F6/B6vEm1mh5aetVDz1DtpoeeibNwnao0PfQ7upPpEUjtPYI67Ks7mh7BIF1megRD1BbTIxiKe6E8E/bg7tYj2E+XmB7BAfxZJt6D67RHSHUh1XG19rCktefh+UhmvpHrYW+QnPZGJLOdKpntNCStXS1p7Rpci+Tn6u4RkTnqhySPyByROfoP/hf3xn0leUT+aH8xnq0OMj3ih2U68SNIFVzFPdzFktNdglfjNThO0ro5kpBvJHq4BUiqpF7yJGRLyJbsI2slrJ6wejpbOpbOwbk4D1md66KHv1A65q+Vtfx1yLp+pvTHz0JW96ybsW42RPqQDdVlpqd6UE03PdUj6hjTU72urjI91fvqftNTn6Y6mJ76YrxM7WJ66svxCrwSr1Gtpqceh3Ku9tSv4ev4BsqV1ZPTvmfQT71legYDZd1gCJIhJEP4sGQISRKSwZLBksFeglfLWpZ1LevauaqP6WnnIRnsm+pP09O+hW+LnNs9o1ZZPWrDvkieqD+2I9kiskVyFfeM71YzTc/4XkkY34ekjUkbkzZ+FB/Dd9TtpmdM/nirykzPeJvoqMVdhldIcnZITyfvjz3dC/giviGZE5Ikg3AwkiqhY8luKGdLz5SEKanYGz1TMqTvIknSL9RdpmdKEk8SfymSx5OHndOT99me/mX1ounpX8FX8TUkW7a7LjO91CPqUNOLfdJLyyf0Yif00verStNLp7qT6aU9Zvg0Pocb8QX1oumlXxfZIb0CmVGvoA374yDcQ1bknOkVyDXbK7xXzTS9wvvVZaZX+AA+iA9LqpBs4dfqUdOLXdTLkpA908s+qHY1vexDSCpLKvu8JLGksqRi5/Tijt+LndOL3dKL3dIrGoADkZzRYNwNyRztiWSOyRyTNiatO1S1mV7uMCSnuxQvQzK7MTgW5cTu5e6VDrsnJbN7SnK6Z/kzmZ3s/14JGRIyJGRI9kI563olctb1SsngD1TG9PIH4cFIBnZFL+6tvbir9vKsy7eOvfwGWcuzus/wadyIJPFviLy19crIk5EkI0kmGXor2b291X3qMtNbPYipWm96q2/Vpaa3PlQZ01sfodpMb300HoPH4vF4Ap6IJ+HJeArK/aW3Pg/PxwvwQrwIL8ZL8FKUp4veejTOVn1Mby219+Z5tXdA8pDk4b2SOSQ/O7B3+IjkD6ki3K7OMr0tVdjDkFrs8bKKPQFPxJPwZDwFT8XTkOSWVHas5LGksnMllZ2Hz6gXTW/7rMip1Zs91jt+WJLEpIofxyfwSfRqoukdb1dTTO94h+gOl5zuKFnRDcez8DzV1/TmGbW3u0/tanq7+/FRfExM5BrpnQzCwTgEh+JuuLuY3iPZUlKlpEo3SJL0KUyRbJ48/kjJ4Enlz5Yk/hw8F8nmL5Bs7LfeGRnYYw1KTokG9ZA61zSox9R606DWq4mmQW1Xj5kGLXNp0IejfH6DPh3PwLNUX9OgL1KtpoH7XYO+Fq/D6/EGvBFvwptxBI7EURjjGNXHNOixOBNn4RxVaRr0fSowDfpRfELtahr0k7hevWgauIc2BHtobRqC/4ghdYUP4yP4hFQXPolUGm5XT5gGdmCDpVJ7BFKpHS41Wiq15yP12itUjWmwV+JVeDVSqU34M1VYqrDkt+S35LePIfntepF7bkNE/mhP3AupJfqvGH+o9jMN8Uei219yugOQzO5IPFqSu2PwWDweT0DqcpdITncpUou7HsdJWs7SBkdyR3J3J96Fq3EN3o3U5R5HOfcaEmpJyJ+QP/1QHWsa0o/VaaYh/QQ/VaNNQ/qtusQ0pNtFf5DqbBr8wXgIktlfKJ33F+HlktOT2ZPZ34A34k1Ifp70GnjSa/Dk92T290pOT2ZPZv8EblAFpoGroyGT5I1K9kkjV0Sj2oBP4TvqCNOoD1N5plEfg5KzUZ+KsmcauToa9dl4Dp6L5+H5eAFeiBfhJaqfadSyfxr1aByDUlEj522jvkuVm0YttTTqtbgO71GBadSP43pVYBq1VNTIc0Ij10VjSP7wPXWsaQzfF+0hkt8eilRkj0bq4qRtZP83WrKxtxvtWFVoGi2pLHl4y2jkLaPRksSSxD6lmkwje7sxGiwZ2OGN3Pcb43clQ7xd3WEa3YGqs2l0cuY3upOQ1d1ZSptGd6Gs7i7CS5EkbowkceRh3zaybxudnPyNbj4uwIW4CBfjElyBK/E2XIW3I9Wx2xvZ4Y1ug1TkqIvvnBuTIVJRMhR3Q6pLN6iTTWP6FKbokarTb6XqlNr98dJtT6X+XDwPqddTrx8tNfrZqqNp9HOQ6jxVeDL7OyWtJ7NfjeskM/u8kX3e6J9E8nNHaMzkmb8xY0a8mzfybt7Iu3ljJrU0qbfUEaZJSfImLbulif3fxP5v0sfjiUqZJn0SSi1N+gJVZ5q0VNGkL8ZLMFY1pkk7lLqatLy9NulpOB1nqY6mSc9FqbRJL8eVqtw06TtwNUqNTVoqago6qh9NU9BJfWSa+Fa5KXxarTBNIflD8rPbm+wRqtg02SPxKKQWSy2WKuzpeAaeicOQ6iwV2cukCktFNpFaLBVZOUubuEaa7EypwlKRpRa7WJLbJbgUVyDV2dtwFd6O1Gup11JvRKURlUZ91Fumife4Jp7Gm2LZb02xRzoQv4x0IN6B3+H3ojtOqnbU7qid667JXSgTdFTKtdbEVdbkpuA0qciRyq1BsrmnZRbuGXxWTB7RU0wTV0FTSp6UPClJ0h/UFaaJq6DJszrXQpM/H8ngyeCdZPAk8WORPH6qJPHk8ewZPw/XSh6ugiafosdMzB7Wt5hmzvNmJadis3ofP0C5ZzVr2SHN+ig8RjWbZn0syn5o1sNQ0jbr81HSNrPbm/XNqsY06xE4EiV/s56vOppmvUiVm2a9GJegpG1mPzfrZ/BZMThK/WGag6PF8BVJG5KZ3dtsj5Y8lmyWbOzVZnZpsyWVJRU7ttmSxMaSxJLHTpA8diJOwslITrsAScgObGYHNlsS2o2qyTRHR6mfTXN0tBh/INnij6V78Sf4KX6Gm/Bb0ZHfybnRzK5rdiR3ZGbvNbP3mp3c35vZgc1uvORx5HFkSOQEa+apozlpxwE4EI+VvqWkSj9EVk93qCtMc/qdyK5r9qzOrmtmvzX7m2VFPwJH4igcJxk8SfxaSeJlnzdn/dRPpjk7Vv1sWpSs0qJklRb1Hf6Ef6jbTAv7p4Wd06LlSaZFyx5u0dNwPi7AhbgIF+MyVW5auOO3aNnJLUF/9ZNpCQ6XdYMj8AT1h2kJpdstIUnC7cjq7JAWdkiLZXUrvW2xfLKV67cl4jOjdhyAA5HPj45HVmGmLfFW3IZf4lf4NX6DJInlem+Jf8Sd+LOkisnmlDrOtLAfWph4i5NutzD3FjcFp6lq0+Jm8OeZOAtn4yLVw7S4pShnaYtbhatxDcpzYAvPri3ufnwAH8XH8HF8Ap/E9Uh/EvqQHIlH4dF4jJjSk3SHOty0pN8hVadUnVJvSr1eq0NMCzuwxd8g9XK+tbC7Wjz1+qlSr6dqPx3nSnV+Hs7HBbgQ6YCnA34Z0gGeClr8OtViWvzdeA/KU00LTwUtPkWPGUq9fdQ36nDTR0kVfdSPKFX0YT/3Uf+o40wfLbX00ZK8j56As1W16aMlZx8t6/bR9+NjIju2Tyg7p0/4pXx++BV+jawYsmLIiuFOZMWQFa2SFS3r2kmqq+ljJ+MUZHXL6vY2vF1VmD72DrwT78LVuAZJaB/AB/EhfBRJHn+ijjB94k+R/DH5Y/LH5I/Jz27vE/+Ev0ry+C9JHv8tuptUoenjbsYROBJHYYwOExyNY3AsjsPxOFGqdrdI1Y7aHbU7anfU7qjdrUUqck/IfBO5xvskAzFSX5k+yaEyneQwPEL9bvqkVJ1Sb7oNqZG93SelxvRfmYUnjyePJ48njyeJJ4MngyeDf1wyeJJkA2T1jNUzWbdVvaCOMK3qRXwJN6tjTav6ArfgVpQ8rezPVvW7ute0qr/UIaZV/S3qm1Qv06pHqVrTqkerrqZVj8GxOBEn4WSU5K1aMrdqydyq16JcNa1akrcGg9RXpjUYrH4yrcEQHIq7YaQD0xrsq342rcF+uD8egIeI4fPqctMavoCfqEmmNfwUP0Mq5VpoDb/Hn6Su8Gf8BX/F35Cqwz/wT9HeKFVbqrZUasfheJyA1M4V1GqnSNWW2rleWrleWq2cGK2W2rkWWiNqjwbj7ki98YtSSyzPKq3xq/gavo5v4AcoJ2Rr/B1SXfwD7pTk8V+iSyS5G43kd+Rkh7c6OQlb2eetbgWukrRuNa5BOXla2e2tyf6SMDkY2V0padOX8GUkIc8MrelHMoX0Y/wcN+MXuAWZDqd9a0rylOT+Rsnsb0Kq8OT35OeKaOUMb/Uk97dJWk9+T37O6laukdZsX8mc7YdUkR2A1JIdgoei1NWmJH+bkuRt6lt1uGlTO1AStim5Ttu0pGpj/7ex89s4sds4sdv0CpRUbXoV3o534J14F65Gydmm5Vpu03IttwWSpy0gT/i6uty0hf9TY01bSLZQnsfaLBnsaCQJ53abnYNksGRg17VZPjnik6PDxJjPjN+WT4s/wq+l6ng7/ohyvbTFv+Cv+I/o5N7R5rpiLBmcQ5K4CThZkrDf2hyp3FyUe26bk+uizd2DZEvfkDwpqdI3kTzpN/gtki0lW7oTSZWSiqeCNk8q9kybnyQrepJwr2/zK1WVafO34VqVb9r845Lh/6efDVNfm75K9nNf9QpKqr7qLZRd0VfJWn213EP7cu/uq7viSFVr+rIr+uqJqtr01dNxBs5RPUxfdkhfvRzvVvmmL3f2vlrum32Ze1/m3jeIJUngxPA1WT18HckTkid8G9/Bd/E9fB8/QDKHZOaO39eS0zrJaRMcjWOQ/Jb8lvx2pqS1s3A2Uoudi1RkqchSkb0H7xXZb32jw6WiiLoi6uKs6xu/IgljEsY/4c8oM+3LTuvLTuvrOmMX7KbON30dVTiqcONwPJLckdytVN1MX3cb3i95nDyZ9HXyZNI3IUn6nGRIX0bycGr1TcnD7urrmTW7q68niSeDj2VFTwZPBs/qPG329azuV8m6ntU962aHSR8yWb2fktX7KbmL9VMv8ed38F2UJP3UTygnTz/dETuhrN5PJzgWZa1++mHVYvrpR0R2Ub+QVcKN+DyyVvi+Osf0Cz/AD5EVQ9ayrGW7qPNNPzteVZt+dobqYfrZlbKKvQ1Z0T6Ij4jMt18s+7Nf/Da+K5/MedIv3olyqvSLWcXx+S6Rz3ejcQyOxXHI6m4CTsSpOA1JlRyqvjL9EultPybbLyVDyurpR0iGlNV54urnqZE3hX6ez+Ge0i/j0/5/Rplcg/05Afor+Zz+Sp40+iv5tP56V3WI6a87YGe1m+mvJ6h601/LZ/bX01UP01/PwBUofevPpPoznf7BUn2T6R8sE0M+P/wYWSVkFa7f/pa1bCdZxcayinXIipYVOfn7M5f+zKJ/xOczhf7xe/g+8vnMpX8sT0f9mUt/xyrMpb/rit2wAiuxBmuxB5KBM7+/m4K34HT+njzp2+oe0z/9RFZnFv2ZRX9m0d+zrqeHXF/9falaa/r7MqxHVvETkbX8NP7MKn4GzlT1pl29o+4x7epdlH62q09Qng/b1SbcitvwK3WnaVff8uftuAO/w+/xB/wRJXk7V2U7e6Cd6bdrSd6ui1Dyt+syLMcKrMQqrMU6rMdJkl/fgtTC/aJd367yTbu+Qwxkn7cHZ+IwHI7nqa9NO3eH9pDaww/wQ6TqkErDr0VLZktmS1pbjTVIEjsZpyCpLKk489vtCtXTtNuVeJsYybXTHpEtGq4+Ne3RWXiuyMnQHr8neeL3kWwx2eLPcZtki7erU017/BPKU3p7/Dv+gX/iX/g3yjXS7uSsbueu0e4C7CA1OiplJ7e7YnWgaXflWIFU5OTu1u7m4O1Si1sn3U7InyR6vmlPxomcLe3p2/gOUkX6CX6KW6WKlFpSakmpJf0Zf8FfRb+rZPPkZP+3+y6YL9l8IRYhyX0JlmIZUounFl+tJph2X4O1SHWeujxT81Tn78A78S6k3ozZZeyrbIxuMu3ZeN1kBiipcYD6CD/GT/BT3KzGmAHqC5R6B6gd+J2ou6rdzACdq843A7TUNUBXYCVK5gE8Iw3Qc3EezscFuBzXqi5mgH5Y5ZsBWu44A8JNsmL4OZIhJEPIunYXWdeyus2RtaxBMlgyWFbnqWaAZS329gDLWpa17GOqtxkQyzPVgJjPj3eoO82A+AdZxbEKe2mAm6fqzQC+TxvgFuMSXIrL8FbV0wxwa+TzHas4eXYa4O7F+/B+fETWTVk3/RA/wk8lQ0qSlCQpSTxJPPWyHwZ4OuyrcbZk83NwLi6QJHzfNcCTxz8oK/qH8GGUDAOVrDiQc3Kg+hq/wR34HUqGgVr280DdATtiJ+yKsu5ALesO1HfiXbhOVglZJdyKfH7I54d8Mve+gZb/1i7FZSh3vYGWT7NSxcBYujQw/hj5TLeLesAM5Eof6JbLv3T8e7da/j2zGMgsBrq7JYm7R6TbA+n2wPRr/Eb0fJrfFTtgR+yEnbELdkXW9bOQ1f2tuAJXInmYwkBPEi8ZBinJMIj+D1I7RK3UqWaQ1ihJBumu2F0daAZxfQ3SBViIsvogPQvllB6kV+HtuE51MYNCVgk/kc9nCoNC1rKsZVnFsorNQz6Ze8Ege6fktHfharxbPjM6X7ebQcxiUPyPfE78r+j4HNcdC1DOtEGuFMuwHPl85jWIq2aQW4ErcZWs5W7HO5B1E9ZldoNSqkhZ3WtZi4kM8t0wB0niSeILsQjJwOwGMbVBnhU9a3n65lkxY8XsAt1uBjOvwcxrMPMarP5Sp5rB6m9Rl6gHzGAtnzxYy1PcYO0wwdE4E+eonmawXorLcDnepkrNYC3dHhzIuoMD1g1u1H3M4OAmkWkOZpqDmeZgpjk4/A6/xx/wRyRh+K9ks9L/wZaEPGcOtqRi1oPtLCSVJYldhbfjHXgnrpWEVvbwYHbC4IiEMSvGch8cHP+Of4hMf7CTZ4zBbipOw+k4A8ngyOBm4zxJ4hbgQlyEi3EJksetkSRc14PZIYMT8qTkSXfir+poMzj9TfRMyo9SA81gH6PDBEfjGByL43A8TsCJOAkn4xRcJnk8qTyp/N1INvbS4EyyDVFy8g9RMsch7Kgh6md1tBmiZFJDtEKNJThdDTRD9GJVaoboO1E+fwj7ZAg7ZEj4obrPDAk/Qj4z5DPDv/Bv0fKZls+0pViGfL6dqUrMEDsLZ+McnIvzcD4uwIVIKrsEycZZMcSSkB0yhB0yJP5WUsXbkWyc3kMcqzs+zfE5THaI43OY7xAmO4TJDuHcHpLuUPubIel3+D3+gHyyV8jnez7f8/l+EfL5zGgIcxnCXIYyi6Hqa9yu9jdD1Y8onzlUK5RPG8oUhtL/of9HcpksR3FsYfhUP0GuCJ7gwuLeCEJ3UVVZU1ZJiLu+j3CvZ+89bOzsJozBgJCQEWYUk2Gt4AWy+xlsQHNLrQEz2kggCTB2nI/NFxnZJ/9z8j9ZWdX4HztWuSca71jrWEsXYhyO66uyz8R4EjefQtY2Z6ODJm4mlX5JZkzs9csn9gO4BtchWXAv9u/gX8q2djbGybhNlvY1rbB9Hd6AN+FteAfqbRPjahzIG8gbyIWTcSBLV89kzE0b42fcnYDk6qLcRbmLJn4mopqJ6LdcIr+JMwl+JrIn/zWJvIV6MpNoTP5tkkjVkmgaXlPibeLQcctwVXKTuAFcgxuq7B5CsrhHkFxuC27Dl/AV3IFU4t7JXZNwTyY1ldRnIPXUN2S/Seqb8Bb8Ger7IqGDie9rbZ7aPFX5Ta3BU4nXs5r455CM/jV8A//UvO3Lmqt9Bd5WffqSBJTDumoG1MITyUwS3uqqwNoua7vTuqrLWvxP8T8VXZXKM8lMiv+pbMHXSpxPo3HZZ9JoAl6El+BlqJoppz11fVVzK3AVDuAa3ITkcs/h75BceJvWZOH2SOsrqlyjX99R8nSkHk2/AdH0D+FjVfMvoHYz9epDiodpG/223l1p+yd4AZILV1POfMp9knY+U5c6n8Mv4JfwK/g1xMnON9FBk3a+hR62laEv1qRhBVJzoObwSCsMVBuoNmxBaqZraZdqu+NaVZeau1OM9bsr5TlKuZ3SHhX2qLCnea2ophVVs7IL9+Ab5lXf0lkbqb6N9G630SS8xcwdOWAs3xuWzlq3KtZYty7DxrpN1XFk4dmxPDvWkcuRxZGFntqaLPU5iH59W/XppvX6VrJ+EZLFr2kWTxZ6Z+mdpS82EB+IDAO4oZGBePy0+GnDNnwJd+EbVePpsPhs8dnyXNiunjGLq5ksSWEyUf1MXklmMtlRRvqWz6KT8BQ8DcfgVdlvsmgaXoPX4Q2ot0SGn1lrPDpostYEPAsn4Y/wHJyC5+GlaMhkblmrcn24AlfhmtbpqNPtyl2TuT1lTT3cThluZw15mwuq1lyEKPs5mTGZR9+j79H36NOLzG9D9TNro9nx0QGTddrwvOoEdMI8RCGgEAYQHfqShR2tsDst/zBZF7Uear0p1empWi6qlsui1CYX3Vcuuq8cD/OWxueOGLegMY5I14frkpncvZQpk7tXStzIa+1Ijht5g4JnrWetZy27zv0AosOtknvUPGpez1LODZNzSvPO/1QTT/LOUa0/oBzQ5GTmYVvXBnQCOmEH7sI9iFoPtd7/o3+avPcB/BB+BD+Gn0DNWOBVIWvSmELU54J7oGipTtFSnQKvCrcgpSncIlyCy7APUeAZL3jGC/dWpkzRoNOg03SiIVM0useCk1N41DwKflUV/ACi5vWtVPin8Bn8A6LPmSk4M0VAJ6ATUAgo8F4r2WMpGlOK/lqy31I2+HUTPoTqcynqc8k5KVvfRUOmbB2D3ytxo8SNEjdKhyYOlDhQOtTcI0lN6R7DJ/ApfAG34DYkI+eqbDrRIVM2RyEZvbpd+mXYhyuQvHhV8i4rOUVl57iu6pyAP8CT8BQ8DcfgGSVOloEseFgG1DghZe9YdMhU8kBmTCW66wonK1mCGlnhVdU6rpGtiWjIVI54N6e/4lLFman4pqrYadUQ35yAp+BpOAbPKD067K5id1VnXOcD82FB58MiXIJEUnnVI7I3Ac/CyeiQcezFybw446jfUa1zzFChI6MLs1DHtczC92PtbC3a2bp1IvqXqR2/sram43XDvGfez8F5uAAX4RJchn24AlfhAK7BdXGmkXsyYxqZFWsax9g9YMyMh0E5TOSw3FcSOezndN7PwwW4CJfgMuxD/fYY5gtkRGalMiMOetUZ8YzDA6jjw/KLzJjD8qvSMXb3pTKjcg8yZn4UhVGva0fRGfVzcF4Z9Ncj/N884hbhsoyYIx4G5X/kPftwRUb+DgAA//8w4V4vQKQAAA==",
-		},
-		0: &zoomLevel{
-			gzipData: "H4sIAAAAAAAA/yTcdXzb1v4+8Ec650gqJI0T25IsSLnDdgx3lFRyabx10O7e+93l3e0Oy+3AqeVu3Tpmxo6Zu61dUsnrmBlL2zpmLvxeen7/vF9+KbLsPJ+PzjmSnaCfthw3op+W5nanOBr99el0Bp1JZ9HZdA6dS+fRk+kp9FR6Gq3RntyxPObYGdr16D92pnYD+o+dpd2I/mNn0znaTeg/dq62GP3HztNuRv+xJ2u3oP/YU+ip2q3oP/Y07Tb0H1ujPOZ8HnP+DO129O97SrsD/ftW5FuWc/vyGdqdGKA9hWUYoK3Q7sIA7WkcjQHaM7n8HQfoM7S7MaCb+3Sv0O7BgO6ntXsxoJv78D0PGDsDf8WAnqe1+zCg5xntfgzoeTbf3vNc7vzp2gMYMJ/79D2dH6fvGe1BDOh7VnsIA/q4z/Lp2sMYsDzfZ6D2rPYIBmrPaY9ioPY8jsZA7YVcfbq2BAN17tP9LJZhYPdz2mMY2P289jgGdnOfsdO1JzCQ72dgz/PaUgzs4fb507VlGMj3MLDv+fy5fS9oT2Lg8ulaLwbydVu0F7U+tGgv4Wi06NO15Wjha7V0v4hlaOl+SUvRMna6lqGFx2/p4fael7QmWnpe1p5CS88r+XPnT9dWoIWv1dL3Ur5P38va02jp40+XT9eeQQtfsVU7AHegVZtMD6GH5urTtWfRqs/QnkOrPlN7Hq36LO0FtOqztRfRqs/RXkKrPld7Ga36PO0VtOona6+iVT9Few2t+qna62jVT9PeQKte095Eq96Tv1b3AdpbaO0+EHejtfsg7W20dh/Mx5PpIfRQelguK9s6dgadSWfR2XQOnUvn0ZPpKfRUehqt0bwbW3v2yX+7nn3pfnR/7R209u2bv2LffnR/3I1B2n7auxik/f/HB9AD6UE0f+eD5Dn4AYPkufQ8ej69gF6Y270/7sCg7gPogfQgyiPEPEJ8rvYeBsXnaRUMis+nF9ALc5NF9Gx6Dj03N1uUPzc7m/I4Wb69TZ6JH9Amz9LeR5tcpH2ANnm29iHa4jO1j9AWn6WtRFu8SFuFtjg/ZlvPrrgDbT270z3onnQv2kW76Vga5SZn5K+SLNRWoy05U1uDtuSs/Gh9u+BAtPXtTvfQ1qKtb0/tY7T17aV9gra+Lu1TtPV1a+vQ1jdW+wxtfZH2OdoyHi1bqH2BtuxM7Uu0ZWdpX6GgxdrXKGhV7RsUtHHatyho47XvUNAmaN+joE3UfkBBm6T9iIK2N+5AQduH5vUtyDO1n1CQZ2k/oyDzDAsy/30L3TEORKG7SsfR8XQCnUgn0b21X1Do3kf7FYXufbXfUOjeT/sdhfhM7Q8U4rO09SjEi7QNKMRnaxtR6DlY24RCz2QdKPQcomso9Byq6yj0HKYLFHoOz99Vz5Tc+cfpEoX5x+sKhfkn6AYK80/E0SjMP4lOo9Np3v+FxNI8FJJ+uolC0l+3UEgG4AcUkoG0hbbSQXSR3g+F5Gy9PwrJOfoAFNgzhcZAzUWh0UJb6SDaRgu0nXbQIi3RMrWpQ11aoR719YEoNAK9BYW+g/MM+ybTQ+ih9DB6eO7y4/RWFJYfjwdRWH4CPZGeRKfR6fogFJYzh4w5ZP1of70NhWyAXkAhG6i3o5C16B0oZK16EYVskF5CgedIgedIITtHL6OQnavbKDSZQ7NFd1Botur540F6BYVmm+6h0CzoPgrNdj1Aodmhhyg0i3onCs2SPhiFZlkfgkLTpg51aYV6+lAUmr4+DIVmoLWgXT9OH452Pf8d2+VAzUO7bKGtdBBt00egXRb0kWhX/TUX7WoAHaiPQrtq0TdD+9jj9M3RPpbHiXmcuEXfAu1xq74l2uNB+lZoj9v0rdEeF/AD2qv9dQft1QH6aLRXB+rD0F5tyd/P/P/haLTPP1Yfg/ZkoL4N2pMWfVu0J636dmhn/7QnbZTHSdppBz1P3x7tyfn6DmhPLtB3RHuSj1HtyUf5u01W0lW5DYv2o/31ndDeGJC/+vJj9Z3RnvH9Z8wha9V3QXs2SN8V7Vmb/ie0ZwV9N7Rn7fruaM/4utl5+h5oz87X90R7doG+F9ozvm72kd6F9myl3o32jK/b5Os2++lj0d7sr0dob+av2yHb9RgdMj9ahzyDLqRn6lV0yLP0ceiQi/Tx6JBn6xPQIc/RJ6JDnqtPQodcqe+NDpkfv0MZ1NT3QYey9H3Rofrp+6Ejbtf3R0fM48dn6AegI16YHyE+U6ugIz6LLqJn03PouXSlfiA6Yh6/augHoaNq6gejo2rl77zaL7dHwwp09OhUUEkVNahJLdqP9qcD6EDaQlvpINpGC7SddtAiLdEytXPn5+dmx/xpdDqdQWfSWbn13bXJ6KjvQfeke9Eu2p2b5D3QkbTQVj3fMkg/BB1Jm34oOpKCfhg6knb9cHSw9zqShDboAn0KOpLT9anoSM7Qj0BHslD/MzqSlfpf0JGs0v+KjmS1/n/oSNboR6IjWav/DR3Jx3nCDZ0KKvW/o6Oh9H+go/f3/Lfr/UP/Jzp61+v/QkfvBv3f6OjdqP8HHb2b9KPQ0Qf9v+jo06hOBZVUUYOa1KL9aH86gA7Uj0ZHH2vR16ofg46+Qfr/0NHXRgu0nXbQIi3RMrVzl+fzRcfyaXQ6nUFn0ny91JGyFuke+rHoSPfUj0NHupd+PDrSLv0EdKSsRTZQPxEdWYt+EjqyVn0aOrJ8HOjI2miBtlPWImMtsoY+HR3ZAn0GOrLT807OzqAL6Uq6iq6ma/SZ6MjW6rPQkbEWTdaiKfTZ6GjKvM+bSmtBEeuwAkV8Rj+nv9M/9DkoYr0+F0VswIEoagP1eShqLfrJKGqt+ikoaoPy7WInbTKKYme6i34qimJX/TQUxZ/0GopiN70HRbG7Ph9FsYdeR1F06QmKoltvoCjG6gtQFJF+Oooi5hGqdAKdSCfRvfUzUJT9NQ9FOUBfiKIcqJ+Josxn5KJspYPobDqHzqXz6Mn0FHoqPY3W9LNQlD36IhTlfP1sFGVdPwdFmejnoigb+nkoyjzVolxD1+rnoyg/1i9AUUn9QhSV0i9CURn6xSgqU78ERWXpl6Ko+umXodi1Tr8cxa7P9CtQ7PqcfqFfiWLXH3nOXev1q1DsYsLdA2kLbaVMOGLC0c761ShGu2gRitGu9E90N7o73YN20W46lkY01q9BMarq16IYTdCvQzGaqF+PYjRJvwHFaG/9RhRjJhwPoAP1m1CMW/TFKMat+s0oxkw4ZsLxHP0WFOO5+q0oxvP021CMT9ZvRzE+Rb8DxfhU/U4U49P0u1CMa3lucQ+dT+s0oQ3KhOM1+t0oxmv1e1CMP9bvRbEqNRfFqqIGNaml34ditZ9+P4rVw/NOrk7RH0Cxtk5/EMXaZ3lu9cF5bvUhdCgdRofrD6FYH6E/jGJ9pP4IivVR3L4Z3ZzupD+KYn1nfQmKdWZYj/THUKzH+uMo1qv6EyjWx+lLUayP15ehWJ+gP4lifaJ2MIr1V+lrei+KSaveh2IySF+OYtKmpygm+ZxbTNr1DMWkQ2+imBT1p1BMSvoKFJOy/jSKia0/g2Li5DknLp2tP4tiMkd/DsVkbp5VMo+upKvoav15FJM1+gsoNphVw9RfRLFh6S+h2Oinv4xio7/+CopcIRQbzK0xRX8VxcZU/TUUG0fgBRR7mWEvM0wH66+jmA7R30AxHaq/iWI6TH8LxXS4/jaK6Yg8mXSk/g6K6Sj9XRTTzfT3UEyZYbqT/j6K6c7cZ6z+AYpppH+IYhrrH6GYVvWVKKbj8qzS8XQCZYbpq/oqFNPX9NUoZvn6rZgNom20QNtph74Gxayor0UxK3FLmdr6xyhmjv4Jipmrf4pixr7N5ujrUMzm6p+hmDFDjp/FbJX+OYrZav0LFLM1eXpNQ/8Sxaapf4Vi09K/RrHZT/8GxWZ/fTCKXO0Um8ywOYVO1b9FsZlnWMLd+nco4R6sQAl5niXkeZaEo01GSbi0Qj3q04CGtJMO1r9HSQzRf0BJDNWOREkM039ESQzXf0JJjNB/RkmM1CKUxCi6mf4LSmJz/VeUxBY8wpZ0K7o1Ha0/ipIYo/+GkthG/x0lsS23b0e3pzvof6AkdtTXoyR20jegJHbm8btot74RJTFW34SSiARQEq8KDSXxmtBREq8LgZJ4Q0iUxJuah5J4K1c61BUKJVkRBkrS03dHiaN0Sc4TJkoyr0VJrhIWSnK16IeSXCP6oyTXai5KMp/LSsoQA1BSphiIkpoqWlBSR4hWlLruFoNQ6mLmXetEG0pdzDxyRAGlyBXtKEUV0YFS5IkiSpEvSihFzDxi5lGnKKMUDRY2StGQPO2ImUfD6HA6QjgoRSOFi1I0SlRQijbLM4k2p1sID6VoS72GUrSV8FGKthYBStFoEaIUjeE+29BtRSdK0XZiMErR9mIIStEOYihK0Y786U6UmXM8L0XdYhhK0VgxHKUo0g5GKXqVvkZfp2+IEShFb4qRKEXMPGbmsUsrYhRKsSc2Qylm5vE8sTlKMTOPV9HVdI3YAqV4bZ58zMw59paqptgSpepUsRVKVfZ57W6xNUo1Zl57VIxGqbZEjEGp9pjYBqXa49y+TmyLUu0zsR1Ktc/F9ijVvtDnolT7Mq9O7avcejHPv16iZWpTR+yAUt3Nk6/7NBA7olQPxU4o1TvFzijVB4tdUKqzXvXN6OZiV5TqW4g/oVTfUuyGUn0rsTtK9a3zJOujKWtRZ7b1brEHSvWxYk+U6sy2HtMqZbb1N8ReKNXfFF0o1ZltwmwTZptURDdKiZenmjDbhNkma8VYlLgeLjWkiFBqKBGj1JigtaDUmEgn0b3pPnRfuh/dX1RRahwgxqHUOFCMR6lxED1YTECpMVlMRKlxiJiEUuNQsTdKjcPEPig1Dhf7otSYIvZDqTFV7I8Sx/ZS793iAJR6Wa/eR+kS+hhlvXrX0c/o5/QLcSBKvV+Kg1Dq/UocjFJaFJNRSkviEJTSsjgUpdQWh6GUOuJwlFLWK2W90oCGtJMOpqxXynqlm9Mt6JZ0KzEFpXRrMRWldLQ4AqWU9UpZr7SbjhV/RimNxF9QSmPxV5TSqvg/lFLWK31D5Mfh+JOyXhnrlbnibyhllbxGGeuVsV4Z65WtFX9HiWvmUlOKf6DEdXKpOUH8E6XmRPEvlJqTxL9Rau4t/oNScx9xFErNfcV/UWruJ45Gqbm/OAal5gF52s0D6UH0YDqZHkIPpYfRw+kUOpXm9Sojr1eZ80gZX4v/oYxvxLEo41txHMr4DgeijO/pD7miTZuMsijQdnE8yqJDnICy2Eo7EmWxtTgRZTFanISyyPMsi5hWxTSUxTgxHWUxXsxAWeRzcVlMpK/TN8RMlMWbYhbK4i0xG2XxtpiDsnhH81CWZTEXZWmLeShLR5yMsnTFKShzbC/LeeJUlLmKLss14jSU5VpRQ5njeVlupJtyFagmelBWupiPshKijrKSIkFZ5bUoq3F0PJ0gGiiriWIByl13i9NR7rpHnIFy19d5Yl3f0G/FQpS7vhNnotz1vTgL5a4fxCKUozZxNspRQZyDctQuzkU56shTiphVtLU4D+VotDgfZY7b5YhZRVU6jo4XF6AcTRAXohwxK47D5egN+iZ9S1yEcvS2uBjliFnFZWpTR1yCcuyKS1HmmFyO54nLUI5Xi8tRjteIK1CO87mvzHG4HG8UV6IcbxJXoVyFuBrlqpbnUNWpoJIyq+o4cQ3K1fHiWpSrE/KOqk7Mrd2NDOXaPeI6lGv35inV7qP30wfog/Qh+jB9hD5Kl9Dv6Q/iepRrP4rtUK79JG5AufazPhfl2i95T9Z+pb/l1tvEjSjXC+ImlOvMub61WIxyfbS4GeX6GHELynXmXK+KW1GujxO3oVwfL25HmeNwuf6GuAPl+pviTpTrb4m7UK6/Le5Guc5sE0fcg3LiintRTvJzvMwxuZzMpLPobDpH3IdyMlfcj3IyTzyAcvJh3p/JR3SleBDlZJV4COXk9zz55A99LMrJevEwyskG8QjKDfZhY7x4FOUGs20w28YkunduL3PuvUcsQbn3XvEYyr330fvpA/RB+hB9mD5CHxWPo8zxudzLnHt/oD/Sn+jP4gmUe38RS1Hu/VUsQ7mXOaccB9KCeBLllDmnW9PRohfldIzoQzmNxXKU06pIUeY6vMx1eDl9XWQop2+IJsocP8scP8vp25Q5Z47eh3LmiqdQzipiBcocS8vZTPE0ytksvYpyNls8g3I2R5+EcpZfs5S53i5nzDn7SDyLcrZSPIcy7yiWM+ac/SGeRzlbL15AOdsgXkS5yZybPN+bE8RLKDcnipdRbk4Sr6DczHO28TAy2HhEvAobj4rXYCPPzcav9DfxOmz8Lt6AjT/Em7CxHgfC5p0NGxvpplwxUJsMW7TQVjpIvAVbtIm3YYuCdiRsMZqOEe/AFrF4F7bI1wm2GEfH0xfpS/Rl+gp9VbwHW7wm3octXhcfwBZvaB5sWaGe+BC29MVHsGWAH2DLmWIlbDlLrIItZ4vVsOUcrQJbvk3foe/S98Qa2PJ9sRa2/EB8DFt+KD6BLT/SXNhyPd0gPoUtN4p1sOUm8RlsBa0FttLoBDqRTqJ7i89hdzHbrkfoo+IL2F1LxJewu5ht12/0d/oHXS++gt21QXwNu2uj+AZ2F7ONmG3UIr6FHbWK72BHg8T3sKO2PM+I2UajxQ+wozHiR9hRLPaAHVXFT7CjcWIG7IjZRi+Kn2FHL4lfYEcvi19hR6+I32BHr+YZRq/R1ymzjZlt7FFf/A47DsQfsON8TLDjWXS2WA87niM2wI7fFhthx++ITbDjdyVgx+9JDXb8fp5h/AH9kDLbmNnGG+hGukl/GXYVUodd1aSAXWW21YlSwq5Okgp2lX1be1QasGtLpAm7lq/BbK6Z7doTdCndKBbCrm2SFuweyH6wezSxCHaPnqfaI6ikis7FHbB75tGTcSrsnlNy6/3z/OsDKGtRb5H9YddHywGwuR6269vQbek4ORB2nZnXn6XP0edlC+z6C7IVdv1F0QW7/lKebeLLQbCTQLbBTsI81aSTzpYF2Ek+DtjJ27IddvJOnlLyLn2Pfk2/od/S7+j39Af6I/2J/kx/ob/S3+hG2QE72SSLsBvQB8NuaLIEu7FLnn9jV/onuhvdne5B96R70S7aTcfSiMa0SsfR8ZT1bUyUZdiNSdKG3dhbOrAb++RVbuyb2/tofgb1LpEu7N7HZAV27+PSg937hPRh9y6VAezejXnFezfl9oFq4nrYfboMYfcJ2Qm7T8rBsPtY6755mAK772Q5BHYfq5z2l0NhpwPkMNjpQDkcdtoiR8BOOWqlY+RI2Ok2og92yiqn4+h4OQp2yiqnz8nNYKfPy81hpy/klU1fpKxy5tNAbgE7C+WWsDNWOZstt4KdzZFbw87elqNhZ+/IMbCzd+U2sLP35LawM1Y5+0ZuBzv7Vm4PO/tO7gA7+17uCDv7Qe4EO/uRj3+SO8POfpa7wM5+kbvCzn6Vf4KdscrZRrkb7GyT3B12kyNYkyNYcxe5B+zmrnJP2M0/yb1gN3eTXbCbu8tu2M095FjYzT1lBLu5l4xhN7tkFXazW46D3Rwrx8NuRnIC7Car3KzKibCb4+Qk2M3xcm/YzQlyH9jNiXJf2M1J+BV2c2+6j9wPdjOvsoO8yg6WyP3h4DF5ABw8zi1PyAPhYKk8CA6WyYPh4EmsgKNJ8QQcTcnJcDRDHgJHM3EgHM2i/ehOdGc6E3fA0Wbliv7yUDhigHYkHLGNPAyOyGvqiHF0PJ0gD4fDlbwjnqIr6NNyChzxjJwKRzwrj4AjnpN/hiPz+joykH+BI0P5VziyUy/CkYPl/8GRQ/ADHN5jd+QceSQcmc/sjpxH36XvCQuOfF/+DY78QP4djsxHS4czkSM/p1/QL+lX8h9w5Nfyn3DkN/JfcOSv+A6O/E3+G478Xf4HjvxDHgVHrpf/hSM3yKPhyI3yGDhyk/wfHAV5LBzOYo7SqaA70B3lcXDUTvJ4OGpneQIctYs8EY7aVZ4ER43Fr3BUJKfBUbGcDkdV5Qw4ahK37y1nwlH5Wewo1lftR/enB9D8Ks/pYt27ltDHKOve9QRdSpfJWXC6npSz4XTLvPrdihrUlHPgdFtyLpzufuJgON2se/fOch6cbta9examwIn6y5PhRKx7xIrzesSJxstT4EQT5KlweD3iRE/J0+BEK2QNTvS03BxO9Exe3+hZ+lxuzIrHAQ1pJx0se+DEQ+R8OLzn78RzZB1OPFcmcOJ5sgEnZsXj9+j79AP6oVwAh/OjE38uT4cTfyHPgBN/KRfCib/K6xt/Tb+hrHj8G/2d/kHX0w10I90kz4RThTwLTlWTi+BUdXk2nKqQ58CpsuLVHeW5cKo75RWs7kx3obtSVrwa0ZhWKSte3ZvuI8+DU91Xng+nup+8AE51f+nAqR4gL4RTZcVrrGxtqbwITm2ZvBhOjWc0P+d1egryEjg97fJSOD0deR17irREWdmeneVlcOqWNhlOvZ+8HE59nLwCDudcp95L++hymtKMNuWVcJLBeaWSIXSovApOMiyvFK93HF7vOLzecZI58mo4yVx5DZxknrwWTvKhvA5O8pG8Hg6/UeDwGwVOspquoWvpx/QT+qm8AU6yTt4IJ/lM3gQnYY0S1ijZKBfDSTbJm+E0pLwFTkPJW+E0DHkbnIZJLXk7nEY/eQecRn95Jxx+HuE0BtIW2koH0e3o9vIuOI0d5N1wGjvKe+A0DpD3wmkcKO+D0zgor0jjYDqZHkIPpYfl9j4h74fTu1Q+AKd3mXwQTi/r1cd69RVoO+2QD8HpK8qH4fSxXn2sVx/rlVryEThpP/konHScXAInHS8fg5P2ysfhpH3yCTgp65WmcimcNJPL4KTNvEYZ65UNkU/CyYbmdclYr4z1ymbJXjjZbNkHJ5sjl8PhdZPD6yaH101O9pFM4WQrZQaH101Oxnpla2QTTrZWPgUn+1iugJN9Ip+Gk32a1yVbRz+jrFe2QT4DJ9son4WTbdJsOE1JFTWoSS3aj/anA+RzcJoD5fNwmi3yBTjNVvkinCbr1dxOvgSnuX1+HjV3oDvSA+iB8mU4zYPkK3CaB8tX4TQn52dZ8xD5GpzmofJ1OLwn5iI/v1wslW/AxTL5Jlw8Kd+Ci16sgIu+XK0o34arleQ7cLUyDoSr2dShLq1Qj/o0oCHtpIPpEDqUDqM70bzurjC0yXCFKd+FKyz5HlzRTzsSrhgn34crxssP4IoJ8kO4nG1d8QRdSpfJj+CKJ+VKuGK5XAVXpJoHVw6mQ+RquHKoXANXDpNr4crh8mO4coT8BK4cKT+FK0fJdXBl3ieunCU/gytny8/h8nrQ5SzschZ25Ur5BVy5Sn4JV+a94co18iu4ci2+gys/puvpBvk1XLlRfgNXbpLfwlXQbLhKo610kPwOrmqT38NVBfkDXNUuf4SrOrQWuGowHUJH0lF0M7o5HU3H0G3otnQ7+RNclfeJqw6VP8NVh8lf4KrDZb5livwNrpqad4I6gv5Z/g5X/UX+AVf9Va6Hq/5PboCrjpQb4aq/yU1w1d8V4Kp/KA2u+qfS4ap/KQFX/VtJuOo/PM5R9L/0aHoM/R89NpeztstZ2+1aRp9UCm5XrzLgdvXlfdhdzDuwu0TLyoTbbSsLbrej+sHtdlV/uN0VNQBut6cGwu32VQvc7kC1wu0O1SC43Z2qDW73YFWA2z1EtcPtHqo64HYPU0W4nP3dbvZeZKgS3MhUZbiRlXddxN7jvO9G4+kEZcPlvO9G7L1oqbgDbrRMOXCjJ/NOi5ZT9l7M3ouH0KF0GB1OR9CRdJRy4cYzVQVuPEvlW2ZrG+HG7L14rvLhxuy9eKUK4MarVAg3Xq064cZr1GC48Vo1BG7M3uOc7nJOdzmnu/EmNRRuFWoY3KqmhsOtsveqg2gbLdB2NQJutUONhFsdrEbBrQ5Rm8GtjlSbw62OUlvArW6mtoRbZe9V2XvVMWoruNVt1NZwq9uq0XCr26kxcKvsveqh9DB6OJ1Cp6pt4FaPkK/Arf5ZbQu3+hd8Bbf6V/p/9Ej6N/p3+g+1HdzqP/n4X/Tfanu41f+oHeBWj1I7wq3+V+0Et3q02hlu9Ri1C9zq/9SucKvHqj/Brd2Q91vtRtqrdoNb61O7w60tV3vAraVqT7i1LO+6WjO3ZzgdIbaF2zNS7QW3Z5TqgtuzWd6HPZurbrg9W+R92LOlGgu3Z6u8D3u2pqNVBLdnDH+6Dd2W+2zHn26f92rPDnmv9uyoYrg9O+W92rOzqsKt6/l4WBdUUkU5TtZNaqlxcOvs1foENR5ufaKaALc+Ke/P+t50mZoIt87+TNhvySg1CW4yW+0NN5mj9oGbzFX7wk3mqf3gJier/eEmp6gD4CanqgPhJqflvZfU1EFwkx51MNxkPrfU6Wo1GW6yRh0CtwF1KNwGx7eGTgUtqsPgNkr5KNcoq8PhNmw1BW7DUVPhNty8ixoV6lGfBjSkneoIuI3B6s9wG0PUX+A2hqq/wm0MU/8HtzFcHQm3MUL9DW5jM/V3uA32Z2ML9Q+4jS1lFW5jK/VPuI2t1b/gNkarf8NtjFH/gdvYRh0Ft7Gt+i/cxtS8MxtH0D+ro+E2/qKOgds4Tv0PbuN4dSzcxgnqOLiNE9XxcBsnqRPgNqblY1rvDepEuL3sq95e2keX05Rm6iS4veyrPvZV3wg6ko6im9HN6RZ0S7oV3ZqOpmPoNnRbuh3dnu5Ad6Q70Z3VNLiprqbDTYWaATeVaibcVKlZcFNDzYabmmoO3NQSh8NN2VfphHz0SyfqH8BNJ6m5cFP2VbpMzYObPqlOhpuNVKfAzUapU+HyeyBuNofOpfPoyfQUeio9TZ0GN6upGtysR/XAzear+XAz9lW2WtXhZmtUAreJfBxraqoBt6mrBXCb7KtmkZZomdrUUafDbbrqDLjNiloIt+mpM+E2fXUW3GagFsFthupsuM3OvE+ag9U5cJtD8ro3h9JhdDgdQTdT58Jtbq7Og9vcQp0Pt7klt29Ft6aj6Ri6Dd2Wsq+aR6gL4Db/rC6E2+RY1zyOHk9PoCfSk9RFcJvT1MWoIEOGCprqElTwlLoUFaxQl6GCp7ECFTyTq+1E8/pWBLTJqAhNXY6K0MXhqAihHYmKmKCuQEVMVFeiwrVThWunilimrkJF5ONDRQ6nI9TVqMiR6hpU5Cj8gIqcq65FRc5T16EiT1bXoyJPUTegIk9VN6IiT8M6VGSN9qibUJHz1WJUZF7Hikxog65UN6MiV6lbUJGr1a2oyDX4DhUFqlFd3YaKyutbUUVaomV1OyrKVnegohx1JyrKxa+oqJB2qrtQUVPV3aioI9Q9qKjp6l5U1Ax1HypqprofFTULL6DSxTy7mvQp9QAqXSvUg6h0Pa0eQqWLeXYzz27mGUE9jEqkqUdQifQ8yYh5RhPUo6hEE9USVLgeqERL1WOoRMvyDLkeqMTD1eOoxCPUE6jEI/MkY+YZz1VLUYnn5YnFJ9NT6KmUecY1tQyVuEc9iUo8X/WiEtdVHypxopajwu/1VeKVKkUlXqUyVOLVqolKzDyrUE+hUtXUClSqunoalSrzrDLPakk9g0q1rJ5FpWrn6VUdyjyrzLPaSafSI9RzqFSnq+dRqc5QL6BSnaleRKXKPGtP5UnWVqiXUKk9rV5GpcYMe5hhDzOcvzHvyfmb1Cuo1KFeRaWu5RnW87GlUp8o9kClPkm9hkp9b/U6KvV91Buo1PfNU60z2/pS9SYqCfszGaHeQiUZqd5GJWGevAavJHPUO6gkc9W7qCTMNknUe6gkDfU+Kslq9QEqyRr1ISrJWvURKkm+Xqokn9BP6Ua1EpVkk1qFSoNZNUpqNSqNfN1SaUyhU9UaVBpHqLWoNPJzttI4kZ5Ep6mPUWlMV5+g0piR59PLfHpXqE9R6X1arUOl9xn1GSockysckyvLmc/yTbkp1OeopMyHY28lnUgn0b3pPnli6b7qC1TSJ9SXqKRL1VeoZMwnG6G+RiUbqb5BJWM+2Wz1LSrZHPUdKtncPJmM+WQJbajvUeF3myvZGrpW5c/6WP2ISvaJ+gmV7FP1MyrZRvULKryerTSL6ldUmiX1GypN5tOcon5HpTlV/YFK84g8DY5pleaJaj0qzZPUBlSa+VxZaU6neT4enlYb4eEZtQkensUKeHgul2OaxzHNEzAAT+SZeGIfLYIn9jU0eGI/Q4cn9tcOhscxzRNLDQFP5OegxzHNk0PpMDrckPDkCEPBk7MNA56cY5jwZJ6JJ+fRhDYMC55cYPSDJ0/XKvDkOqM/PPmZMQCe/BzfwZNf0C/pV/Rr+g3daAyEx+s+j9d9Hq/7PKVTQYu0ZLTAU3mGnppitMJTU41B8FSeoadOotPURfDUdKMNnpphFOCpmUY7PI5pnppN59CENugCenpu19PI4HU9Y3TA63rWKMLrek6+BY9jncexzuu+DKfC677cKMGLYJThRcw82sew4UX7Gg68aL8854iZc9zzoqWGCy9aZlTgcdzzeL3jxcOM3OGGDy8egR/g8T6nF88xAnjxXCOExzHQi5l53DA64cUL8rRjZh6vo5/Rz43B8OIvjCHw4i+NofDir4xh8OKvjeHweJ/T4/WOF28yRsCrwhgJr6rl+Vd1YxS8qjA2g1ctGpvDq5aMLeDxesTj9YhXnWpsCa96hLEVvCozr06j0+kMOtPYGl51ljEaXnW2MQZelZlXmXm1YWwDr7rA2BZe9XRjO3i1Z43t4dWeM3aAV3s+z7n2An2RvpTLcdLjOOn1XGrsCK8OYyd4dc3YGR5HSK8+kU6iexu7wKvvI1J4HCE9jpBePb8a9erLjF3hcbXv8e6lx7uXXjLU+BO8ZJixGzyOll4yx9gdXjLX2AMeR0svYRWShrEnvGSBsRe85HSjC17ytdENL/nGGAuvkc/UXkMzIngN3Yjh8S9QvIY0qvAayhgHr2HkXd0wqUX70YG0hbbSQbSddtCiMR5eo5RXpFGmNmWlGlOMCfAaU42J8Bo8OxqzjUnwGnOMveE15qpd4DXmGfvAa5xs7AuvcYqxH7zGqcb+8BqnGQfAa9SMA+E1eoyD4DXmGwfDa9SNyfAaiXEIvEbDOBReYwGPfHp+xjXOMA6D11iY17f3WeNweL3PGVPg9T5vTIXX+4JxBLzeF40/w+tlNTmqexzVvb5LcSm8FMZf4KWa+B4ex3MvnWj8FV46yfg/eFxLe+k+lNVMWc10qXEkvJTjWMpq8t6mlw0x/gYvG2r8HR7vbXrZbOMf8LI5xj/hcWz3OLZ7HNu9rEEX0NONf8HLvjb+DS/7xvgPvCaMo+A1NeO/8Pg3LF6T1WxKqqhhHA2vaRrHwGtaxv/g8V6l1xxoHAuv2WIcB6/ZahwPr8lqNtuNE+A1O4wT4TWLxknwmqxms2xMg9e0jenwmocbM+A1pxgz4TWnGrPgcb7wmrPpHDqXzqMn01PoqfQ0WqM9dD6t04Q2KKvZPJ2eYcyG11xozIGPF4258PGSMQ8+XsYK+HiFvkpfy+Uc5HMO8rXLcCl87fJcAeNk+JyPfKFTQcdpEXx+MuiLCcYp8MVE41T44n7tYPjiAfogfYg+TB+hj9Il9DH6OH2CLjVOgy+HGjX4cphehC+HGz3wZT6u+nIkHUWn0xl0Jp1lzIcvZxt1+HIO1sGXC4wEPuc1n98b9PnXN75cSz+mnxgN+PJTYwF8uc44Hb78jNs/p1/QL40z4MuvjIXw5dfqVvic+3y5kW4yzoSvLM2Fr/oZZ8FX/Y1F8NUA42z4qtU4B74aZJwLX7UZ58FXBeN8+KrduAC+6uCzisaF8FXJuAi+coyL4SvXuAS+quBX+Mqjh9MpxqXw1Rn4Cr5aaFwGv+tFZPC7XjIuh9/1snEF/K5XjCvhd70qHoPfxfpy7vM59/ndlxlXwe9mfSMYV8OPNOMa+JFuXAufa34/GmdcBz8ab1wPP5qQ14X3A/3ofvEz/OgB4wb40YN8/JBxI/zoYfoIfZQuoY/Rx42b4EdPGIvhR0uNm+Fz3vR5n9CPh8u/wI9HGLfAj0cat8KPRxm3wY9Z33iGcTv8eKZxB/x4lnEn/Hh2XtmY9Y0XGHfBj0837obP75z78RrjHvjxWuNe+PHHxn3w40+M++HH+brXj9cZD8CPPzMehB9/bjwEP2Z94y+Nh+HHXxmPwOfniT7nWT/eaDwKP95kLIFfZX2r/Wh/OoC20kG0jRZoO2V9q0Vaog51jcfgVyvG4/CrrC/naL86xXgCfpX1rb2aV7b2mrEUfu31vIK1N3I5k/qcSf16fhXs1zWqG8vg14XxJHz+tZFfj2hs9MKvV40++PVFeR3rZ9Nz6Ln0BnojvYkupnfSu+jd9B5jOfz6vXx8n5HCr99vZPDrD+TVTHwa0JB2UlY8YcV5deMnI+hIowk/GWU8BT85Ka94Ms1YAT+ZbjwNP5lhPAM/yUd1PzndeBZ+cobxHPxkYV61ZK3xPHxe3fjJ58YL8JMvjBfhJ1+qBH7yFbf/Sn+jvxsvwU/+MF6Gn6w3XoGfbDBehd+oGK/Bb7AKvcy89zXjdfi9rxtvwO9l5pzvfM53fgo1HX6qGW/CT3XjLfipEN/DT8cab8NPI+Md+GlsvAs/reYppYuM9+CnZxvvw0/PMT6An55rfAg/ZebpjcZH8NObjJXw08XGKvjpncZq+Oldxhr46d3GWvjpPcbH8NN7jU/gp/fJzeGn9+fppcw8841P4WeBsQ5+Fhqfwc+YeTbU+Bx+Nsz4An423PgSfjbC+Ap+xlGUV0x+dpLxNfxsmvEN/Gx6nnM2gzLz7HR6Rn6WZQuNb+Fna43v4GfMPPucfkG/pMw8Y+bZb8b38LPfjfxV/sh7PltPN+Q2K8aP8Jt55gFeN35CgDeMnxHgTeMXBHjLyLe/bfyGAO8YvyPAu8YfCPCesR4B3jc2IMAHxkYE+BArEOCjXM5iAWexQLsEpyLQLjU2IeCMFnBGC7RH6ZJc3kEKhGYCAWe3gLNbIIZrEQIxgu5J96JdpoZAdJs6AjHWFAhEpB2MQJxJz6KLTIlAnK1NRCDOoeeaCoE4zzQQiPNNE4G4gHteSK+h15oWAnGd2Q+BuN7sj0DcYA5AIG4UXQjETZqHQCw2ByIQN3OfW8wWBOJWsxWBuM0chEDcLu5CIO4w2xCIO42PEYi78mdJnQoqzQICqcx2BNIwOxBI0ywikJaxDoHsZ5YQyP5mGYEcYNoI5EDu02I6CPjfDwL+94NAttGC6SKQ7WYFgeww8y1F00cgS/xpmdrUoa4ZIJAVM0QgPbMTgQzNwQhkpzkEgRxsDkUgh5jDEMih5nAEMl/1BfIEeqI5AoE8yRyJQE5D/m4X0NPpGeYoBHKhuRkCeY2Wv59r6Uq6iq6ma+hac3ME/AQzkL+aWyCQv5lbIlBlzUWgbOpQ19wKgaqYWyPgLBx0vY4MQdcb9E36Fn2bvkPfpe/R9+kH5mgEXR+aYxB0sVc5IweckYPuy8xtEHBGDrrZn91LzG0RROzPSDO3QxDp5vYIODsH0XBzBwQR+zNif0Z7mTsiiLrMnRBE3XlHRWMp+zNif0ZnmTsjiBblPRmxPyP2Z3QuPY+eb+6CILrA3BVBxP6M2J/RteafEETXmbshiK7PqxndQG+k7M9oMb2Z3kJvpbfR2+kd9E7K/ozZn7Ewd0cQS3MPBLHSighig5rUov1ofzqADqQt5p4I4lZzLwTxILMLQdxmdiOIC+ZYBHE79+mgRTNCEJfMGEFcNqsIYtschyB2zPEIYtecgCCucE+PhrSTDqZDzIkI4qHmJAQx+zNmf8Yn0pPMvRHE7M+Y/Rmfbu6DID7D3BdBnM9ZQXyNuR+C+FpzfwT8i7kgXmUegCBebR6IIF5jHoQgXpt3Jj/lDPiNpiD+zTwYQbVsTkZQtc1DEFQd81AEVdc8DEE1XyUGXEUEtavMwxHUrjanIKhdY05FULvWPAJB7Trzzwhq15t/QVC7wfwrgtqN5v8hqH1oHomg9pH5NwRcVwRcVwQ9HDN72JM9z5t/R9DzgvkPBD0vmv9EwFVHUNfMfyGo6/JRBHV2Zn24+W8EdXZm/U90N7o73YOyY+t75X1VP4MuNP+DoH6meRSC+lnmfxHU2aV1dmmdnVk/n15gHo2gzs6sX0GvpFfRqyk7tn6teQyC+nXm/xDUrzePRVBnx9bZsXV2bJ0dW2fH1tmxiW4ehyAR5vEIkqHmCQiSYeaJCJKTzJMQJNPMaQiS6eZ0BMkMcwYCrkyC5HRzJoLkiryayZXmLATJVeZsBMnV5hwEyTXc51pzLoLkOnMeguR682QEyQ3mKQiSG/NOSG7icxdTdkWyyjwVQfKreRqC5DezhqBRNnsQ8C5B0HDM+QgarllH0GD1uXoJeq/CUwh6r6bX0GvNBEHvdWYDQe/13HIDvdFcgKD3w3x06v3IPB0BVzgBVzhB3+XmGQj6njcXIuh7wTwTQd+LOAZB30u5KaufauZZCFLdXIQgZfX5d9xBuluef7o7ZZVTVjldaJ6NID3TPAdBepZ5LoJ0kXkegpRVTlnl9HzzfAQp58eUVU5Z5fRK8wIE6VXmhQjSq82LEKTXmBcjSK81L0GQXsfnXm9eiiC9wbwMQXqjeTmC9Ca62LwCQXqzeSWClFXOdPMqBJkwr0aQDTWvQcA7DEF2Ep1Gp9MZ5rUIsgXmdQj4HzOC7ArzegTZleYNCLKr8spmV1NWObuWXkevpzfQG80bEWQ3mTchyBabixFkK82bEWSr8rM7+9W8BQG/kxw0OQc1bfNWBE3OQU3XvA1Bs2LejoDrpRA3mXcgxGLzToS4GSlC3ELvpvfQe+l95l0Icb95N0I8wC0vm/cgxCvmvQjxKv6DEK/lajvhLoTazuZ9CLXJaCLUrjDvR6hdiUsRctUUakvMBxBqz/Dx8+aDCLUXzIcQanlvhFreG6G+UZuMUN9kPoxQwHwEodDkCIT8RnHIlVIozjIfRSgWmUsQcqUUivPo+eZjCLk6Crk6CsVF9GJ6Cb3UfByhuMw8CqG43HwCobjCXIpQXGkuQyiu4nGuNp9EKK4xexGKa7nlJrqY3mz2IRR5J4QSVKO6uRyhFFoRoRxqpgjlMDNDKIfTEXSk2UQoR5lPIZSbmSsQys3pFubTCOWW5jMI5Vbmswjl1vgBoRxNx9CT6DTzOYRyuvk8QjnDfAEh78OHsmHug1AuMF9EyPsVoTyPnk8voZfSy8yXEMrLzZcRypvMVxDKxdx+M72F3kpvox/Sj8xXEfK+fch7F6H8kf5EfzaOQih/MV9DKH81X0co824MVcV8A6HyzDcRKt98C6EK8g7suglPIexabL6NsOtm8x2EXbeY7yLsutt8D2HXPeb7CLvuNT9A2HUfagi77jc/RNj1gPkRwq6XzZUIu14xVyHsetVcjbCLHdjNDuxmB3ZPNtcg7L4i77RudiDXRWH3EvqMuRZh9/Pmxwi7XzA/Qdj9ovkpwm524NiN5jqEYzeZnyGMoB2JkPf2w2hb7W8IuRYKo7PoIvNzhFwLhVz/hNH59ALzC4TRheaXCKOLzK8QRuzAiB0YXWp+jTC6zPwGYXQ597+CXknZgdHV9BrKDozYgdFi81uE0c3mdwi5RgpjmN8jjDVjHcJYN39AGLMD46F0GB1OR9CRdBTdjG5Ot6Bb0q3MHxHGW5s/IYxHmz8jjNmBMTswnmb+gjDOr/XCeAZlB8YN81eE8QLzN4T8VCKMzzNnIeR/OAzjS8zfEcaXmn8gjC8z1yOML+ezbjI3IIwXmxsRxjebmxDGt1hAGN9qaQjj2ywdYfyhJRDGH+WdFn9tSYTxN5ZCGLMD458sA2H8s7EEYfxL3nXxr5aJMGYHVivUo75lIayyA2s35x1Yu8Xqh7B2q9UfYe02awDC2u35KFe7g95J77IGIqzdbbUgrN2Td2PtfvqA1Yqw9qA1CGHtIe75MH2Evmq1IayxM2uv0zdye9ilPezSHo6TPYfQS60Cwp7LrHaEXDWFPY9ZHQh7nsaNCHuesYoIe57n9hesEsKeF60ywh527Pz1+Zg5fwPl+Dl/k2UjrO+Zd2x9L8tBWO+yXIT1fG0f1hdZFYRcHYX1c+i59CJ6seUhrF9i+Qjrl1oBwvpNVoiwvtjqRMjVTsjVTljfaA1GWN9kDUGYwBqKMNHy/klGmxMRJmOsYQiTbazhCJNtrREIk+2skQiT7fMuSo6i/6VH02OsUQiT/1mbIUyOtTZHyPs5YTLN2gJhMt3aEmEyw9oKIT9/CZOGtTXCZEHeXQn7LTmDLqRnWqMRJmcZzyHkf9QMk7OtMQiTc/KeTM61tkGYnGdtizA539oOYXKBtT1C/ne7MLmIXmztgDC5xJyDMLmUr3gzvcXaEWFyu7UTwuQOa2eEyYfWLggT9meykq6iq+kaupZ+nHdv8om1K8LkU+tPCJN11m4Ik8/408/pF/RL+pW1O8Lka2sPhPyrtDD51toTYfKdtRfC5HurC2Hyg9WNMPnRGouQf5UW8j80hg3PihA2fCtG2GDP97Lne2+ht9LbrCrC3tutcQh777DGI+y905qAsPcuayLC3rvzPu9lz/ey53sfoA/Sh6xJCHsftvZG2Mue732Vvmbtg7D39Xxk7mXP97Hn+9jzfZOtfRH2sef7LrH2Q9h3qbU/wr78eiHsY+f3PWYdgLDvcetAhH1PWwch7HvGOhhh3/PWZIR9L1iHIOx70ToUIdeT4fL11mEIl2+wDke4fKM1BeHyTdZUhOme1hEI072sPyNMu/LOT9n/6SJ6tvUXhOk51l8Rpuz/lP2fXkwvsf4PYXopH3PsTRdbRyJMb7b+hjC9xfo7wnSj9Q+E6aa85zNQ9n82mo6h29Bt6XbWPxFm21v/Qpix/7P/Wv9GmB1t/Qdhdox1FMLsf9Z/EWbHWkcj5Hoy5HoyzKZbxyDMZlj/Q8hPrEJ+YhVmC6xjEWanW8ch5H9jC7OF1vEIszOtExBmZ1knIswWWSchzM7m/udY0xBm5/LxefR8azrC7AJrBsLsQmsmwuwiaxbC7GJrNsLsEu7D/s/Y/9kt9HZrDsLsjvz8yj605iLMPrLmIcxWWicj5Lo0zNj/2RrrFITZWutUhNnH1mkIs0/y/sw+peusGsLsM6sHYfa5NR9h9oVVR5h9aSUIs6+4z9eU/Z99S7+j39Mf6I807/9O3Go10InbrAXoxO3W6ejEHaihEw9bZ6ATj1gL0YlHrTPRiSVI0YlX6WvWWejE69YidCLv2068Sd/K5fq2k+vbTu0inIpO7WLrbHRql1jnoFO7FJeiU3vUOhed2hLrPHRqj1nno1N73LoAndpT1oXo1FZYF6FTe9q6GJ1cA3dqz1uXoFN7wcofv2hdhk7tJetydGovW1egU3vFuhKd+nrtSHTqG6yr0KlvtK5Gp74p3yJ21/6GTrEH3dO6Bp0iv1bq5Aq5k/cSO8Ui61p0irOt69ApzrGuR6fI+7xT3EBvpDfRxfRm6wZ0ilusG9EpPtc8dIov6Jf0K/o7/YOupxusm9ApNlqL0Snyc6FTjqZj6DbWzeiU21q3oFNuZ92KTpmP/53yKPpf6zZ0yqOt29Epj8E6dMrjrDvQKY+37kSnPMG6C53yRO55Ep1m3Y1OeR73PN+6B53yAutedMoLueV2eod1HzrlnVoFnfIuutK6H51ylfUAOuVq60F0yjXWQ+jsujXviq7b6O2UfdL1MH2EPmo9jM6uJdYj6Oxin/w/ju47TmoycQP4M5sok752RXRS3ygqXQHrzib23rH3fve75nl3euftbmIDG01AqVLEs9EEBcvOJnQVsNJ7X7BjB3+fPP98P/lkxwV8nyfz5k0m0/AJ/bT8FioNn5XfRqXh8/JsVBq+6LARFc5CK5yFVqpDy3NQqT5XfgeVKhPCWWiFs9BKdTadQ+fR+XQBZUKqH9AP6Ud0SfldVKpLy++hUl1Wfh+VRiakcS/dR5mQiAmJTiu3ohKdXuQhYkKi/uUaKtGAchsq0ZPlDBXOYCtczatwNa8SMSHR+HKOSjShPBeViAmJJpXnocKZZyViQqL28nxUol3lBahETEjEhES/lheiEv1WXoRKtLdIQrSPMiExExJ3LS9GJe5W/gCVuHsxvnEPyoTEd5c/RCW+p/wRKvG9xcjGTEj8f/RP9M/0L+UlqMR/LS9FJf5beRkqMRMSD6SDKBMSMyHxK+WPUYlf7TARlZgJ4UpaJd5Q/gSVeGP5U1TiTcWRpGlKkYGmqXR2+TNUmuYUo9/0Of2i/DkqTcvLX6DStKK8HJWmleUVqDStKpLQtLo4bjStoZvpFrqVbqPb6Q66k7bTXXQ3/ZJ+VcjZY4Wzx0rztZiLSvN15ZWoNA8rr0KleXh5NSrNI8prUGluLdLSXCuvRaWZ6WqeX16HSjNT1LykvB6V5qXlDag0L8N9qCQ9irQkPWkveiJlipLTyhtRSZgirrxVuPJWSZ4ob0Il6V/ejEoyoEgIV94qnFtWktHlLagkY8pbUUnGlrehkowrb0clebG8A5VkfHknKsmEcjsqycTyLlSSSeXdqCQvlb9EJZlcpCh5mW6nO+jO8leoJO3lr1FJdpW/QSXZXf4WleRL/vQr+jX9hn5Lv6Pf0z30B/oj/Yn+XCQ2+aX8HSrJr+XvUUl+K+9BJdlb/gGVlIlNu5Z/RCXtVuQzZWJTJpZz2kp6I72J3kxvobfTO+id5Z9QSe8q/4xKOqFIYzqx/Asq6aTyr6ikL5V/QyWdXN6LSvpyeR8qKRObvkJfLf+OSsrEpqvpGrqWrqPrFaDCT1BWWqcoJVRamd7W2XSOUodKK9Pb+gVdTlfQlXQVXa1IqLQyva2bFRmV1i3Kfqi0bqXblP1Rad2udECldYdSRqV1J/e30110t6Kg0vqloqLS+pWiocJ5YIXzwEqN6a1dR4cV76e1EYqOSo25rdUoc1vjUbH2kWKgwvlepbaUMrcZc5v1VExUsl6KhUp2olKPSsbcZqcpB6CSna4ciArXEitcS6xkTygHoZL1Vw5GJRugHIJK9iR9SjkUlWy0chgq2RjlcFSysaVrUcnG0RfpeDqBTqST6EvKEahkk5WOqGTMbcbcZjuUwp1KJ1SydvyOSrZLOQqVbLdyNCrZl0oFlewrxUYl+1pxUMm+UVxUsm8VD5XsO8VHJfteCVDJ9igClewH+iP9if6shKhkv/A3/0p/U45BJdurHIsK56IVzkUreTelMyp5d+U4VPIeyvGo5D2VE1DJb1S6oJLfpHRFJb9Z6YZKztzmtyvdUcnvUHqgkt9ZJDC/izK3+USlJyr5JKUXKvlLWIFKPpm+rJyISv4/5SRU8leU3qjkryp9UMmZ25y5zdcofVHJ1yono5KvU05BJV+vnIoKP0lq43XlNNh4QzkdNqYoZ8DGVKUBNqYpVdiYrjTCxgwlgo030QQbs+kcJYaNd5QzYeNdZLCxWjkLNtbgZthYS9cVll5QzoZdGolhsEuj6GzlHNilOdz+iC5RzoVdWqqcB7tUJM2WwtItsKVjaHflfNhSD+UC2FLPUj/Y0knKhbCl3spFsKU+ysWwpb585cn0FHoqPY2erlwCWzpDuRQ252w252y29CR9ij6tXAabczZbGk3HKMX+ycoVsKUiY7a0ne5QroQt7cTvsKV2+hvdq1wFW9qnXA2bczNb7kF7Kv1gy706zIctn6hcA1u+Ct/Blq9WroUt91Ougy1fo1wPW75WuQG2fJ1yI2z5euUm2PINys2w5RvLd8OWb8J22PLNyi2w5VvKx8KWb+XvuU25FbZ8u3IbbPkOvmaCcjtseaJyB2z5VeVO2Jyb2fJa5S7Y8jql+G3ri9Hnc9fthtfxJuyGN+gUOpVOo9PpDMoMNDADDXPoO8o9sBveVe6F3bC6SELDGuU+2A1rlT/Ablin/BF2daTyf7CrHH3OxGzOxGzOsuzqErpU+RPs6jLlz7AjUYxyFNJj6LG0O+1BmYToJNqb9lH+Ajvqq/wVdnSy8jfY0SnK/bCjU5W/w45OUx6AHZ2u/AM252Y2VxftaIDyT9jRk8q/YEdPKQ/Cjp5WHoLNuZkdjVb+DTsao/wHdjRZeRg2r5DaEZMQ7VD+CzvaqTTBjpiEiEmI9tJ9SjPs6HelBXbMJMQ9aS8lgR2fqKSw46uUR2DHVyuPwo77FWMXX0OvpdfR6+kN9EbKJMQ301uUx2DHtxaZiW9THocd3879TEI8QXkCdjxR6Q87flUZADt+TXkSdrxWeQp2vE55Gna8XnkGNp+QbzdxlJs4yk0z6Sz6Fn2bcvSb5ijPwm56RxkIu+ldZRDspveUwbCb3i8y0LSWrlOGwG5aXxwHmjYUNo9UhsJuZhKalyrPwW5epgyDnZyuDIednKGMgJ30V56HnQxQXoDNFTObK2Y2ry3avLZoJ4PoYDpEGQk7Gcrt5+gwOlIZBTsZpYyGnYxWxsBOxpSuhZ1MVsbCTl5WxsHmbMdOdigvwk52KuNhJ+3KBNjJLmUi7GR3MY7JPvq7Mgl2CuUl2Fx5s9M6KlGZ7kf3px1omSpUpRrVqUGZjbSnMhl2ekXR6PTK/W+DnV6lvAw7vboYx/RW5X+w09uUV2BzJmOnE5VXYaeTlNdgpy8pr8NOX8UK2Olryhuw09eVKbDTN4rWp6ulGbDTNcpU2OlaZRpsfqOE3cqxbuVYt3KsW2cp02G3vsX9byszYLfOVortOcpM2K3vcP+79D1lFuzW95W3YLdyrFvXKW/Dbl2vzIbdyrGujVLmwK4tVd6BzfmDnZ2uvAs7O0N5D3bWX3kfdjagQyvs7Gml8BmlBjt7VmmDnQ1UMtjZICWHnQ1W5sLOhijzYGdDlfmws+eUBbC5XmRnI5WFsLNRyiLY2ehifDOOcjZZWQw7e1n5ADbnBna2g+5UPoSdtSsfwc52KUtgZ7uVpbAzjnL2e2EOZRnsvIR9sPM65WPYuaR8AjuXlU9h5/spn8HO91c+h513oGWqKF/AzlVlOexcU1bAznVlJeyco5z3UFbBzou5q51zlPMrldWw86uK0cw5yvmt9DbKUc4n0knKGth837dzjnL+Gn1dWQs75yjnq5V1sPM1ynrY+dpifPNilB28hzfh4H1lAxy0KhvhoIYMDtpoRtcrm+CgGDWndDfmwindQ++l9ymb4ZRGKVvg8P3aKS1RtsIpLVW2weH7tSOdrmyHI52h7IAjNZQuhyNVaX9lJxxpgNIOR3pS2QVHekrZDUd6WvkSjvQMX/Oc8hUcaZjyNRxpuPINHGlE6Vw4vD7oSKOUb+FIo5Xv4EjFyDrSi3Q8nUAn0kn0JeV7ONJkZQ8cvms7XF1xuLricHXF4eqKI32p/ABH+gq/w5H20WL0HRnKj3DkkvITHFnHPjiyofwMRzaVX+DIlvIrHLm+dDAc+QB6ID2IHkwPoR3pkbQTPYoeQ4+lnelx9Hh6Au1Cu9JutLvyGxy5h7IXDld1HPkKeqVS/A2vUoq/eZEiRx5JR9HRKuDIY9QSHHmsWgdHHqdKcOQXVRmOPF7dD448Qd0fjjxR7QBHnqSW4chF0hz5dVWBI7+hqnDkYibpyGvoWlWDI69TdTgNTFfD+7RVNeA01FQTTkObasFpYLoa1qv1cBo2qAfAqTJd1XvUA+FU71UPglO9Tz0YTnWUeggczgec6hL1UDjVpephcKpMV3R6qR+c6Az1cDhRg3oEHN435fAd3OH1QSd6kj5Fn6ZMF68DOtEwOlztCCcaoR4JJxqpdoITjVKPghMVxw0nYroipisarx4NJ5qgVuBETFfEdEUvqTacaLLqwOFMwOHKjBO1qy6caJfqwYl2qz6c6MsiSxHTFTFd0e9qACeGKuDEJTWEEzNdsUFNaqnHwInr1WPhxEwX74ly4oPUznDig9Xj4MRMF+99cuIj1ePhxJ3UE+DETFd8jNoFTnys2hVO3FntBic+Tu0OJz5e7QEnPkHtCSfuovaCE3dVT4QTd1NPghN3x144cQ+1NxyuCDkx0xVfSa+iV6t94MRMVzxK7QsnHq2eDCceU6QlHkvH0RfpeDqBTqSTKNMVv07fUE+Bw6dIOfEa9VQ48Vr1NDjxOrkEp6mtyFVTpp4OpylXz4DTNFdtgNM0r8hV03y6nm5Qq3CaNhZHsKZNhVy9cZp707vVRjjN96gRnOaReBhO8yg1hsP1Gad5iXomnOal6llwuD7jNH9MPynkZ0KdpI5K1C/SmATq2XASoZ4DJwnVc+Ekx6jnwUmOVc+Hk3RWL4CTHKdeCCc5Xr0ITnKCejGcpIt6CZykq3opnKSbehmcpLt6OZykh3oFnKSneiWcpJd6FZzkRP4pJ9HelC1IzqAN6tVweIXRSRppRGN6Jn1eLV75gnoNnGSkei2cZJR6HZyEaedcyEnG0nH0RfV6OMl49QY4yQT1RjjJRPUmOAmTn7xEJ6vF/4GX1VvgJEx+0q7eCodzJCf5Xb0NTgr1djgpj59pvfIxnPQA9Q446YHqnXDSg4qcpwfTQ9S74KSHqnfDSQ9T74GTHq7eCyc9Qr0PTtpR/QOc9Ej1j3DSTur/wUmZ8PRoWqE2dahLPerTgAr1T3DSUP0znLSH+hc4aU/1r3DSXurf4KQnFtlOL6OXUyY/ZfLTq9T74aRXq3+Hk45WH4CTjlH/ASedov4TTjpV/RecdJr6IJx0epHhdAZ9k66kq+hq9SE46Rr133DStep/4HAO5rQy4a0ZzelcOk99GE7rfPW/cFqZ8NYNxVG0daPaBKeVCecKj1PrrTbDqd1dHEVr96AGpzZSbYFTG6UmcGpMeG2JmsKpLVUfgVNbpj4Kp/ax+hicGhOeoXQGnKxE69TH4fAOMSfz1SfgZIHaH04mSnfDyUJ6DD2WdqbH0ePpCbQL7Uq70e60B+1Je6kD4GQnqk/CyU5Sn4KT9VafhpMx59kZ6jNwsgb1WThZVR0IJ2tUB8HJInUwnCxWh8DhHfsO7zpzshfUoXCykepzcLJR6jA42Wh1OBzOBp1srDoCTjZOfR5O9qL6ApxsvDoSTjZBHQUnm6iOhpNNUsfAyV5Sx8LJJqvj4HBlycmY86xdfRFOtk8dDyf7XZ0AJ4c6EU5eUifByeuLPOcH0AMpc54z5/kh9FB6GD2cHkE70iNpJ/UlOPlR6mQ4OXOeV4ojf26rL8PJHfV/cHJXfQVO7qmvwsl99TU4eaC+DicXxTE8D2kP2pP2Ut+AkzPnOXOeX77/bXDyK9QpcPIr1alw8qvUaXA4C3Xy0ep0OPkYdQacfIr6Jpx8qjoTTj6tSHI+XZ0FJ5+hvgUnZ85z5jxfpb4NJ1+tzoaTrymyzVmow1moi3l4Ey7mq3PgYqP6DlwUGXaxmW4p5FU/t9RbfRduqY/6HtxSX8yFW3pBfR9uaanaCre0TK3BlerUNri8/9+V+qgZXKmvmsOVTi71gyudos6FK52qzoMrnabOhyudri6AK51RuhSu1KguhCtF6iK4Usw9Z6qL4UpnqR/Alc5WP4QrnaN+BFc6V10CVzpPXQpXOr90OVxpJB2lLoMrjVY/hiuNUT+BK41VP4UrjeNPJ6ifwZUmqp/DlSapX8CVXlKXw5Umqyvgckbqch3JlXaoK+FKO9VVcKV2dTVc6Td1DVxpr7oWrtxJXQdXPkpdD1c+Wt0AV66oG+HKrroJruxhL1y5B+1Je6mb4conqlvgysUou/Ll6la48mhshyuPUbfBlaeoxfZUdQdceZq6E648HSvgyjPom2o7XHlmqSNceRZdSVepu+DKq4txlNfQtZQjy3Ukl+tILp885sqbChs44g3z1d1wGzaqX8Jt2KR+Bbdhs9oEt4Ejzut3brU37aN+DbfKEa8+r34Dl/eSudWl6rdwq8vU7+BGdaUz4EaS+j3cqE/pbrhRX3UP3Ohk9Qe40Snqj3CjU4sxjU6jp1OOe9RII8pxj85Uf4IbncXts+k59Fx6nvoz3Oh89Re4Ecc9GkVH0zF0LOW4RxPoRDqJvqT+CjearP4Gl3NFl6tGbrSD7lT3wuWqkRv9pu6DG+1Vf4cbd1I+hhsfpQFufLRWghtXsA9u7Gp1cGOOe9xDk+DGPTUZbtxL2w9uXLTbjTnu8eXa/nBjjns8RusAN55SjG88lU6jHPeY4x6/qZXhxjM1BW48S1Ph8gmfbrxK0+DGqzUdbrxGM+DGazUTbrxOs+DG67V6uPEG7QC48UbtQLi8iuc2tRbj3lTTDoLL+ZvblFHmoWm+djDcpo3I4DZt0g6B27RZOxRu0xbtMLhNW7XD4TZtK7LB+ZvL+Zvb3Ec7Am4zs9Gc4mG4vOPdbR6hdYTb/Lx2JNxmpqW5jWY01zrBbZ6rHQWXV+Xc5vl0qXY03OZlWgVu88eaDZczPbdlWqkBbst0OoO+WboAbss3Reo493MTT3PgJn6RvSTQXLiJ4HZIj6HH0s70OMqsJn01D25ysubDTU7RArjJeUXSkvM1AZef43P5OT43maSFcJOXtGPgJuuLtCQb6EbtWLjJJq0z3GSzdhzcZIt2PNxkq3YC3GSb1gUuV7TcZIfWFW6yU+sGN2nXusNNftN6wE32FllKXa0n3NTTesFNuxWJSrtrJ8JNe2gnweX1NTe9TOsNN71c6wOXd8W76RitL9x0rHYy3HScdgrctJjnu+l4OpPO0k6Fy2tkLq+RubxD3uW3lbnpRu00uOkm7XS4rcxJa007A25rm9YAl7Mgt5U5aZ2vVeG2Mietm+hmuoVu1Rrhtm7TIricBbm13loMt9ZHOxNujTmppajBrT1CmRbe7+TWnqdMS61NOwtujWmp5drZcGtztXPg1uZp58LltTC3tpQu086DW/u4fChczprctmna+XDbpmsXwG2boV0It41paftGuwhuxiNV5pbOhpt51KeBdjHcTGiXwM1C7VK42THaZXCzY7XL4WadtSvgcjblZn20K+FmfbWr4GYna1fDzU4p0pIxM9n5Wj+42QTtGrjZRO1auNkk7Tq4vGfezdZr18PNNmg3wM02ajfCzTZhMdxsM91Ct9Jt2k1ws+3azXCzHdotcLOd3N+u3Qo3+604OmV7tdvg5m6RnNzTboebMzN5d+0OuHkP7U64XNdy88u0u+Dmlxdp4T32Lu+xd3mPvZuPU6fDzV/U7oabj9fugZszM/ks7V64fNKpm6/T7oObr9f+ADffoP0Rbr5R+z+4/HY2D8XxxENGczpX+xM8zNP+DA/F+aCH7dpf4GGH9ld42Imb4aG9sHSS9jd4pd6YC6/0LB6GVxpIB2n3wysN1v4Or9SmPQCvlGn/gFfKtX/CK83V/gWvNA/3wSvN1x6EV1qgPQSvtFD7N7zSIu0/8EqLteL3fKD9F17pQ60JXukjrRleaQmGwSst1VrglZZpCby610sN8OreoFPoVC2FVzdNewRe3fTSBfDqvtUehVf3nfYYvLrvtcfh1e3RnoBX94PWH17dj9oAeHU/lc6AV/cz/Y3uLZQ6a0/Ck47TnoInnaQ9DU/qrT0DT+qjPQtP6lu6FJ50Hj1fGwhPuqB0OTzpQvo4fUIbBI/XyDxeI/N4jczjNTJPepo+Q5/VBsOTBnJ7EB2sDYEnDdGGwpOGas/B46cAPGkYHU5H0OfpC3QkHUVH0zF0Ap2oDYMnTdKGw+OnADzpU/oZ/Zx+QZfTFXQlXaWNgCet1p6HJ63RXoAnrdVGwpPWaaPgSeu10fCkDVgMT9pJ27Ux8KRd2lh40m78Do/X9TxprzYOnrRPexGe9Dv2wZNd6mnj4cm+NgGeHGAvPLmHNhEeV+c8+RJ6Kb1MmwRPLnrhyWPpOPoiHa+9BE+eqU2GJ8/SXoYnv1XqCE9+m66ma+ha7X/w5HXaK/C4tuY15HSu9iq8hnncZgsattMd2mvwGnZqr8NraNfegFc9qch/lS2oPlvkszpQmwKvOojbg2mbNhVeNdOmwavmRfKrcylbUJ1PF9CF2nR41UXcXkw/oB/Sj+gSbQa86lLtTXhczfMa2YLGN7SZ8BqnaLPgNU7V3oLXOK3IfyNb0PhtqQ+8xu/o93QP/YH+SH+iv2lvw2tk/qPO2mx4UXH89KKTtDnwot5F2qM+lPmPmP/ofHUhvOgC7R14EfMfMf/RE9q78KL+2nvwogHa+/CiJ7VWeNFTWg1e9LTWBi96RsvgRc+WboMXDdRyeNEgbS68aLA2D140hPuHavPhRc9pC+BFw7TizxquLYIXMf8R8x+9oC2GF43UPoAXjdI+hBeN1j6CF43RlsDjfNKLJmpL4UWTtGXweCeYF32qfQwv+kz7BF70ufYpvOgL7TN40XLtc3jRCu0LeNFKbTm8aFWR6mg1XUPX0nV0PWX+I+Y/aqe7tBXwot3aSni8munxaqYX7dNWwYt+11bDi5n/2NPWwIt9bS28mPmPe2jr4HH90Isv0dbDiy/VNsCLL9M2wouZ/3istglePE7bDC9+UdsCLx6vbYUXF+8FXjxL2wYvfksrXsn8x6u1HfDiNdpOePwGFo9PmPeadhYJb2rX2uE17dJ2wWvare2G1/RlcbRv+qqw+STtS3jNTHtzH9qXMu3NTHtzq/YVvOaa9jW8Fiaz5Q3tG3gtU7Rv4bVMLdLYwmS2TNe+g9cyQ/seHmeGXstMOqswOVrbAy+paD/AS2ztR3iJo/0EL3G1n+ElHvW1X+AlgfYrvETQkB5Dj+VrOmu/wUuO0/bCS47X9sFLTijynPSgPWkv7Xd4yYk64CUn6SV4SW+9Dl5ygS7BSy7UZXjJRUV+kovpo/QxypwnT+j7wUv66/vDSwYUiU2G6x3gJSP0MrzkeV2Bl7ygq/C4WuglY3QNXjJW1+El43QDXvKibsJLxusWvGSCXg8vmagfAC/Ji5Qmc+k8Op8uoAvpIrqYfkA/pB/pB8JLlugHwUuW6gfDS5bph8BLPtYPhZd8oh8GL/lUPxxe8lmp+Psv14+Al6wocpvs0jvC4xVbL/mSfkW/1ovf/I3eCV7yLfd8R7+ne+gP9EfKzCfMfLJPPwpewmN+Cv1oeGlJr8BL63QbXirpDry0nj89gB6ou/DSg3QPXnqw7sNLD+H+Q+lhtBM9ih5NK9SmDnX1AF7q6QJe6ushvDTQj4GXiqJlaUiPocfSbrS7fiy8tIfeGV7aUz8OXtpLPx4eVzi99CL9BHjpxXoXeOklRRPTS+nz9AU6ko7Su8JLR+vd4KVj9O7w0rFFN9Nxyonw0pl6D3jpLL0nvPQtvRe8lD1Nl9MVdKU0A166Si9ev1o/CV66puhsKzvb2k530d16b3itX+p94LV+pfeFx/m8V+utnwyv1kc/BR7n815tkH4qvBo7W2vVT4NXq+mnw2tjZ9s4p2qbop8Br22qXmxP06vw2qbrjfDaZugRvLY39Rhe20z9THhts/Sz4GVHl+6Fl1WoTR3qUo/6NKCChvQYeiztTI+jx9MT9LPhZT30c+BlPfVz4WW99PPgZScW7z7ZSfr58LLe+gXwsgu450L9QnjZRfpF8LKL9YvhZexs9ph+Cbzscb14zRP6ZfCy/kVbM3Y2G64XrxlRNDR7nr5A2VmufHq8p87LxulXwMteLFqWjdevhJdN0K+Cl03Ur4aX5Xo/eNlc/Rp42Ty9eP18/Tp42QL9enjZQv0GeNki/UZ42WL9JnjZB/rN8LIP9VvgZR/pt8LLlhR9zJbSZfRj+gn9lLKz2XK9+Juv0G+Hl+3S74CX7dbvhJd9qd8FL/uq6HL2tX43vOwb/R542bd68f/zO/0+eNn3+h/gZexs9oP+R3gZO8vzFC/bq/8fvGyf/id42e/6n+HlKH5bXtL/Ai+v0/8KL5f0v8HL6/X74eUH6H+Hlx+oPwAvPwifwcsP1v8BLz9E/ye8/FD9X/C4WuvlnfQH4eVH6Q/By4/W/w0vr+j/gZfb+sPwckf/L7zc1Zvg5R5/j08DvRleLvQWeHmoJ/DyY/QUXs7O8hzKy7vTHrSn/gi8vJf+KDyu1nr5RfRi/TF4+SX64/BydjZnZ/MX9Cfg5SP1/vDyUUVD89F0DGVn83F0pj4AXj6r6GbOuWXOzubsbL5CfxJevlJ/Cl6+quhpvpoWnfXxJTL4+Ep/Gj6+1p+Bj29wO/zS4XgNfukI2qfDVPhcrfVLf9CfhV/6oz4QfmmwPgh+aYg+GH5pKIbBL7XSmj4EfqkN98EvZXQnbae76G76ZaFU1ofCl5TSvfClLvpz8KWu+jD4Ujd9OHypuz4CvtRDfx6+1FN/Ab50UelS+NLF+kj40iX6KPjSpfpo+NJlpcvhS5fTR+lj+hj40uP6WPjSE/o4+FLRL18aQJ+lA/UX4UuD9PHwpcH6BPjSEH0ifGmoPgm+9Jz+EnxpmD4ZvjRcfxm+VPTRl8bScfr/4POZML40UX8FvtTG7Ux/Fb6U66/Bl+aW9oMvzaPz9dfhSwv0W+FLC/U34EuL9CnwpcX86XJ9KnypeL/zpa/pN/o0+NK3+nT40nfc870+A760R38TvvSDPhO+VHTEl36iP9Nf6K/6LPjSb/pb8KW9+tvw5Tp9NnxZ0ufAl2X9HfjyftgHX96fdqBlqlCValSnBjX1d+HLlv4efPlQ/X348mF6K3z5cP70CNpRr8GXj9Tb4Mud8Bl8+Sg9gy8fo+fw5WP1Y+DLnbEXvnwc7UF70l76XPhy0RFfvkSfB1++VJ8PX74M2+HzPMuXr6BX0ufoMDpcXwBfHqEvhC8/ry+CL7+gL4Yvz9Q/gC/P0j+EL88udYQvz6Hv0Hfpe/R9upyu0D+CL6/Ul8CXV+lL4cur9WXw5TX6x/D5DeY+v/HWl7fSbXQ73UF30vbCBnat4Sv6tf4J/AZ2rXq4/in86hH6Z/CrfYrGcZ3cr/6B/lH/HH51sP4F/OqQol9Vdq3KrlVr+nL41TZ9Bfxqpq+EX2XXqu36KvjVXfpq+NXd+hr41S/1tfCrX2EW/Khc6gM/UvR18KMu+nr4UdeiU1E32p32oD0pGxddTC+hl+ob4EeX6RvhR2xcxMZFj9HH6RP6JvhRf30z/IiN41mVHw3Ut8CPBulb4UeD9W3woyFFm6Kh9Dk6jA6nbFw0Vt8On0+88fmUG59PufH5/Rd+lOk74Ee5vhN+NFdvhx/N03fBj+YXzYoW0IV0EWXjouX6bvgRGxexcdE39FvKxkXf61/Cj/boX8GPftC/hh/9qH8DP/pJ/xZ+9LP+HfzoF/17+NGvfP1vdG9hXEclKut74Mf76T/Aj/cv2hd30H+EH5f1n+DHiv4z/FjVf4Efa/qv8GNd/w1+bOh74cdm0aDYoofSw/R98OPD9d/hx0cYgB93NErw4yP5UzYuPooeQ4816uDHnQ0JfnycIcOP2bi4p7Ef/LhX0TVeZfDjS+il5c7w48uM/eHHlxsd4MdXGGX48ZWGAj9m4+Jhhgo/Ho4V8OMR9Hn6Ap1JZxka/JiNi+cYOvz4HcOAH79rmPDj9wwLfszGxWxcvIKupKvoarrGqIfP70324y3GAfDjrcaB8GM2Lt5uHAQ/3mEcDD/eaRwCP2bjmr42DoXf9I1xGPymb43D4Td9VzSu6Xu6p7D5cHqEcQT85o5GR/jNRxpHwm/uVDSx+SjKVjb3NTrBb2Yrm/9oHAW/+VHU4Dc/RgcbR8PnFQq/OTMq8Jtzw4bfPLfoY/M8Op/uNBz4ze1FE1t2GS78lt1FHxO2MlEMD37SxfDhJ12NAH5SzCH95ELKJiZsYsImJpfSywwBP2ETk/5GCD8ZYBwDP3m6aFzyjHEs/ORZozP8ZKBxHPxkrHE8/IRtSnLjBPjJXKML/GSe0RV+wtYkbE3C1iTLKZuSfE/3GN3gJz9x+2ejO/x0/+L9Je1g9ICflo2e8FOlyF7a2egFPz3OOBF+enyRuvQEygSmPY2T4Ke9jN7weS7j8w4NP73S6AM/vcroCz+92jgZftrPOAV+ek2RvfRaeh29nt5Amcx0mHEq/JTJTJnMdAqdSqfR6cZp8NMZxunw0zeNM+CnM40G+Oksowo/fctohJ++bUTw09lGDD+dY5wJP32vpMFP3zfOgp+2GmfDT2vGOfDTtiKlaUZzOpfOo/Mpk5yuMc6Fn641zoPPu0R8flObz88Z+elG43z46SbjAvjpZuNC+OkW4yL46VapDD/dZlwMP91uXAI/3cHftpO2G5fCb/26eGdp/YZ+a1wGv/U743L4rd8bV8Bv3WNcCb/GtNeOoB3pkcZV8GudjKvh15j2GtNe62v0g1/7g3EN/NofjWvh15j22mPGdfBrg/Ew/FqbcT38WmbcAL+WF9muMe21ecaN8GtMe22ncRP8GtPetsu4GX4b056VjVvgZ4pxK/ysSzH3y7oat8HnGZOfXWjcDj+7yLgDfnaxcSf87BLjLvjZpcbd8DPO9DKmPetPBxj3wM+Y9uwZ+qxR/M6Bxn3ws7HGH+BnTHuWG3+En801/g9+Ns/4E/xsvvFn+NkC4y/wM6Y9W278FX62wvgb/Ixpz/ZQpj37uTDfn3Yw7oefl42/w8+Z9ryz3gw/P854AH5+vPEP+DnTzrMAP+9p/BN+3sv4F3yeBfi8W9jPr6RX0atpP3qN8SD8/FrjIfj5dca/4efXG/+BnzPt/ES5z0+U+/lw42H4+Qjjv/Bzpj2fajTBz6cZxd9nutECP59RpDd/k86ks+hb9G06m86hTHv+vpHAz1uNFH5eMx6Bn7cZj8LPM+Mx+HluPA4/n2s8AT+fZ/SHnzPt/HSSn68xBsDP1xpPws/XGU/Bz9cbT8PPN/CnG+kmupluoVvpNrqdMu35Tu0++Hm78QwCfI8MAfYYzyLAD8ZABPgRtyMoHU6PoB2NQQhKRxqDEZQ6GUMQlIqEB6U+tK8xFAHviA54R3TAO6KD0n30ceM5BKUn8DCC0hBjGAKe4wSlNmM4glJmjEBQyo3nEZTmGi8gKM0zRiIoFZkP+BSmgE9hCkpLjVEISsuM0QhKH3PPJ3SnMQZBqd0Yi6BuZ6kngrp2ussYh6Cu6EgglY0XEUiKMR6BpJbuRSBp9Hh6Au1CuxoTEEhFdwLpQmMiAukiYxICfk9BILXQhD5KH6OP0ydofzrAeAmB9GTpNgTSU/Rp+owxGYH0rPEyAmmg8T8E0iDjFQTS4NK1CKQhdCgdSUfR0carCKQxxmsIpDbjdQRSZryBQMqNKQh4ZhRI84ypCKT5xjQEPDMKpIXcv9yYjkBaYcxAIJeN+xHIivF3BLKKzxDIGu1MjzPeRCAfb8xEIBddC+QutCvtRrvTHrSnMQuB3Mt4CwHPMgL5Wnqd8TYC+XpjNgK56FfAM4uAZxaBPNyYg0AeYbyDQJ5ivItAnmq8h0CeVtIQyNPpO/Rd430E8ntGKwL5faOGQJ5ntCGQ5xsZAnmBkSOQF5Y6IuCzrwM++zqQt9JtdDvdQXcacxHwDCJoYOYb9tAfjHkIGpj56uHGfATVI2hHYwGC6pFFwqudKDPPM4ug2tdYiKB6N2oIqvfQe+l99HH6BC3ONYLqUGMRgmobtzOaG4sRVOdye57xAQJeMwp4PSioLjE+RFBdanyEoLrMWIKg+nGHNxFUmXmemwTVdmMpgsadxjIEje3GxwgadxmfIGhk5nluEkSK8SmCSDU+QxAx8xEzH51gfI4g6mJ8gSDqaixHEDHz0YX0ImMFAn5PRBC1GCsRRMx89KixCkH0mLEaQfS4sQZB9ISxFkHU31iHIBpgrEcQPWlsQBA9ZWxEED1tbEIQPWNsRhA9a2xBEA00tiKIBhnbEESDje0IoiHGDgQ8ZwkiZj4aZexEEI022hFEY0pHIojajF0IoszYjSDKi1RHzHw0j86nCygzHy03vkTA85EgLlOlSH6sGl8hiJn5mJmPj6PHG18jiE8wvkEQM/NxV+NbBHG3ogu86zvgPD+IexrfIYh7Gd8j4Dw/iK/d/zYE8XXGHgT8DFrAz6AF8XPGDwjiYcaPCOLhxk8IOLcP4inGzwjiqcYvCOJpxq8IYmY+Zubjd43fEMTvYROC+H06j843ij99gbEPQczMx5uN3xHEW0wgiLeaJQTxNrMOQbzdlBDwezeCeKcpI4jbzf0QNDHzTXvM/RE0/WB2QNDEzHNOHjT3NcsImocUR+nmoaaCoHmeqSLgTDtoXkAX0kV0MWVWm5eYGoLmpaaOgHeGB83MZzPz2cJjcku7aSBo2WWaCDhLDxLVtBAkzGRyvFmPIDnBPABB0qVIYNKVnkfPp8wnZ+9BcpF5IAJetQmSS+il5kEIksvMgxEkl5uHIEiuMA9FkFxpHoYguco8HEFytXkEgqQfX38NfZA+ZHZEkPzbPBJB8h+zE4LkYfMoBMl/zaMRJE1mBUHSbNoIkhbTQZAkposgSU0PQfKI6SNIHjUDBMljpkCQDCrdgyAZbIYIkiHcHmoegyB5zjwWQVKcjwfJcDqCMvPJKLMzgmS0eRyChJlPWmmNttHMPB5BkpsnIEiY/2S52QVBssLsiiBZaXZDkKwyuyNIVps9ECRrzJ4IkrVmLwTJuqIFqWqeiCDVzJMQpHqR/NSgXczeCNKu3O5m9kGQdjf7Ikh7mCcjSHuapyBIrzVPRZBeZ56GgGcKAc8UgvRp+gx91jwdQTrQPANBOshsQJAONqsI0iFmI4J0qBkhSJ8zYwTpsKIL6TTzTAQp85/OpnPMsxCk75hnI0jfNc9BkC4wz0WQLjTPQ5AuKlKdLqYb6SbK43+6xTwfQbq9OMKnO8wLEKQ7zQsRpDzmP7J/qYzgkQ6FrexC6x7zIgStP+jPIGhlFzhjD2p9zYsR1NiFGucqtXnmJQhq881LEdQWmJchqC00L0dQW2RegaDGLtQ+Mq9EUFtiXoWgttS8GkFtmdkPQY1dqLELbTvNaxC0tZvXImjbVbSAc/ggU83rEGTsQna8eT2C7ATzBgRZF/NGBBm7wDuagux88yYE2QXmzQiyC81bEGQXmbciyC42b0OQXWLejiC71LwDQXZZ6RwE2eX0CnolvYpebd6JIOtn3oUgYxeyB4ujevaQeTeC7N9FbrP/0Ifpf2kTbaYtNKEpfYQ+Sh+j7EI2mLIL2VD6HB1mFg43i3/1CPM+BNlI8w8IslHmHxFko4vMZ+xC1mr+H4KsZv4JQdZm/hlBlhUznIzvBRm7kC2nK+hKuoqupmvMvyDI1pp/RZCtM/+GIFeLRuSaeT+CXDf/jiBnF/IulF3Iu5kPIMi7m/9AkPcw/4mAd3AFOec5+XXmvxDk15sPIshvMB9CkD9t/htB/oz5HwT5s0XC84HmwwjyQdweTIfQofQ5yi7k08z/IsjZhXy22YQgn1Mc+fN36Lt0AV1oNiPIF5ktCHJ2Id9oJgjyTWaKIN9sPoIg32I+iiBnF/IddCdlF+bubz6GYG7RBYEfkEHgR/NxCPyE2yHwcyHn9qLU13wConQ75kKU7qB3mv0hSneZAyB4b5goDaKDzSchSovMpyBKi82nIUofGKMgSh+qj0GUPjKfgeC9XqK0ne6gO2m7+SxE3cZST4i6TXQr3WYOhKjbbg6CqNthDoao22kOgahrN4dCSKr5HARn8kLqYg6DkIqmCKkb7W4Oh5B6mCMgpJ7m8xBSL/MFCOlEcySEdBL39DZHQUh9zNEQUl9zDIR0sjkWQjrFHAchnWq+CCGdxt92Oj2DNtAqbaQRjemZ9Cx6Nj2HnkvPM8dDSOebEyCkfuZECOkacxKE9M/S5RDSv+iD5ksQ0kOleyCk4XQEfd6cDCG9YL4MIY00/wchjSodCSGNpmPoWDrOfAVCepHb4+kEOpG2mq9CSDXzNQiprbQfhJTRtXSd+TqErJtvQMiGOQVCLhoh5K7mVAi5mzkNQu6O7yDkHrQn7UevMadDyNeaMyDk68w3IeTrS4dAyDeYMyHkG81ZEPJN5lsQ8s3m2xDyLdgOId9Kb6O30zvonfQu2kITmtJH6KP0Mfo4fYL2pwPok+ZsCPkpcw6E/LT5DoT8DFZAyK/TN+gUOtV8F0KeZr4HwTMRIc8234eQiyYKuY1mNKdz6QK60GyFkBeZNQi5aKXgPWmC96QJPrFWyOvMNgh5vZlByBvMHKKB7Wv4kf5kzoVoYPt4liGqfc15EFW2r3qHOR+ieidqENW76MCiO7wnTVQHmwsgqou4vZh+QD80F0JUPzIXQfAJEqK63VwMUd1hfgBR3Wl+CFFtNz+CaGT7GjeZSyAa2b7GbXS7uRSicUepD0TjTtpeGKnmMohIMz+GiLqYn0BEXc1PIaJu5mcQUXfzc4ioR+kciKgn7UVPpCfR3rQP7UtPpqfQU80vIKLTzOUQ0enmCojoDHMlRNRgroKIquZqiKjRXAMRReZaiCg210FEbF90lrkegp9WENE55gYIflpBROeZGyGi8/n7+5mbIKJrzM0QEdsX/cvcAhE9aG6FiNi+iO2LRpjbIKLnze0Q0QvmDohopLkTIhpltkNEo81dENEYczdENNb8EiIaZ34FwW8TENF482uIaIL5DUQ00fwWImrl/pr5HUTUZn4PEWXmHoiI7YvWmT9AxDoWQ8SG+SNEzPbFXc2fIOJu5s8QfC6ZiHuYv0DEPc1fIeJ+5m8Q8TXmXoj42qJx8XWU7YtvoDfSm+jN9BZ6q7kPIr7N/B0ivt0CRHyH+RBEfCdl++IWqwQRs31xatVBxGwfvxFAxI9ZEkT8uCVDxE9Y+0HE/a39IeIBVgeI+MmiWfFT9GnK9vGTrSJ+wypDxFMsBSKeWjQunkbZvng2nWOpEDHbF2dWsSe3dIh4rmVAxGxfvJAuskyIeLFlQfB6h4jXWPUQ8VrzEYh4nXUARLy+dAQEn+Mhmn6yDoRo+tk6CKLpF+tgiKZfi/bxnEg097UOgWhm+5rvKBrXPNA6FKJ5Y9Gj5k10s3UYRPMW63CI5q3WERDN26yOEM3brSMhmnfgQojmnbTd6gTRsqp0MkTLarrGOgqiZa11NETLOqsC0bLesiFaNlgORMtGy4Vo2WR5EC2bLR+iZYsVQLRsLRrawm62bLcERAu7yaseIlGoSjUrhEh06xiIxCjeJZMu1rEQSdci/0mj1RkiiazjIJKYe860jodIzrJOgEjOtrpAJOdw/7lWV4jkPKsbRMLuJP2s7hDJNVYPiOTaojvJdfR6egN9gP6Dsl8J3914ziWSh6yeEDw/EslQ+hwdZvWCSIZbJ0IkI6yTIJLRVm+IZEwJEMmLVvHvGs/tCXSi1RcimWSdDMEnAAs+pU3wKW0imUKn0ml0Op1B36Qz6Sz6Fn2b5tYpEMlc61SIZJ51GkQy3zodgldzBK/miISdTdZZZ0CkptUAkVpFW9N6egA90KpCpAdZjRDpwdxzCO1Ej6LdrAgi7W7FEGkP60yItKd1FkTaq2g6r+aI9Cp6tXU2RNrPOgcivabob3o7vcM6FyK90zoPIr3LOh8ivdu6ACK9p2ho2kSbrQsh0hbrIog0sS6GSFPrEoj0EetSiPRR6zKI9LGilSm7mb5hXQ7B8zKRzrGugEjfsa6ESN+1roJI37Ouhkjft/pBpK3WNRBpzboWIm2zroNIM+t6iHSedQNEOt+6ESJlT1P2NF1EF1s3QfDzv4Kf/xXpautmiHSNdQtE60/Fe2Lrz/QX41CI1l+tWyF4jiZqfa3bIGrsZu0O63aI2rNFQ2sDrTsgamxobZN1J0Rts3UXRG1L0cHaVtqOWRBtK627IdrYxzb2sW0NXUvXWfdAtK3n9ga6kW6im+kWutW6F6Jtm3UfRNt26w8Qbexjxj5mivVHiEy1/g8i06w/QWS69WeIjH3Mulh/gci6Wn+FyBqLZmURZR+zM+lZ9GzKPmbn0vMo+5j1o9fQa62/QWTXWfdDZNdbf4fIbrAegMgesP4Bkf3D+idE9k/rXxDZv6wHIbIHrYcgMr7f8RxNZEOtf0Nkz1n/gciGWQ9DZMOLrmUj6GjKPmYvUvYxYx+ziXSS9V+I7CWrCSKbbDVD8DPCImMfs6lWC0Q2zUogsulWCpHNsB6ByN60HoXI2MdslvUYRPaW9ThE9rb1BESWc/9cOs/qD5HNtwZAZAusJyF4vUlk7GO2znoKIjeN+yFyy3oaImcf8wOsZyDyA61nIfKDrIEQ+cHWIIicfcw7WYMh8qOsIRB5N+7pTnvQntZQiLyX9RwErzcJXmMS+dXWMIi8X9HBnH3M2cf8DnonvYvebQ2HyNnHnH3Mm60REHmL9TxEnhSNy1P6CH2Uso/569YLEPkbRRN5XUnwupLI36Hv0vfo+7SV1mgbzeg8Ot8aCZEvsEZB5Oxjzj7mi63REPycsshXWWMg8tXWWAje4RbiF2QI8as1DiF+s15EiL24HSHPE8NSX2s8wtKd1gSEpbusiQhLRR/DUtHHsLQewxCWNtCN1iSEpU3WSwjrVlqTEdatsl5GWLfa+h/CuqKDYd1W6xWEddusVxHWbbdeQ1hXdCqUZLof3Z92sF5HKJWtNxBKijUFoaRaUxFKmjUNoaSXrkYoGdZ0hJJpzUAoWdabCKX60r0IpQNoF9rVmolQ6mbNQih1L52DULqe3mC9hVC633obofR3azZC6QFrDkLpH6V7EEqD6GA6xHoHoTTUehehNNn6L0LpZes9hNL/rPcRSq9YrQilV60aQn6LWSi9Tt+gU6w2hNJUK0MozcAShNKbVo5QmmnNRSjN4p7Z1jyE0hxrPkLpHf5X79L36PvWAoRSq7UQoVTjnjaa0ZzOtRYhlBZYixFKC60PEEprrQ8RSuusjxDKJhYjlC1rCUK53lqKUD7AWoZQPtj6GKF8iPUJQvlQ61OE8mHWZwjlw63PEcpHWF8glDtayxHKR1orEMqdrJUI5aOsVQjlo63VCOWKtQahbKP4rxzay1qLUD7RWodQPgnfIZR701PpafR0egZtoFVrPUK50dqAUI6sjQjlmPvPpGfRs+k59Fx6Fb3a2oRQLnoaytfQu63NCOV7rC0I5XuxHSGf9hzKTdZWhHKztQ2h3ILiX5TQV+lrVvGa160dCOWij6E8j863diKUFxg1hHLRqVD+wGpHKH9o7UIor5RmIJRXWbsRNrBHDb/S36wvETbstb5CyDO+sNrX+hph9U7MRVi9i7JHVfaout76BmF1g/UtwupG6zuE1U24EGHjytKVCBtXWd8jbFxt7UHYuMb6AWHj1qJNjdvodutHhI07rJ8QRrL1M8JoP+sXhNH+1q8Iow5FU6IyVahKNcoeRQY1qWX9hjCqt/YijA6w9iGM2KOoK+1m/Y4wYo8i9ii6oR4Io/tLlyOM/l5fQhg9ULQmYo+iQfV1CKPB9RLCaEipeOVQOpm+XC8jjP5Xvx/C6JWiC9Gr9LX6/RFGr9d3QBi9UV9GGE2pVxBGU/lT9ih6k86k7FE0m86pVxFG79RrCKN363WE0Xv1BsLo/XoTYdTK19TqLYRRW309wiijef0BCKO5/OmC+gMRRgvrD0LIc7cwWld/MMKYPYotWk8PoAfTQ+ih9DB6OD2CdqRH0k70KHo0rRj3I4zt+kMQxuxR3IueSE+qPxRh3Lv+MIQxexSfVn84wvj0+iMQxmfUd0QYN9QfiTCu1ndCGDfWH4UwjuqPRhjH9RWE8Zn1NsL4rHoHYXw2PYeeW+8i5NONwvjqeg9h3K/eRxhfUx8gjO8u2hTfUy8Qxvf+P0f3HSY1ubYB/J5NwIqmj1RpkrzvmwxgoYPMJrZT1O9YaPZeAfXYG7OJlQ72Bth7O3ZAdzaxC9gLVUUFxd4b+l25//ldc2WHWWCe+0meyTvJdufD5zWx/WSa4cNPGkYAP2kzBPyEOUru335L+MkDhoSfPFhmJ2GOkhe6PAc/edFQ8DnT+Zzp/OQ1uswI4ScrjQh+ssqowZ/2Z5mgaZuNgfCn/VXuiab9XdrobQyC3+hTpqkxokxQYyRljhrMUeP9ck/U+ICuNAbDb6wydobfWG3sAr+xxtgVfmOtsRv8xjpjCPzGh8ZQ+I2Pyqy1rTWGwW9bZwyH3/ahMQJ+W3l86Ld9TNdT5q6NuWvbaIyE3/a5MQp+CmM0/LRijIGfthi7w081Yyz81DDq8FPTaIWfWmWCUtuI4aeOkcBPXWMP+Kln7Ak/rfKnO9CuNKQRrRl7wU8HGnvDTwcZ+8BPmcH0MOMf8NPDjX/CT48w/gU/PdL4N/z0KGNf+OnRxn7w02OM/eGnxxr/Bz89zvgP/PT4Mq3pCfREyvymZxqlzG/K/KYz6EzjQPjpLOMg+Ols42D46Rxun0vnGePgp/PLRKfMdXqPMR5+em+ZppS5Tl8xJsBPXy3TlK6ma+haYyL8dJ0xCX76oXEI/PSjMhFZb+NQ+Fkf4zD4Wd8yBVk/2p/uRJmRbDfjcPjZEOMI+NlQ40j42bCynrPhdIRxFPxspHE0/GyUcQz8bLRxLPxsb2yAn+1jHAc/+wef+U+6L92P7k//jzIj2Tg6nk7Y7nH42UnG8fCzk40T4GenGCfCz04tM5JNplPoVHqacRL87HTjZPjZGcYp8LP/GqfCz840JsPPzjKmwM/ONqbCz84xToOfnWucDj87zzgDfna+8V/42QV8nQvpRfRi40z42TTjLPhZwzgbftZmnAM/Yx6za+i19DrjXPjZ9cZ58LMbjPPhZzdy+030ZrqQLqL3GxfAzx4wLoSfFWWKs+eNi+BnLxgXw89eLJObMb/ZMmMa/Gx5pRv8bAVdSVcZDfjZaqMNfrbGSOG3M9ftm7t48Nv/MjL47cx1s3eZ6CZz3Ryx9bfwm8w15z6fc5/ffN+4BH7zA+NS+M2VZVqbq+hquoaupetKO9aW+82OdfRD4zL4nPX8jo+Ny+F3rDeugN/BLHdsMK6E37HRmA6f852fw5gBP68YM+HnLeU+MdeoQU1qGbPg57YxG37ucItLPWMO/LxqzIWf72DMg58zy3lozIefR8ZV8PNamdx8IB1EmeX8MHo4PYIeSY+iR9Nj6LH0OONq+PnxxjXw8xOMa+HnJxrXwc+Z5fxM43r4+VnGDfBzZjlnlvOZxo3w81llTvPZxk3w8znGzfBzZpnn9fx8vnEL/JxZzu8xFsDP7zUWws+Z5fwVYxH8/FXjVvg8B+fna4zb4Odrjdvh5+vK/OYfUma56E370L7GHfCLfsad8Iv+ZboLZrlglovdjLvgF0OMu+EXQ4174BfDjHvhF8ON++AXI4z74Rcjy+QWo+hoyiwX+xgPwC/+YTwIv/in8RB8fnvIL/YzHoZf7G88Ar9gljnf+cU441H4xXjjf/A53/nFSfRkego91XgMfjHZeBx+McV4An4x1XgSfnEaf3o6PYP+l55Jz6Jn03PoufQ8er7xFPziAuNp+MWFxjPwC2a5uNhYDL+YVqavaFAeo3J+9ItrjCXwi2uNpfCL64xn4RfXYyX84gZ6o/Ec/OImox0+vwnlFwuNJvxikdEBv7jfyOEXDxgF/IJZLp43nodfvFDmt2CWC2a5WGa8AL9YbrwIv2CWOTP6xSq6mq4xXkKAP42XEWCz8QoC/GW8igBlloPKCOM1BJUyvwHnxIBzYtDyobEMQctHxnIELR8bKxC0rDdeR9DyifEGgpZPKyMQtHxmvImgZYPxFoKWjcbbCFo+N95BoKEyDIFWMd5FoLUY7yHQyjwG2g7G+wi0rsYHCLRuxkoEWvfKSQi0HrQn7UV3pJIqYxUCLTRWI9Ciyt4ItBOMNQi0E/n4JGMtAu1kYx0C7RTjQwTaqcZHCLTJlQMQaFPoVHoaPcv4GIF2trEegXaO8QkC7dzKiQi0K+l041ME2gzjMwTazAoQaHOMDQi0ucZGBNo843ME2nzjCwTanfzpXfRueo+xCYF2r/ElAq3MXaC9RF+mrxhfIdBeNb5GoK2sdEKgraKrjW8QaGuMbxHoffEqAr2f8R0Cvb/xPQK9zFqgD6A+Daig0vgBga6MHxHog7hlMN2Z7kJ3pbvRIcZPCPSh2IBA/5fxMwL938YvCPR9jV8R6Ptx+/70/4zfEOj/MX5HoB9g/IFAP9D4E4F+kLEZgX6w8RcCfZzxNwL9IhMI9IvNCgK9zEKgN+gcOpfOo/PpVWYLAv1qU0Og32TqCPSbzU4I9FvMzgj0BeYWCPSFWIlAX2RuiUC/1dwKgX6buTUC/Xa+wh30TnoXvZveY26DQL/X3BaBfp/ZBYFemNsh4JnBQH+NLjO3R6AvNw0EepmXQH+dvkHfpG/R1XSNaSIY+6dpIRi72bQRjP3LdBCMZXbqI0wXQZ3Z4WwYcDYMWj+sHISg9SP6MV1PPzE9BK2fmlUErZ+ZOyBo3WB2RdC60eyGoPVzszuCmNmJK2YPBHGL2RNBzOzEO9CutJvZC0Hc3dwRQdzD7I0g7mn2QRD3MvsiiJmdWJr9EMTK7I8gDsukxMxOfAJlduKT6Mn0FHoqnWzuhCCeYg5AEE81fQQxsxOfRc+m55gBgpjZiZmdeLopEMQzyizEzE48h86l8+h8eqcpEcR3mQpBfLcZIojvMSMEcXn8GXCuDGJmJ37ZrCHg95sCfr8piFeaAxHEq8xBCOLVZWp4pYggYXaSfrS/ORhBspO5M4JkgLkLgsQ3d0WQBOZuCBJhDkGQSHMogkSZwxAkzE4y2ByOINnZHIEg2cUciSDZ1RyFINnNHI0gGWKOQZAwO8m/6L/pvpTZSZid5P/of+gB5u4IkgPNsQiSgyouguRgOo5eRC826wiSaWYrgqRhxggSZieZayYIknnmHgiS+eaeCJKrzL0QJFeX6UhuojfTW+gCyuwki8y9ESS3mvsgSG4z/4Egud38J4LkDvNfCJI7zX8jSO4y90WQ3G3uhyC5p7INguReeh8tzP0RJMwO574gWUaXm/+HIFlh/gdB8rp5AILkDfNABMmb5kEIkrfMgxHwfF+QrDHHIeCV04Jp6+kn9FP6Gd1AN9LP6Rd0E/2SfkW/pt/Qb+l39Hv6A/2R/kR/pr/QX+lv9Hf6B/3THI9g2mZzAoJpf2ESgml/mxMRNFCmu1GhLVSjFrWpQ106wpyEgLNt0PgPPcA8BEHjQPNQBLyDZ9A4xjwMQeNY83AEjePMIxA0jjePRNCYXPaNxhTKHsK5OGh7wTwKQduLlT0RtH1Wdo+2DebRCNo2mscgaPvcLJ/zhXkcgrZNZcdo+5J+Rb+m39Bv6XelaYt5PIJUM09AkOrmiQjSTmU/SbelXahHq3QH8yQEaVfzZARpN/MUBGl381QEaQ9zMoK0Z2U4grSXOQVBuqM5FUHa2zwNQdrHPB1B2tc8A0Haz/wvgrS/eSaCdCfzLATpAPNsBKlvnoMgDcp+lYqyX6XSPBdBqsr+k06mU+hU8zwEKftPeo55PoKUPSc9j55P2X/S6eYFCFL2n5T9J72V3kZvp3fQO80LEaR3lR0mZZ9JXzYvQpC+Yl6MgDNvkL5L36Pv0w/MaQjSlWYDQbqq7DNZYLYhyAQfS6rMFEEWmhmCLCq7SlajA+kg8xIE2eCyM2Q7m5ciyHYxL0OQsVdkB5iXI8gONK9AkB1kXokgY6/I2Cuy8XQCnUgn0UPooXQabZjTEWRt5gwEWWrORJBl5iwE2SXmbATZpWVv4bnFILucXmHOQZBdycfT6QxzLoJspjkPQTbLnI8gm21ehSCbY16NIJtrXoMgY5/JFpnXIsg6yo6R5bQwr0OQsW9kb9K3zOsRZG+bNyDI3jFvRJC9a96EIHuv7BLZ6pYHEWRrzJsRtK83b0HQ/om5AEH7p+ZCBO2fmYsQtG8wb0XQvtG8DUH75+btCNq/4E83mXcgaP/SvBNB+1fc8jX9hn5Lv6Pf0x/oj/Qn+jP9hf5Kf6O/0z/MuxC0/2nejaB9s3kPgva/6N/mvQiaMO9D0KyY9yNotpgPIGiySzQt80EETZvbHerSEeZDCDgpB012ieYB5sMImgeajyDgPU+D5jFlB2geS4+jx9PJ5qMImuwSnLIDTtlBxwtlf+hgl+hgl+jYQDfSz+kX5v8QdGwyH0PQ8aX5OIKOr8wnEHR8bT6JoOMb8ykEHd+aTyPoYJfIW6hGdfMZBHknczGCfFtzCYKcXSJnl8ir5lIE+Q7mswjyrmU3yLvR7rQHZZfIe9EdzecQ5L3NdgR5H27pS/vR/nQnOoD6NDCbCHJhdiDIpZkjyJVZIMgnm88jyKeYLyDIp5ovIsjZJfJzzJcQ5OeaLyPIzzNfQcAzqkHOLpFPN19FkM8wX0OQs0vwzGmQ32YuQ5Dfbi5HkN9hrkCQ32m+jiC/y3wDQc4ukb9svokgf8V8C0HOLpGzS+TvGRMR5O+bbyPIPzDfQZCvLLtBzi5RBJRdopDmuwgKZb6HoAjN9xEUkfkBgqJmrkRQDDRXISgGld2gYJcodqa7UHaJ4gB6ID2IHmyuRlCMM9cgKMabaxEUE8x1CIqJ5ocIiknmRwiKQ8yPERTsEgW7RNGgbTSlGb2EXkovM9cjKC43P0FQXGF+iqC40vwMQTHdLH/vDHMjgmImnzmLzqZz6FzzcwTFQvMLBMWisjPw7GdQ5OYmBEVhfomgYJco2CWKt+jb9B36rvkVguI982sExWrzGwQ8+ylQHlEIrKd/4VII/G1+C1Gp4UiIykDzO4jKgRgHUTnI/B6icjCeh6iMo+PpBDqRTqKH0EPpYfRweoT5A0TlSOwFUTnG/BGiUmaz3GOVnkwnmz9BVKbw8RxaZlO0fF05CKLlG/NniJZvzV8gWsp8CZ45FVonsweE1tn8FULbwvwNQtuyMg5C24puTbcxf4fQtjX/gNC6mH9CaNuZmyG07c2/IDTDXAKhmebfEJplAUKz+accqwKhuVYLhOZZGoRWtXQIrXdlOITWh/al/Wh/uhMdQH0aUGF1gtBk5RgITVmdIbTQ2gJCi6wtIbRaZW8IbSAdRAfTydZWENoUa2sIbaq1DQSnb8G1tYJra4V2nrUtBNfWCi2jl9BL6WVWFwjtcms7CO0Ka3sI7UrLgNCmWyaEdkOl/PfeSG+iN9Nb6AK6kC6it1oWhHYblkNod9K7LBtCu9tyILR7sAJCu9dyITi5C+0Vy4PQXrWqENpr1g4Q2rJK+f+wnK6gr9M36JtWVwjtLasbhPa21R1Ce8cq3993rZ4Q2ntWLwi97ANCF1RaO0LoyuoNodcqHoQ+0OoDoZfZF/pgeojVF0IvMyv0OXSu1Q9Cn2f1h9DnYyWEfgtdQBdaO0HoZdaE3k6btMMaAMG1skJ/3/Ih9A+sAEJfaQkIfZUlIcYyU2PXl2kay0yNZabqzFSdmaozU/WDLAVRP9gKIerjrAiiPt6qQdQnWAMh6hOtQRD1SdZgiPoh1s4Q9UOtXSDqzFT9cGtXiPoR1m4QdWaqzkzVman6SdYQiPrJ1lCI+mRuYaY4WQtO1qKVmWr9xhoG0Voe2YpWZipmpuJO1nCIuLM1AiLewhoJEW9pjYKIt7JGQ8RbW2Mg4m2s3SHibSvHQ8Rd6HZ0e2pQk1rUtsZCxE6ZhdilHq1SZiruY9Uh4r5WK0Tcz4oh4v5WAhHvZO0BEQ+w9oSImak4sPaCiIW1N0TMTMWKhjSiNWsfiHig9Q+IeJD1TwievRXxZOtfEPEU698Q8dQyQZzKBVfMivhca1+I+DxrPwiumBVxZu0PEV9i/R9EfKn1H4j4Mqt85uWV3SDiK+iV1oEQ8fQyIzEzFd9oHQQR32QdDBHfbI2DiG+xxkPEC6wJEPFCayJEvMiaBBHfah0CETNTMTMV30Xvtg6FiO+xDoOI77UOh4jvs46AiF8pkxW/Sl+zjoSIl1lHQcTLraMhYmYqZqbiN6zy/+FN61iI+K0yL/Hb9B36Ln2vNGGmEmEdB5FI63iIRJVpSpipZKB1AkQyyDoRImGmkkOskyC4ClckzFQyl86zToZImClO0yJZYJ0CkSwsE5QwU0m7dSpE0rQmQyQd1hSIhJlK3qcf0JXWVIhkVbmfmrbWOg1i2jrrdAje80VM+4gya9OYtWnM2jRmrcGsNZi1xiFl1hqHlilrHGadAdE43PovROOIMjUNZqdxFD2aHmOdCdE41joLonGcdTZE43jrHIjGCWV2GifSk6xzIRonW+dBNE7hllMps8bZU3D2FI1ncS1E4znaTpu0oM/TF+iL9DW6rLTta+t8CM6eIm2hGtWtCyDSTtaFECmTldrWRRApk5UyWWk32p32oD1pL7oj7W1dDJH2saZBpAOsBkTqW20QaWClEKmwMoiUyUqZrJTJSpmstEYH0kHWJRDpYOtSiHTnMl/pLnQYHU5HWJdBpCOtyyHSUdYVEOlo60qIdIw1HSLd3ZoBkY61ZkKkdT6/lcY0oXvQiXQSPYQeSg+jh9Mj6JF0Mp1izYJIz7FmQ3DmFek02rDmQKRtfJxacyHSzJoHkV5izYdILy1znV5GmfT0CusqiPRK62qIdLp1DUQ6w7oWgjOySGfR2XQOnUvn0fn0Kno1vYZeS6+zroNIr7euh0hvsG6ASG8sO0B6L73PuhEivb/Me/oAXU5XWDdBpK9bN0OkbxjfQmQDrFsgMt9aAJEF1kKITJT5zQbRwdYiiGxn61aIrDwSFtmudDc6hA6l4+kEOtG6DSKbZN0OkR1i3QHBGVlk86w7ITImPbuJ3kyZ+myBdRdEdmeZ9+wueje9hy6hS+mz9Dnrbois3boHImta90JkHdZ9EBk7Q7bSuh8iW2U9AJF9XPaEbD39hH5KP6MbrAchso3WQxDZ59bDENkX1iMQ/L6kaF9bdon2dfRD+hH9mLKTtLOTtLOTNNlJmuwkTXaS5qH0MHo4PcJ6FKJ5pPU/iOZR1mMQzaOtxyGax1hPQDSPtZ6EaB5nPQXRPN56GqJ5gvUMRPNEazFEk0e/TR79Nk/BURDNU+lkyk7C+VQ02Umaz1lLIJrt1lKIZtN6FqJZWM9BNJ+32iGaL1jl81+0OiCa7CTNZbgKouNrK4foYCfJW6wCItes5yFyveweeSfKTpLb1gsQuWO9CJGzk3AOFXl36yWIvIf1MkTe03oFIu9lvQqR72i9BpH3tpZB5H0qoyDyAdSnARVUWsshcmWtgMhD63WIPLLegMhr1psQOTtJPogOpjtbb0Hk7CT5MOttiHy49Q5EPsJ6FyIfab0HkY+y3ofIR1fGQORj6O50rPUBRF63VkLkrdYqiDy2VkPkibUGgiuQRc5Okk+y1kLkh1jrIPJDrQ8h8sOsjyDyw62PIfIjrPUQPBMtcnaSfIr1CUR+jvUpRH6u9RkEvz0q8oa1ASJvszZC5Kn1OUSeWV9A5JdYmyDyS60vIXJ2kpydJOcxQ34lnU5nWF9B5DPLvOezrK8h8tnWNxD5HOtbiHyu9R1EPs/6HiKfb/0AkV9l/QiRX239BJFfY/0MkV9r/QKRX2f9CpFfz9e5gbKT5Owk+X3WbxD5/dbvEDk7Sc5Okq8wLIj8desPiPyN8kigGGD9CVH4Zd8oAspOUrCTFIPpztZmiGIX6y+IYlfrb4hiNxsQxRC7AlGwkxTj7RaIYoKtQRQTbR2imFR2j+IQyk5SzLM7QRTsJAU7SXGz3RmiuMXeAqJYUPaHgp2kuMveEqK4294KorjH3hqCq4VFsbTLcxDFs3b5+LkuTYiivewbRdPeFqLosLtAFOwkxUp7Owhe61IU7CTF+rJjFJ/Y20MUn9oGRPGZbUIUG2wLotjIP/U5/cK2IfhtU8l5WeJv24GswHYhKxUcCcnZWVYG2h5kZZBdhawMxjjIys50F7or3Y0OoUPpMDqcjqAjadkHJGdhWXnW3gGy8pzdFbLSjqsgK027G2Slw+4OWcntHpCVwu4JWXm+/GnLF5WDIFs20S/pV/RruxdkS9kTZMu39Dt7R8iW7+3ekC0/cMuP9Cf6M/2F/kp/o7/TP+ifdDP9i/5dyrPYUqvQFqrZfSC1sv9IrRM1qEktuy+kZvOxY/eD1Fy7P6Tm2TtBalV7AKS2g+1Dal3tAFLrZgtIrbstIbWy50hN2ApSk3YIqSk7gtRqlZGQ2kC7BqkNsgdCaoPtQZDazvZgSG0Xe2dIbVd7F0htN3tXSG2IvRukNpR/apQ9BFIr+4zUEnsopFb2EKmNpxPoRDrJLv91R9jDIbUj7RGQ2lF2+QpH86fH0GPpCfREehI92S7/5qfYoyG1U+3yd022d4fUpthjIbXz7DokZ3ypXUAvpBfRi+1WSG2aHUNqDTuB1C6194DULrP3hNTKniO1K+iVdDqdYe8Fqc20y98+y94HUptt/wNSm2P/E1Kbi+WQ2v32vyC1sldIraDP2/+G1F6w94XUXrT3g9ResveH5Nl5qS2nK+jr9v9BamU/kfoA+z+QetlPpL6rfQCkvhsfD7EPhNSH2gdB6sPsgyH14dgAqY+3x0FyVbPU59jjIfW59gRIfZ49EZIzu9RvojfbkyD1W+xDIPUF9qGQ+kL7MEh9kX04pH6rfQSkfpt9JKR+u30UpH6HfTSkfqd9DKR+F1/hXvtYSP0++zhI/f7KNpD6A/QZupgusY+H1Jd2ySD1lVgPqa+yT4DkvbEk740leW8syXtjSd4bS+of2SdC6h/bJ0Hq6+2TIfUv7FMgecUeyc8H5Ni/S+uwT4WsV+zJkPysQNYH0kH2FMj6YHsqZH1n+zTI+i726ZD1Xe0zIOu72f+FrA+xz4SsD7XPgqwPs8+GrA+3z4Gsj7DPhayzh3D2l/W59nmQ9Wft8yHrz5X9oc4eUm/SDprTgrKHtLKHtG6yL4Bs/dK+ELL1K/siyNav7YshW7+xp0G2fms3IFu/s9sgW7+3U8jWH+wMsvVH+xLI1p/sSyFbf7Yvg2z9xb4ckndwkK2/2VdAtv5uXwnZ+oc9HbKVPaR1sz0DsvUveyZk69/2LMgY9mzIuGLPgYxb7LmQsWbPg4x1ez5kzB7CzxxkbNpXQcaWfTVkbNvXQMaOfS1k7JadIfZole5Au9JutDsN7OsgY1FmP5ZUUfaQeCAdRAfTnekudFe6Gx1C2UPiUXS0fT1knNg3QMbsITF7SDzBvhEynmjfBBlPsm+GjI+onAIZH0mPsm+BjI+2F0DGx9gLIWP2EK5AkPGJ9iLI+CT7Vsj45MoBkPEp9FQ6mU6h59Hz7dsg4wvs2yFj9pD4IvsOyPhi/nQabdBL6WX2nZDx5fZdkPEV9t2Q8ZX2PZDxdPteyHhG2UnimfZ9kPEsPp5N59j3Q8Zz7Qcg4/vtByFj9pC4sB+C5JWOJK90JHmlIxm/ZD8MGb9sPwIZL7cfhYxX2P+DjF8vu0fMHpIMsB+DTNhDkl0pe0gyhA6lw+zHIZPh9hOQyXj7Schkgv0UZDLHfhoymVvmPZlH2UN49l/y7L/k5xUyWWA/A8mz/zJZRG+lt9Hb6R32YsjkTj5mD0nupffR++0lkMkD9lLI5Bn7Wchksf0cZLKk7BvJUsoekqyy2yGT1XYTMlnDLWvtDshknZ1DJh/aBWTyEZ/zsf08ZLKez/nCfgGSVzGVDdgvQjYq9kuQjRb7ZciGVvaNxhA61H4FsjEMt0A2htMR9quQjZH2a5CNUWVnaIym7A+NufYyyMY8ezkk75EhG0/Rp+kz9grIxmL7dcjGEvsNyMZS+03IxrP2W5CN5+y3IRvsJw32k8Y3eBKyjd2jbZP9DmTbl/a7kG1f2e9Btv1YORWy7Sf6s/0+ZNsvZX7bfrU/gGz7zV4J2fY7t/xhr4Js+9NeDdm22V4D2fYXt/9dmoJWKDtAatprIVPLXgeZ2mWi0wHUtz+ETAP7I8iU6U7H2B9DpuXUINOxtE4Tez1kuof9CWS6p/0pZLpXmbV0vP0ZZDrB3gCZTiyzmU6iR9Gj7Y2Q6TH255ApE5qeYH8BmZ5ob4JML7C/hEwvtL+CTC+yv4ZMmbj0cvsbyPQK+1vI9Er7O8iUe+d0Dp1rfw+ZzrN/gEznl3vk9H77R0h+DiDTdtq0f4JMO/g4p4X9MySvpyT53V6Zvmz/Apm+Yv8Kmb7K57xm/waZLrN/h8y6lfnKutMetCftRXekvWkf2pf2o/3pTnSA/QdkxoRmw+hw+0/IbES5T89G0oPpODre3gzJc/Eym2X/BZnNtv+GzOY4gMyY1ux++oBTgcwedFogs4fKvXz2VLlfzp52NMjsmTIX2WK60dEhs8+dTpCXDK50gWyi3MM2K7TF6QzZ1JwtIJvMSHOosyVkc5izFWRzOBZANkfQkXSUszVkkxnhPC6bc+k8ZxtI3pNCNpmR5tPOtpDNZ3ADZHMxXeKUv30pHz9Ln6PtznaQTWak+TXmQ3Z84WwP2bHJMSA7viwT0fEVZUY6fnJMyI6fHQuy4xfHhuz4tazzjt8oM9LxB/2TbnYcyI6/HBey42/Hg8zhVCFzZiRnRnLT2QEyt8pc5MxIPsDpCpn7TjfIPCjTkTMj+Ri6u9MdMh/r9IDMmZE8oXvQPZ2ekPw+r+T3dmU+wekFmU90doTMmZGcGcmPdnpD5sc4fSC5llvmJzh9IfMTyyzkF9AL6UWUGckvp1fQK51+kPl0pz9kzozkc+k8ZyfIfL4zADK/3/EhOeHKnBnJm04AmXc4AjLPHQnJ6yxJXmdJ5swIv28r81ccBZm/6oSQ+WtOBJkvK2u7YEaK7k4NsujhDIQsejqDIItezmDIYkdnZ8iit/kuZNHH2QWy6OvsClkwI0V/ZzfIYidnCGQxwBkKyclaFsxIMZyOcIZBco23LJiRYpwzHLIYb+uQXKcti1nOCMhidpmIYg5lRgpmpHiAPuiMhCweckZB8tqtktdulcUzzmjIYrEzBrLY6OwOyWsoyecHVaqQzw+uVKEqLbgUqqLZp0JVdGcsVKUTjoSqdKZb0CF0qFOHqgzDQqjKcDqCjqSjnFaoSpkaVTmOnuDEUJUTcRRU5SR6Mr0ITajKxXSek0BV5jt7QFWuwrVQvKqqqjxBn6RPOXtCVZ7GDVCVdmcvqEqZI1Upc6Qq3zh7Q7VsrBwE1fK5sw9US5kg1fIT/Zn+4vwDquVX559QLb85/4Jq+d35N1TLH86+UC1/OvtBtWyuTIDS4OwPpVWc/4PSWirHQ2ka1Wkn2pluQbem29BtaRe6Hd3e+Q+UZjgHQGmmcyCU1r8yCkrbyTkIShvgHAyl+ZWRUNpYZxyUVqZMaQndg+7pjIfS9nLKv9tEZyKUNsmZBKUd4xwCxblS8UqtSpvuHAqlzXAOg9JmVnaD0ubQuXSecziUNt85Akq73zkSSnvAOQpKexAroLSH6MP0Efosfc45Gkprd46B0prOsVDaa5VOUNoy5zgobblT/htXOCdAaa87J0Jx7lPam/StUr0b7e6cBKX3dk6G0vtUPCi9r3MKlN7PORVK7+9MhtJ34vYRdKQzBUof5UyF0kdjA5R+sHMalD7OOR1Kn1FxofSZzhlQenlspvQ76J30Lno3vcf5L5R+r3MmlH6fcxaUfr9zNpT+AH/6IH3IOQeKc5/SFzvnQulLnPOg9KXO+VD6s84FUPpzWA+lf0Y30I3OhVC8p4OqM0F1jerORVD1Ts7FUPXOzjSoOhNUZ4LqQ50GVH2Y0wZVH+6kUPURTgZVZ4Lqo5xLoOqjnfJ1jnMug6of71wOVT/BuQKqfqJzJVT9JGc6VJ05ql/kzICqX+zMhKrPK9NUn+/Mgqpf5cyG4l0hVJ05qj/pzIGqP+XMhaozR3XmiDOgat3ozINq/dyZD9XK7LT+5JTbmZ3WX+ivztVQrb8510C1/l4mpfUP+idldmLQinMtVNziXAcVa871ULHu3AAVd3JuhIo7OzdBxVs4N0PFWzu3QMXbOAug4m2dhVBxF2cRVLydcytUvL1zG1RsOLdDxaZzB1TM7MQ7OXdCxQPK1MTMTjzWuQsqZnbixLkbKt7DuQcq3pNb9nLuhYonOvdBxZOc+6Fi5iWe7jwAFc9wHoSKmZeYeYnnOg9BxfOch6Hi+c4jUPH9WA4VP+A8ChU/6PwPKn7IeQwqfth5HCp+xHkCKn7WeRIqfs55CipuLzMSNynzEi+jy+kK+jp9w3kaKn7TeQYqfstZDJV0c5ZAJd2dpVBJ7zILCfOS9KX9aH/KvCTMSzKSjnKehUqYl+Rg5zkorpRWCfOSzHTaoTjLqOQOpwmV3Ol0QCV3OTlUcrdTQHEls+JKZsWVzCq5nz7gPA+VPOi8AJU85LwIxSsaqWQxXeK8BJUsdV6GSp51XoHiFV9VwrwkG5xXoZKNZVIS5mXan2VSpm0ubei0E+3svAbVYF4aI5xlUI2RznKoxihnBVRjtPM6VIN7lsbxzhtQjROcN6EaJzpvQTVOct6GapzsvAPVOMV5F6pxKp1MpzjvQTWmlqlpnEYvoBc670M1LnI+gGpc7KyEakwrc9Ro0Daa0qucVVCNq53VUI1rnDVQjWudtVC8M7VqXE9voDfSJ5x1UI0nnQ+hGswa5yPVWIHToRqv0zfoarqGrnU+gmqsw4lQXLes2jbQjc7HUG2fO+uh2r5wPoFq2+R8CtX2dZnNtm/ot85nUG3fORug2r7nlh/oj85GqLafnM+h2n52voBq+8XZBNX2q/MlVNtvZWZTnXZyvoJKOztfQ6VbVP4JlW7pfAOVbuV8C5Vu7XwHlW7D7dvR7Z3voVLD+QEqNZ0foVLL+QkqtZ2foVKnTGvq0h60J+1Fd6S9aR/al/ZzfoFK+zu/QqU7Ob9BpWOd36E4o6m0lcbOH1Bp4vwJle7hbIZK93T+gkr3cv6GSvd2AZXuU+4Z04PpODrerUClE/h4Ip1ED3FboNJDK6dA8W6JKr3C1aDSK10dKp3udoJKZ5SdIWWXSOfR+W5nqPQqdwuo9OqyP6QP00fcLaHSR8u9Z/o/+gxdTJfQpe5WUOnb7tZQ6TvuNlDpu+VeMn2Pvk8/oCvpKrqarinNurnbQmXdy9Rn7ADZSLcLVDbK3Q4qYwfIDna3h+L6Z8X1z4ozl8rYDbKZrgGVzSq7QTabLqSL6K2uCZXd5lpQ2e2uDZXd4TpQGbtB9oBbvsITfPwkZTfI2A2yJW7591laZj9jB8g2uFWodqa+fbO7A1STqW92crtCNTu73aCaTH1zhNsdqjnS7QHVHFUmnVOYajL1zePpCfREepLbE6p5stsLqnmKuyNU81S3N1RzstsHqjkFs6GaU+lpbl+oJlPfvJBeRC92+0E1p7n9oZoNdyeoZps7AKrJ1DevolfTa+i19DrXh2pe7wZQzRtcAcU7jKvmE66Eaj5Z7mGb7a6C4sSnmsvpCjeEar7Ox8x+k9lvrnEjqObaMvVNZp+rkVXHBrcG1bHRHQjF1ciq4wu6yR0E1cHsd3zjDobq+NbdGarjuzLFHd+7u0B1/ODuCtXxo7sbVMdP3P4z/YX+Spn9nNnPO7lDoPLO7lConNnPt6Rb0a0ps59v5w6DyrevnAyVG9SkFrXd4VC5446Ayl13JFTewx0Flfd0R0PlvdwxUPmO7u5QeW93LFTex61D5X3dVqi8X7k3z/vTnehYN4bi7KlyZj+P3QQqT9w9oHhWV/G6UopzqOJ1pRSvK6Xyg909ofJx7l5Q+Xi33DLB3Qcqn+j+Ayqf5Jb/lkPK1HPSVJw0Fe8eovLp7r+g8hnuv6Fypj5n6vP59CrK1OdMff6Iuy9U/qi7H1TO1OdMfb7Y3R8qX+L+H1S+tNzj896IivdGVPm77n+g8vfc8je+7x4IlX/gHgSVr6Sr3IOh8tXuOKicqS+6ueOhCqa+YOqLke4EKH47WPHbwao42H4SqhjnToQqxruToDhFqoKp53pjVcxyD4EqZruHQhVMfbHIPQyquNU9HKq4rUxxcTu9gzL1xQOUqS+edI+A4hWcVLHYPRKqWOIeBcWr+6riM/doqGKDewxC/OkeixCb3eMQ4i/3eIT4G5ci5NQZVjSq007uCQgrnd0TEXLqDCtb0q3oCPckhJWR7skIK6PoaPcUhJUx7qkIK7tjHMLKZHcywsoUdwrCylR3KsLKaZiNsHK6exrCyhk4CmGlzT0dYaVMd1i5wT0DYeVG978IKze5ZyKs3OyehbByC65FWFlAF9JF9FZ6G72d3kHvpHfRx+jj9An3bISVshuElXb3HIScW8NKB83pa3QZXe6ei7Cywj0PYWW1ez7Cyhr3AoSVte6FCCtlZwh5bjds2eRehLDlS/dihC1fudMQtnztNhC2fOO2IWz5vjIBYcsPboqw5Uc3Q9hSdoBQ02kn2tm9BKFWJj3Utqbb0G1pF/dShNp27mUItTLpoeZQ170coea5VyDUqu6VCLUd3OkIta7uDIRaN3cmQq27Owuh1sOdjVDrWRmKUOtN+7hzEGp93bkItX7uPITaWHc+Qs68odbqXoVQi92rEWrjK4ci1Ca41yDkbBtq091rEWoz3OsQcrYNtVl0Nn3UvR6hVuYu1J6iT9Nn3BsQaovdGxFqq92bEGpr3JsR6jvgVYR6V/cWhJw3Q32kuwChPt68HKE+wV2IUJ9VcRHqs91FCLlOOOQ64ZDfrg25TjjUn3BvRag/6d6GUH/KvR2h/rR7B0L9GfdOhPpi9y6E+idYj1D/1L0bof6Zew9C3hMw7LRFZUuEY8t9Yjh2s3svwrF/ufch5JnBsN7i3o+wrrkPIKzr7oMI653chxDWO7sPI6xv4T6CsL6l+yjCOtNRH0FH0lF0NB3j/g9hnemoT6ZT6FT3MYR1pqN+uvs4wjrTUb+hzEX9RnoTvdl9AmH9FvdJhPUF7lMI60xEfZH7NML6re4zCOu3uYsR1m93lyCs3+EuRVi/030WYZ2JqDMR9cfd5xDWn3DbEdaZiHq7W75+0+1AWO9wc4Q8CxnWX3MLhPVl7vMI68txOsL6CrqarqFrS3mOMmzd5L6AsPVL90WErV+Vtd36Nf2GMgutP9AfKbMQMwtxJ/clhHFn92WE8RbuKwjjrd1XEcbbuK8hjJmFuIu7DGG8XVn/MbMQMwuxSz1apTvQrrQb7e4uRxj3cFcgjJmFmFmI+9C+tJ/7OsJ4rPsGwrjuvokwbnXfQhjHlb0RxsxCPIEyC/F0922E8Qz3HYTxTPddhPEs9z2EPAcXxo+67yOMmYX4KfcDhPHT7kqE8TPuKoTx4konhPFqusZdjZBXbQqTru4ahMkIdy3CZCQ2IEzG0wnuOoQJs5DMdj9EyPNlYbLA/QhhstD9GCHX94bJE+56hMmT7icIk6fKmk+eps+4nyJMFvPxJ+5nCJNP3fKVPytTwLv1hXtsUbERcioMp212NyKc9pf7OcJpf7tfIGzA3YSwUXG/RNhocb9C2NCwP8KG7n6NsNHJ/QZho7P7LcLGFty+pfsdwsZW7vcIG1uXKWhsQ8e4PyBsMAuNqe6PCBunuT8hbJxepqBxBrff4v6MsLGgrPzGQvcXhI1F7q8IG7dzyx30Tvc3hA3WduNueg+9l95HH6QP0YfpI/RR93eEjf+5fyBsPOb+ibDxuLsZYaPD/Qthg/XfeIW+WlY4z6OFbZvol+7fCNtY4WlnDwjTLbwKwnRLrwVhupWnIUy3Lqsu3cbTEabbep0Qpl28zghTVm/K6k0NalKL2pRVnbKqU1Z1yqpOWdUpqzplVafdvS0Qpj28LRGmrOqUlZz287ZCmPb3tkaYlsdvYTrW2wZhWve2RZi2el0QprG3HcI08bZHmO7hGQjTPT0TIc+1helRnoUwPbqs83QWZVWnD2I5wvQh+jB9hD7q2Qg5Z4XpY56DMH3ccxGmT3gewvRJr4owfcrbAWH6dKX8uz1DF3tdEaZLvG4I06Ved4Tpaq8HQs5WYWZQk1rUpg51vZ4IM8/rhTCrejsizJigrKvXG2HWzeuDkHNZmI3w+iLMRnr9EGblEVrIuSzMxtDd6Vhap600pgndgzKJ2QSvP8KMScxmezshzOZ4AxDyfFmYXUev93yE2Q1egDC70RMIs5s8iTC72VMIs1u8EGG2oExr9pQXIcyY0Gw1XUPX0nVeDWH2IR9/RD+m6+kn3kCEXH8btv/pDULYvtkbjLD9rzJ97X+XNkErtIUys02ddqKdKTPb3JJu5e2MsLm1twvCJjPbHOPtirDJzDan0tO83RA2T+djZrZ5S5nKJjPbXEgX0du9IQibd3hDETbv9IYhbDKzzbu94Qib93gjEDbv5WNmtvkgHz/E7cxs8xFvJMLmo94ohM3/lfus5mP0cdrhjUbYzL0xCJtMa8cX3u4IOzZ5YxF2fFnmtINpzTuXqcm3oFvSrSjTmm9Dt6Vd6HZeHWG+vdeKMDe8GGFuegnC3PL2QJjb3p4Ic8fbC2HuensjzD1vH4R51fsHwnwH758I867evxDm3bx/I8y78zV7ePsizHt6+yHMmda8H+1Pd/L2R5iPLTOb12krjWlC96B70qPKbObMac6E5kxo/pD3fwjzh73/IMwf8cqfPuodiDD/n3cQwvyxMn354/QJ+iR9ijKh+WK6hC71DkbIay6F+RpvHMLC8MYjLExvAsLC8iYiLGxvEsLC8Q5BWLj4BmHh0ap3KMJiB+8whEVX73CERTfvCIScnkJOT2Ex0jsSYTHKOwphMdo7GmExxjsGYbG7dyzCYqx3HMKi7h2PsGj1TkBYxDTxTkRYMJsFs1lM8E5CyG9xhsVs72SExRzvFITFXO9UhMV13mSExfXeFITFDWX6ihvpTfRmegtlNounvKkIC2azYDaLNd5pCIu13ukIi3Vl7ooPvTMQFh95/0VYfOydibBY752FsPjEOxthUWYzwu+4FBH+8M5BhD+9cxFhM/ZHVNnaOw9RZRvvfESVbXEkokoXuh3dnhrUpBa16Sg62rsAUWWMdyEiTl5RpcxdVFlEb/UuQlS5zbsYUaUDNyCq5N40RJXCayBq+cJrQ9SyyUsRtZTHbFFLmZFI287LEGnbe5cg0ozKIYg0k3aj3b1LEWk9vMsQaT29yxFpZd1G2k7eFYi0Ad6ViHgeLdICKqikipb1GWkPedMR8S4hEa8TG+nbezMQ6YY3E5FuerMQ6WX9RHqV7kC70m7ebER6WT+RntA97McR6Xt6cxDpe3lzEel7YwMifR863puHSJ/gzUfEM1aRPpPO8q5CpM+ubINIn+ddjUif712DSL/KuxaRfrV3HSL9Gu96RPq13g2I9OvcwxHp1/P5T3k3ItLL2oj0JXQpXUlXeTch0ld7NyPS13i3IBrLGhj7h7cA0dg/y3d/LGugvjXdxluIqM4aqHfxFiGqb+fdiqi+vXcborrh3Y6obnp3IKpb3p2I6rZ3FyLOF1F9tHc3ovqY8t3nfBHVF3r3IKov8u5FVL+1fN/rt1HWQD2nBa5CxBWGEY/eo9ZN3n2IWr/07kfEo/eIR9pRvL33AKLY8B5EFLMSYlZC3J328B5CFPf0HkYUsxLinegA7xFEse89iigOvP8hioX3GKJYeo8j4oq+KGYlxKyEuMN7AlGce08i4vVOI17vNOL1TqPE9J5ClLAekqr3NKJkB+8ZRElXbzGipFtZAwnrIWE9JHvQPeledG9vCaJkH28pomS89yyiZEJZAzwjEyUzvecQJbO8dkQJ6yGZR+fTq+jV9Bp6Lb2Osh6Sp7wmIh5pR8kSrwMRV5pFXGkWJavoai9HlKwpe8K0n8t6mPaLVyCa9qu7CdG037znEU373XsB0bQ/vBcRNVgbjW28lxA1tvVeRtTowsfbea8gamzPn1rU9l5F1Chnz6gxkrI2GqO91xA1xnjLEPF4O2qU++ioscBbjqix0FuBqLGorAce30Ztm7zXEbWVe8yIx7dR29e0nOCi1PDeQJSyBtIe3puI0p7eW4jSXuU7nu5IAyq8txGl0nsHUcr3Og1pRIfR4XQEHUlH0dF0DN2dsk5S1knaUdkKUZp77yJKC+89RFxnFfHanlG6xnsfUbrW+wBRus5biSj9sKwfXtsz4vFklJneKkSZ561GlFW9NYiyHby1iLKu3jpEGWuJx41R1oP2pL3ojvQf3oeIsn96HyHK/uWdgCj7t/cxIq6tirJx3npE2XjvE0T8nD/i5/xRNtP7FFE2q6yWjDXGNVRR9rT3GaLsGa/8s4u9jYiyJd7niPi5fZSt9r5AlLFm2lkz7b+U73j7r/Q3+jv9o7TJmmluQ7elXeh2lDXTZM00WTNN1kxzpLcJUXOU9yWi5mjvK0RN9hMe70XNW7yvETUXeN8gai4sq6XJmuFn4FHHJvql9y2ijq+87xB1fO19j6iDNZMb3g+IctP7EVHew/sJUd7T+xlR3sv7BVHOmslZM7nwfkWUS+83RLnyfkeUh94fiHLWTD7M+xNRPtzbjCgf4f2FKB/p/Y0oH1UFonx0tYIoH1NtQZTvXtUQ8SgoylkzOWsmz2lR1RHlz1c7IeLxTJSvoWvpumpnRPmH1S0Q8RqSUcGaKczqloh4PBMV3B8V3B8VXatbISq6VbdGVHSvboOo6FHdFlHRs9oFUdGrrKiCNVP8g/6T/ov+u7odIq41iopx1e0R8Zr/ET8ljvgpcVTMrBqIillVE1Exu2oh4pqiiGuKIn7qG/G6/RG/iRMVS6s2omJ11UFUrKm6qKGsmRrKmqlVLFq+77VK+b7XKiOrHmr8Zkqt5atqFbWWr6s7oNZSvnc1zap2RU2zq91Q05zKIahpLu1Gu9MetGe1O2par2oP1LTyPa1pARXVnqhpsnI4apqq9kJNC6s7oqZF1d6oabVqH9S0gdW+qGmDKiNR0wZ7f6Km7Vzth5q2C7fsSnejQ+jQan/UtGHVnVDThvOVx1QHoKbtXvVR08ZWA9S0Ol+hlc+fWjkANV63oaadTs+gZYXUeBxS04rKVqhpz1cFatoLVYma9mKlE2raS/Rl+gp9lb5Gl9HldAV9nb5B36RvVRVq2tvVEDXtHW5ZS9dVI9S0D41vUdPKSqvpBjWrNdR0qzoQNd3GN6jxyKfGI5+a3o12pz1oT9qrOgg1fcfqYNT03tWdUdP7VHdBTe/rrUNN71fxUNP3re6KGq+OWNMPru6GGtfw1Pj5ak2fXR2CGu+SXNOXVoeipq+uDkON362odXIqNmqd3EoVtbGsqLGsqDorqs6K4iefNX7fodbKimr9qjS2KiejFtvV4ajFTnUEajGriEcRtbh7dSRqcY/qKNTintXRqMXl3qQWs4piVlEsqmNQi1lFsaIhjWiNDqSDqrujFg+ujkUt3rmsiniXah21eNdqK2rxbtUYtXhINUEtHsrnD6OsongM3Z2OpXXaSqdW90AtPq26J2rx6dW9UIvPqO6NGo9hajyGqcWsovh5+kJ1H9TiF6v/QC1+qfpP1OKXq/9CLX6l+m/U4ler+6IWv1bdD7V4WXV/1OLl1f9DLV5R/Q9q8ev86Rv0zeoBqMVvVQ9ELX67Wv5/vlM9GLV4rTkNtXhddRxqcbmPq8WsIh4v1Xh9+FpiVcejlrCKElZR0rU6AbWkW3Uiakn36iTUkh7VQ1BLelYPRS3pVT0MtWTH6uGoJb2rR6CW9KkeiVrSt3oUakm/6tGoJftWj0Et2a96LGrJwdXjUOPKlloyq3o8asns6gmo8b7DtWRp9UTUktXVk1DjMU9tD1bRHm71ZNR4/FObxiriMUyNxzA1HsPUGqyixvzqKai1vVQ9FbW2l6uTUWt7pToFtbZXy7rip2S11K1ORS31ylpKq7Rb9TTU0u7V01FLe1TPQC3tWf0vammv6pmo8SillvamfaisnoVaqqpno5ZOrZ6DWnpaxUAtPZ2eUT0XtfS/1fNQS8+sno9aelb5vvMuVLX/5+i+w5yo8z+Av7MTQIoFC5lu75rpyUzU0+zEu/td8e5UsAH2XpHelOwMS1Wxe714nr0B9kb2OyBYwYKKBSxIr6J0/D3z/uf15JnNrvgp3zIzmfBbqMrJ6NI4lPktVOVkLB1Hx1NWCFcv5eSjvDaSj0vjUU4Wl25BmU9mK3P1UuaTycvJV6VbUU6WliagnPyU5zHZSrfR7XQH3ZnLVU2ZZ8nK6f6lJsopM54y46lS6kA5VUsJyqlWSlHmqqbMVU05PYQeWpqIcnpYqRPl9PDSJJTTM3j8D6XJKKd/zEeM9E90QGkKyryToZxOpdNKU1FOp5emoZzeVpqOMlc4Za5wyvykeTl9rXQbyunrpdtRTt8o3YFyOqc0A+W0hW9RTruooIvpJ/SL0p0op1+W7kJ5Yr+8ciaWSnejzFVQmaugMlcyZa5kylzJlFusnFZn6R6UW5PQQrl1F727dC/KXfMLZ6DctYC+VboP5a63S/ejLFhL4qDSAyiLfqU/oyxYS0It/QVloRUGoCx0alCz9FeUxcGlv6EsDsnrSrCWxHH0+NLfURasIsEqEkPpMDqcjij9A2UxsvRPlMWo0r9QFqNL/0ZZjCn9B2UxtvQgymJc6b8o837sMtczZa5nyoJVJD6mi0sPoSxYRVzPlMWXpf+hLL4qPYwyn4ldFj+VHkFZbC09irLYVnoMZbG99DjKYkfpCZTFztKTKHOdU876lp5COdu/9DTK2QGlZ1DmOqeccfbJOPtkWj7XZHrpWZQzozQT5YxVlB1KD6OccTJWUfaHA55DOftjaRbKGasoYxVlrKJsamk2ytm0Uv6e6fgc5ey20vMoZ7eXXkA5YxVxbVPmdxKV+Z1EZX4nUZnfSVTOWqUXUc66Si+hnInSyyhnrKLsk9IrKGdflF5FmU/cKs/Nq8jiisjC1tJrsLgisrgisgonl16HxbumrUKKS2AVJtLO0huwCnktWYW76N30ntIcWIV78RdYbe+UWrDa3i11wWp7ryRgtb1fymC1LSz0h9W2iH5Qmgur7cPSPFhtH5XehNX2MY8vpp/kSv1K82FJpdICWJLcbyIsSSm9BUtSCwNgSRo16cGlt2FJh5TegSUdWnoXFldclnRs6T1Y0nGl92FJx5cWwpLGlUbDksaXFsGSbil9AEu6tfRrWNKEwlmwpCbt4JGk9CEsKeWRibSTTqJ5BVpcL1nSi4WesKSX6Mulj2BJr/D1q/Q1uph+UvoYlvRpaTEs6bNCN1h8arQlfV76BJb0RelTWNKXyP9f1tJ1pc9gSetLS2BJG0qfw5I2lr6AJW0qfQlL2sz3/EC30B9LX8GSfiothSVtRf43d9CdpWWwpF2lr2FJu0vfwJL2lL6FJf1c+g5WEaXlsIqF0vewim2lFbCKUmklrGKxtApWsRt/2r20GlaxR2kNrOJepbWwij1L62AVe5XWwyr2Lm2AVexT2giruHf+LynuV9oEq9i3tBlWcf/SD7C4frOKamkLrKJW+hFW8Y/9DoNV/FPpJ1jFM5H/d8+iZ5e2wir2L22DVRzAI+eUtsPitwtZ/ByuVTyfXkAH0kF0ML2QXkQvppfQS+ll9HJ6Bb2SdtJJdDKdUtoBqzi1tBNWcRo+h1W8nd5R2gWrOKPQG1bxTjqHtkq7YRW7SntgFUXpZ1j8HluLT6u2iovpJzJgFT+VC7CKn8ltsIpLZAlW8XO5CKv4hdwNFteZFteW1mlb5e6wuLa0uLa06ifLPWDV2Zt19mZ9orwXrHpn3ol19mb9rrwT63fTeyh7s/2dwhmw2t+l79H35Z6w2hfKvWC1L5J7w2r/QO4Dq/1D/vQjeW9Y7R/L+8BqXyzvC6udvcnryxavL1uxLO8HK1bkvrBiVd4fVszejE35AFjxwfKBsOJD5INg8fqyxXWsFR8r94MVHyeXYHEda8XjCvnfGU9vkWVY8a2yAiueIKuw4qaswYo7ZB1WnMgGrDiVTVjxRPlgWHGnfAismL3JVajFVagVvygfCit+ST4MVvyyfDis+BX5CFjxq/KRsGL2ZszejD+hn8pHwYo/k4+GFS+Rj4EVf57Xc/wFZW/Ga+VjYcXr8s6K19MNdCPdJB8HK94sHw8r/kE+AVa8RT4RVvyjXIYV/8T3sDdj9ma8k+6iu+ke+nNuA7RA26hEi7Qb7U570L1oT9qL9qZ9ZAtWY2/ZhtXYT3ZgNfrm/djYn7I3G2qpA1ZDk11YjT8W+sFq/En2YDXO5Ouz6NmyD6vRXw5gNQbIFViNc+QqrMa5cgircZ4cwWqcL9dgNS6QT4LVGCifDKsxSD4FVmOw/AtYjQvlU2E1LuI7L5ZPg9W4RK7Dalwqt8NqXCbHsBqXyw1YjSvk02E12JuNTvmXsBqT5F/BakyWfw2rMUX+P1iNqXk/NtibDfZm4w75N7AaM+TfwmqwNxvszUaLdlEh/w5WI5N/D4tPw7Yai+UzYDU+KUiwGp/Kf4DV+Ez+I6zGEvlPsPjsL6vxBWVvcsVuTdgqnwlrwjb5LFgTttMddKd8NqwJu/KenbA7t1mk3eh+tC9lX3O1bzVPlvvD4mdgrWZH3tfNRB4Aq5nK+ZGJeV93PJF3ZceT9Cn6NP2IfiyfC6tjsXwerI5P5PNhdXwqXwCr4zN5IKyOJfIgWB2fy4NhdXwhXwir48v8txJFvghWkq/orMSQL4aVmPIlsJKDC7mHyJfCSg6VL4PF6+lWcjg9gh4pXw4rOUq+AlZytHwlrOQY+SpYybHy1bCS4+RrYCXs7qRGT6In01PoL+iptFO+FlbCLuY9z1Yyhf4n7+jkQfpf+pB8Hazkf/L1sJKH5RtgJY/QR+UbYfFZVVbyOOVowB2HlczOezx5jj5PX5BvgpW8KA+Blbwk3wwreVUeCit5TR4GK3ldHg4reUMeASuZw/e36KfySFjJZ/IoWMnKfExIVtHVdI08GlayVh4DK+EokWyhP8pjYaV98tfp3vI4WOk+8nhY6b7yLbDS/eRbYaV95Qmw0nxtaaWa3ISV6nIHLO5QrPRs2l9OYKUD8mpPz6FX0CvlFFZ6lTwRVnp13jXpNfRaeh29no6gI+koOpqOkTthpWPlSbDScXm3puPlybDSW+QpsNJb8z5NJ8hTYaVN2iFPg5Um8nRYacrXE+XbYKWdeW+mk/ivmiHfDiu9U74DVnqXPANWenfeg+k99F56H72fPkD/TP8i3wkr/StfZ/JdsPgMaitdSBfRD+iH9CP5bljpx/I9sNLF8r2wUnZ0ukS+D1bKLuaOyZqzlW6j2+kOulO+H9acXfIDsOawc1tF+c+weG+A1WLntvrKf4HV2l/+K6zWAfLfYLVOlv8Oi/eEWy12biuR/wGrleY922LndrFzu56U/wmr6yn5X7C6npb/DauLndv1MV1MP6Gf0s/oEvo5/SKX+y9LGPJ/YAkz71PBbhWH0EPpYfKDsMTh8n9hiSPkh2CJI+X/wRJHFQbDEkfTY+ix8sOwxHHyI7DE8fKjsAS7VZwkPwZLnCw/DkucIj8BS7Bbxanyk7BEp/wULDFJfhqWmCw/A4v3KluC3SoelJ+FJf4rz4QlHpJnwRL/w0JY4mH6CH1Ung1LPCY/B0s8Lj8Pizs7SzwtvwBLzJZfhCWek1+CJZ6XX4YlXpBfgSVe5O++RF+lr9HX6Rvyq7DEHPk1WILdKj7Nu1J8RtmtYpX8OiyxWn4Dllgjz4El1sotWILdKtit4sfcjN2a7U33ofvKXbCy/WQBK+srZ7C4E7QyTZ4LK9PzDs3YrRm7Nesvz4OVDZDfhJWxWzN2a3YlvUqeDyu7Wl4AK7smnxOza+W3YGXXyW/Dytit/CYjKxspvwMrGyW/CysbLb8HKxsjvw8rG8u/M46Op7fQW+kE2qQdNKEpnUg7Kbs1m0HvpHfJC2Fld8uLYGX3yB/Ayu6VP4SV3Sd/BCu7X/4YVvaAvBhW9mc5j8xf5E9hZX+VP4PFbziysrnyEljZQjn/a4vkL2BlH8hfwso+lL+ClX0kL4WVfZx3aLaY5t1qF7qjE3ahR9+NsHlm3i6cRE+Wl8HmntQuDJO/hl0YLn8DuzBC/hZ2YSQdRUfTMXQsHSd/B7swHpfALtxCb6UTaJN20Lxb7bYn5OWw254s/AZ221P0afl72G3PyCtgtz0rr4TdNlNeBbttlrwadttseQ3stufktbDbni+cAbvthVwp71NbMuR1sKW8N23pUHqYvB62dHhhMGzpOHq8vAG2dELhQtjSidSmDnWpR0MayRthSzV5E2zppMLpsKWT6SnyZtjSL+QfYEunyltgSyMK+8GWRtJRdDQdQ8fSyXSK/CNsaar8E2xpmrwVtjS9cBZs6TY6g94pb4Mt/VveDlv6j7wDtvSgvBO29BgWwpYel3fBlp7Ae7ClJ+nMQk/Y0ix5N2xptrwHtvQc3/k6fUP+GbY0RwFsqaUUYEufKm2wpc8UCba0RCnClvIVuC19R5fT7+kKpRtsaaXSHba0SukBW1qt7AVbWqPk/8V8ZW5L+crcLvaivWkfpRfs4t5Kb9jFfZQ+sIv7KnvDLqrKPrCLmrIv7OIAZT/Yxbxb7eJ1Sl/YxevldtjFG+QYdvFGuQG7eJOyP+ziEOUA2MWblQNhF4cWDoJdHEaHy7+EXRyh5K9HKv1gF+/jX7tfKcEuPqDIsIt/VhTYxbxT7OJcRYVdfAvfwi6+rWiw+d1GdvFdulDRYRcX5d1RZ3fU2R31mmLA5lUGu34yZXfUh9Hhigm7PgLDYddH0lF0NB1Dx9JxysGw6+OVQ2DXb6G30gnKobDrTfwFdr2DJjSlE3Pbn8i7o52d0s5OaX+aPkOfpTPpLDqbPqccBrv9eeVw2O0vKEfAjo28L2L2SHyociTs+DDlKNgxeyRmj8THK0fDjk9QjoHN6x12bCvHwo4d5TjYsascDztmj8ShcgLsOFJOhB3XlDLsmD0Ss0fiU+gv6Kl0hGLBjkcqNux4lOLAjtkj8RjFhR2PVTzY8WTFhx1PUQLY8VR8CDueplRgx9OVKuyYPRLPUELY8Z1KBDv+T17h8YOU3RE/Tp9QarBjdkfM7ohnKSfBjmfzp+yOmN0Rv0HnKCfDjlt856f0M7pEOQU296d2zO6Ilyu/gB1/r5wKO16hnAY7XqnUYcerlHbY8eq8/uM1dL0Sw+a+1W70UhqwG72V02E3+ii/hN3YGxtgN/ah+1JV+RVs3lViNwYov4bdOEf5P9iN6/KqblxPb6A30pvoEHqz8hvYjaHKb2E3him/g90YzuMj6EjK7mjcr/weduMB5QzYvJPEbrA7GnOVP8BuvKX8EXbjbeVPsBvvKGfCbryrnAW7sVA5G3ZjkdIfdpPd0WR3NGvKANi8emI3hym5w5VzYTdHKOfBbrIjmuOU82E3x+eV3MHK7HhOuQB2x/PKQNgdHMM7tiiDYHf8WDgPdsL6TA5VBsNODlMuhJ2wPpOj6NH0GHosZd0mxysXwU5OUC6GnZyo5H+hrFwKO7GUy2AntnI57MQpVGAnrnIF7MRTroSd+MpVsJNAuRp2UlGugZ1UlWthJ6FyHewkUq6HndSUG2AnrO1kBB2p3Ag7GcXXo5WbYCdj+HqsMgR2Mk65GXYyPq/t5BZ6K51Am7SDJspQ2EmqDIOdTFSGw046lRGwk0n8KeeLZIoyEnYyVRkFO5mWd0QyXRkNO7lNGQObd0rbyR3KWNjJDGUc7OROvucJZTzs5EnlFtjJU8qtsJOnlQmwk2eUJuzkWaUDdjKTzlIS2MlsJYWdsC+SOcpE2ElL6YTNu1/sRNCMzlUmwU7mKZNhJ2/yyBJlCmx+UtXmJ1Vt3k1tJ1/RpXQZ/Zp+Q7/NZ5bkO2Uq7GR53kfJysK+sJNVyjTYyWplOuxkjXIb7GRt3kHJOuV22Ml65Q7YyQZlBuxkI49vyk3bqESLtBvtTnvQvWhP5U7YaS/lLthp77zv0n60RGWqKHfDTlXlHti8M8dOByj3wk7PUe6DnZ6b91F6HmVPpfcr98NO5ykPwOY3xtrpfLpA+TPs9J18TknfVf4CO31P+Svs9H3lb7DThcrfYaeccVrsqRZ7qlVT/gGb15Xs1jDln7Bbw5V/wW6NUP4Nu8WearGnWuyprtnKf2B3Pac8CLuL66Iu9lTXlryb+AlQm3sWm3sWWxym/Be2YE9xn2KLo5WHYItjlP/BFscqD8MWxymPwBbH5/0iTqAn0jK1qE3ZU8KlHvVpQCu0SkMaKY/CFrW8XwR7SrCnxEjKnhKjKXtKjKXjlMdgi/HK47DFLcoTsMWtypOwxQTlKdiiqTwNW3Qoz8AWifIsbJEqM2GLicos2KIz7wgxSZkNW0xWnoMtpijPwxZTlRdgC/aUmE5vo7crL8IWdygvwRYzeIQ9JZ7Iu0M8SZ+iT9Nn6LN0Jp1FOdcI9pSYQ1vyUNiiS3kZthA8kimvwBZzlVdhi3nKa7DFm8rrsMUS5Q3Y4vO8I8QXyhzY4kulBVt8RZcqXbDFMkXAFl8rGWzxjTIXtvhWmQdbfMffWk7ZU2KV8iZssZqv1yjzYYu1ygLYYp3yFmyxnsc3KG/DFhuVd2CLTcq7sDP2VCYp78HOisr7sLNuykLYWXdlEeysh/IB7Gwv5UPYWU/lI9hZr7x3MvYU71yys5LyMexMVhbDzhQl/2tq3k28E9vOBtBzlE9hZ+cqn8HO2FMZeyq7X1kCO5un5K/fVL6Anc1XvoSdLVC+gp2xp7J36Xv0fbqQ5j3lcI/jcI/jcI/j8Pm6TuEmOoTeTIcqS+EUhinL4BSGK1/DKYxQvoFTyHvNKeS95hTyXnPaZivfwml7rnAGHOkw5Ts40uHKcjjSEcr3cKQjlRVwpKOUlXCko5VVcKRQWQ1HipQ1cKRblLVwpFsLOhwpVdbBkSYq6+FIncoGONIkfAhHmoeFcKQ3lY1wpPnKJjjSgkJPONISZTMc6XPlBzjSN8oWONK3hX3hSN/R5cqPcKTvlZ/gSCt4ZKWyFY60StkGR1qtbIcjreHxjXQT3azsgCP9oOyEI21RdsGR8v24w+tZjrST7qK76R76c26xTdkNpygpe+DwrmmnWFJ+hlM8s9APTvEsFXCKZ6sFOMX+ahuc4gBVglM8Ry3CKZ6rdoNTzHPtcMXuFO9Xu8MpPqD2gMO7nR2u2J3iXDpP3QtO8U21J5zifLUXnOKCPL9cpTtcpTv1mtobDlfpTp35rQ9R+8Cp35xnsD6UDqPD6QjK/NaZ3zrz2z5b3RtOO/MbH1a4BE58uLoPnPgIdV848ZGFCpz4KHo0DQunw4kjeou6H5yY+Y1TOlHtCyfuVPeHEzO/MfMbv0nnqwfAiZnfeIl6IJw473cn/oYyvzHzGy9XD4ITf6/2gxOvUEtw4pX4Gk68SpXhxKtVBU7M/MbMb7xJVeHEm1UNTvyDmv9LtuRZi5lfXhNx4p2qAYfXRJx4t2rCifeoB8PhNRGn0aYeAqch5ZnlXdBOo6QeCofXKZzGWephcBpn57lr9KcD6Dn0XMr8cs3pNO5XD4fTeEA9Ag7XnE4jU4+E05irHgWnMU89Gk7jzTynjfmU+eU60+E60+HzLZ1mlYY0ojX1GDhcfzpN5r3JvHPN6XDN6XTkOyOnYxadrR4Lp4P55TrTSQ6lh6nHwUkOV4+Hk+RzlpPcop4AJ2E2kwm0STvVE+Ekk9QynGR+nsdkgWrBSZbkeUw+V204yRd5FpIvKbOZMJsJs5ksVx04yfd5BpMVlNlMVqkunGS16sFJ1qg+nGStGsBJ1qn5v2e9WoWTbFBDOMlGNYKTbFJrcJKf8nwlW+k2up3uUE+Ck+xUT4aT7FJPgZPszvOY7FF/ASf5WT0VTgr1NDhpQa3D4SrISUtqO5yUWU7PUmM46Yw8g+mdagNOepd6Opz0bvWXcNJ71F/BSe9Vfw0nvU/9Pzjp/epv4KRz8vymLdql/hZOKtTfwUmzPMvp3DyzXO04XO04fCqj06qqv4fTCtUz4LQiWlP/AIerIIef/3JaQ9Q/wmmNU/8Ehysfp4uZ7Zqlngmna7Z6FpwuZparHUccqp4NRxym9ocjDs9zKip5n4oqvUUdAEfcqp4DR0xQz4Ujmup5cESnej4cMUm9AI5gfsUCdSAcwfyKz9VBcMQX6mA4gvkV36gXwhHfqhfBEd+pF8MRy9X8v/69eikcsUK9DI5YqV4OR6xSr4AjVqtXwhFr1KvgiLXq1XDEOvUaOGK9ei0csUG9Do7IV7aO2ESZX7FVvR6O2KbeAEdsV2+EI3YoH8ERO/OsiV2U+RV76M+5GWiBMr+8l9jJzlRvgpOdpQ6Bk81Qb4aT3akOhZPdpQ6Dk92tDoeT3ZNnMLuX3kfvp8xv1lJHwMm68mxmgmY0z6/LmdflzOvy+YFuodp3E1w+8d7lE+9dPvHe5YzsckZ2C0PUkXAL49RRcDnbum3PFH4Dt+1ZOpPOorPV0XA5C7uSWajAlQ5Wx8Dl2T9XOlQdC1eaUDgdrtRUx8GVOtTxcKWkoMOVUvUWuNJEvu5Ub4UrTVInwJUm40O40hQ6lU6jt9M76Ax6J81rw5UWKK/Bld5Sm3Clt9UOuNI7hZ5wpXfpF2oCV/pSTeFKXxX2hSstpcvo1/QbdSJc6Vu1E670Hb6GKy1XJ8GVvlcnw5VW4BO40kq6Sp0CV1qtToUrreGRteo0uNI6vl5PN9Cf6FZ1OtzimeptcItnqbfDLZ6t3gG3mI/YbnEAPYdOp7fR2+kd6gy4xRnqnXB594VbnKPeBbfYUu+Gy5nX5czr8ul2br2q3gO3Hqr3wq1HtKbeB5czsssZ2a0PUe+HW2d+Odu6PH/ltj+rPgC3fab6Z7jts9T8+Ow8s5yF3Zj5jQ9W/wo3PkT9G9z4UPXvcGPmN26q/4Abd6j/hBsn6r/gxqn6b7jxRPU/cONO9UG48ST1v3DjyepDcOMp6v/gxlPVh+HGzG98u/oI3PgO9VG48Qz1Mbgx8xszv/EC+hZ9W30cbvyO+gTc+N081/EXeZbjL9Un4cZfqU/BjZeqT8ONl6nPwI2/Vp+FG3+jzoQbf5tnNmZ+4+XqLLjx9+psuPEK9Tm48Ur1ebjxKvUFuPFq9UW4PIPkxmvVl+DG69SX4cbr1Vfg8mySyzsc3Hir+ircxpnqa3AbZ6mvw22cLc+D2+ivvgG3MUCdA5czsttgfhu3qS24jdvVLriNO1QBtzEjzyyv4LucbV3Otm6zphhwOau6nFXd5hA1g9scg+Fwm2PVuXCb49R5cDnDuh0z1TfhdszK89XB3HGGdZNQnQ83ifIMJvmOz+VZFDeZoC6AmzTVt+AmHYW+cJNEfRtukqrvwE0m5vFPOukk9V24yeS8W5Mp6ntwk6nq+3B5JsRNpqsL4Sa3qYvgJjPUD+Amd6r58XfyfCXvqh/BTd5TP4abvJ9nKllIF1HmLvlSXQw3+SrPS7KULqNf09V0jfoJ3GSt+incZB2PrFc/g5tsUJfATTaqn8NNNqlfwE02q1/CTX5Qv4KbbFGXwk1+VJfBTX5S89/aqtwFl9eX3bR/nql0gPoNXF5fdtPp6rdw09vU7+ByfnQ5P7qtmrocLudBl/Og2xqifg+3xVy0xqor4LbG5VngnOhyTnS7Zqkr4XbNVlfB5ZzocvftCuZC1NTVcLn7dsUEdQ1c0cyzIJgLkdBUXQtXTFTXweUO2hWT1PVwxWR1A1wxRd0IV0xVN8EV09TNcMV09Qe44jb+7gx1C1xxp/ojXMFciHfpe/JQuOJ99Se4YqG6Fa5gLgRzIb5Ut8EVX6nb4Yql6g64Ypm6E65gLgRzIdbQtZS5EOvpBrpR3QVXbOLrzfQHuoX+SH9Sd8MVW9U9cHn10M36qz/DzQZogMurh242XSvAzW4r9IbHuczjXOYV8pWnV4honguvMERrg1e4WZPgFYZqRXiFYVo3eIXhWnd4hVEYDq8wmo6hY7Ue8ArjtL3gcY7zOK95bbO0nvCkPDueNEHrBU9qar3hSZ1aH3jSJG1veNJkbR940hRtX3jSVG0/eNK0Ql940gwt905tf3jSXfgQnnQ3XagdAE9apB0IT/pAOwie9GEh/698RD+my7R+8KQ8wp70k1aCJ23VZHjSNmyAJ22nO2i+0vD42RCvqGgKvOIA9TV4xXM0FV7xXE2Dxz2dVzyfXkCn0mmaDq+Y7809zh0e5w6vznjWI7U3PM4RXn2IZsCr36yZ8OpDtYPh1YdhLLz6cO0QePVR2qHw6qO1w+DVx2iHw6uP1Y6AV8/r3+Oc4rXP1I6E156PRV6c17wXR9pR8DhfeHFTOxpe3JnHLZ5EJ9MpdCplVOMZ9E7tGHjxXdqx8GJGNV6IhfDiRfQDeSi8+EPtOHjxR9rx8GJGNV6mnQAvZlTjn+hW7UR48TatDC/erlnw4h2aDS/eqTnw+FkJr6HQAYV+8Brn0HM1F17jPM2D1zhf8+E1GFXekeU1pmkBvAaj2likVeBxDPc4hnvcJXnNSKvCa96khfCaQ7QIXvNmrQavOVQ7CV6TsW0O106G1xyhnQKvOVL7BbzmKO1UeM3R2mnwOmZqdXgds7R2eBzbPY7tXhJqMbwk3/l6CWObNLUGvOQuLX99t/ZLeMkTeZSSJ+lT9Gn6DH1W+xW8ZKb2a3jJLB6Zrf0fPJ6F9pKPlNfgJR9rv4GXLNZ+Cy/5RPsdvOTTPMLJZ3QJ/Zwu034PL/lay/9t+X7KS1bR1dof4CVrtD/CS9Zqf4KXrNPOhJfs4E93amfB41lfL2X80/PzyKcXaGfDSwdq/eGlg7QB8NLBeczTC+lU7Rx46TTtXHjpW3n807fpO9p58NJ3tfPhpe9pF8BL39cGwksXaoPgpYu0wfA4tnsc270Wc9SK+m6Cx7Hdaw2hN9Oh2oXwWsO0i+C1hmv5O0dol8BrjdQuhdcapV0GrzU6r3mO+V7XLO1yeF2ztSvgccz3OOZ7gjkSzJFoalfCE3fltS3upsyReFK7Cp54SrsannhauwaeeEa7Fp54VrsOnpjJd87SrocnZms3wBPPaTfCEx/xdz+mi+kn9FPtJnjiM20IPLFEuxmeYI7EMvq1NhSeYI7EKm0YPLFaGw5PrMFaeGItXaeNgCeYI7FTGwmPnwvwMqV0HryMOcouoAPpIG0UvGywNhpexhxlU7Ux8LJp+RieMUfZ29pYeNk7hV7wsnfpe/R9upAuKvSCzzHf55jvc//iF6o0pJE2Dn5hmDYefmG4dgv8wgjtVviFkdoE+Nyz+Nyz+G0ztSb8tnws8qWTtQ740imF0+FLE7QEvtTUUvhShzYRvpTPuT6v7/vS41onfOkJbRJ86Uken02f0ybDl57XpsCXXtCmwpde1KbBl17ib71MX6Gv0tfoYvoJXaJNhy/lufClr+hSuky7Db70tXY7fO5BfGk5/Z6uoCu1O+BLq7QZ8KU8R760TrsTvrReuwu+tIHv2UF3anfDl/I9qS/le1Kfs4ZfVLR74BfzfPnFQdq98IuDtfvgF/N8+cWp2v3wi3m+/GJXnoVivq/0i/Pom3S+9gD84gLtz/CLb2l/gV98W/srfM4pPucUn/sRv57vN/16vt/06xEdRofTEdrf4NdHan+Hzz2I3/6s9g/47TO1f8Ln3OHHJ2v/gs+r2D7nDj9u0g7t3/Bj5otXnP34ce0/8OMneIT5ipmv+Dn6PH2Bvqg9CD9+Sfsv/Phl7SH48Sva/+DHr2oPw49f0x6BHy/WHoUff6I9Bj9eoj0OP2a+YuYrXqo9AT/O16g+5xefewo/Xq49CT/+XnsKfrxCexp+vFJ7Bn68SnsWfsx8xeu0mfDj9dos+DHzFTNf8U5tNvx4l/Yc/Jj54nzkNxTtefgN5qsxSAX8xmDtBfgN5qsxVb4dfmOa9iL8xrw8X435dAF9i76dy1nJ56zkN/fKs9PsSXvR3rQP3ZvuQ/el+9G+dH96AGWWm8xyc0Se0+ZI7SX4zVHay/Cbo7VX4DfHaK/Cb47VXoPfzFcIPncrfsdM7XX43K34ycnaG/ATZjm5iQ6hN9OhdBgdTkfQkXSUNgd+MlprwU/GaF3wk7GagJ+M0zL4yXhtLvzkFm0e/ORW7U34yQRtPvyEVZR0aAvgJ4n2FvzkYe1t+Mkj2rXwk0e1d+Anj2nvwk8ez2smeZW+pr0HP3k9r7fkDTqHtugH9EP6Ef2YLtbeh598ouWvP9UWwU8+0z6AnyzRPoSffK59BD/5QvsYfvKlthh+8pX2CfxkqfYpfO6DfO6D/OQb7TP4ybfaEvjJd9rn8JPl2hfwE9ZVsk77En6yke/cRDfTH7Sv4CdbtKXwkx+1ZfCTn7T8+FbtG/jJNu1b+Ml27Tv4yQ5tOfxkp/Y9/GSXtgI+z1X6nJf9VNFWwk9Zh+kgbRX8dHJegekUOlVbDT/luMG51W/10NbAb+2lrYXf6kl70d60D92b7kP3pfvRvnR/bR38FiuN5yf9FiutxUprjaSj6Gg6ho7V1sNvjdM2wOdezO+aqW2E38VKEydrm+ALVppgpYkh2mb44mbtB/hiqLYFvhim/QhfDNd+gi9GaFvhi5HaNvhilLYdvhit7YAvxmg74YuxWARfjKPj6S30Vm0XfDFB2w1fNLU98EWH9jN8keiALx7Oa0k8Qh+lj1FWmmClidf0Anzxut4GX7yhS/DFHL0IX7DSBCtNfKh3gy8+yrMvPqaL6Sf0U/oZXUI/p1/o3eGLL/Ue8MVXPLJU3wu+WKb3hC++1nvBF9/oveGLb/nT7/Q+8MVyfW/4XBX4Yp2+D3zBShOb9H3hi836fvDFD9pw+GIL3/Mj/Ylupdvodj3/v9uh7w9f7NQPgC926QfC51lTn6sLP1P0g+BnA/V+8LNBegl+xkrLpugy/GyqrsDniiLgCiEo9NBVBIX98BCCQl9dQ1DYX9cR8IlJQcGlnm4gKOQVEhTG6iaCwjj9YASF8fohCNo2QkLQtkk/FEHbZv0wBG0/wEUgnVyoIJBO0Q9HIP2icDoC6VR6iX4EAulS/UgE0mU8cjm9gl6pH4VAuko/GoF0tX4MAuka/VgE0rX86XX0enoDvVE/DoF0k348AmmIfgICaRi+RCANpyPoSDpKPxGBNBqLEEgdNNHLCKS00BeBNJHO0S0EUl4ngdRFBc3oXPqBbiOQPtQdBFJeA4G0VM//f5fpHgLpax5ZS9fpPgJpvR4gkDboeTQ26lUE0iY9RCBt1iME0g96DYG0RT8JgfSjfjIC6Sf9FATSVv0XCKRt+qkIpO36aQikHXodgbRTb0cg7dJjBNJuvYFA2qPncfgZGxBwlRIUFf2XCIqq/isEfOJTUBwoz0NQHKT/GkExr4egOEX/PwRcdQR11kB9P/03COp99d8iqOfzVFBnDdRZA3VP/x2COmugPlb/PYL6OP0MBPXx+h8QtG/U/4igfZP+JwTtm/Pst7MGYtYAVyAB76ML4lP1MxHEl+hnIYgvzfMSX6afjSC+XO+PIL4iz2B8JY9fRa+m1+gDEMTX6ucgiK/Tz0UQX6+fhyC+QT8fQXwj33MTHUJZA/Fw/QIE8Qh9IIJ4pD4IQTwqz3vMGohZA3FCU30wgpg1EM+hLf1CBHGXfhGCWNBMvxhBzBqIP9AvQRB/mGc5Zg3ES+kyyhrgiiWI19H1+qUI4g36ZQjijfrlCOJNWI8g3kx/oFvoj/QnupVuo9vpDrqT7qK79SsQxHv4+mf9SgRc+QTciQcNVb8KAe/HCxoD9asRNAapQxA0WAONKfo1CPgdFgG/wyLgOidosh6a++nXIuC6JeC6JeC6JWgeqF+HoHmQfj2CZj/9BgTNEo+zTppe300ImuP0GxE0x+s3Ieh4uXAGgo5X6EZ9CIKOTfrNCJJGXg/J6fSX9Ff0InoxvUQfiiBhnSTMaTJcH4YgSfXhCJKJ+ggESaaPRJAwL8l79H26kC7SRyFIPtBHI0iYL874QbJOH4MgWa+PRZBs0MchSBjD5Gd9PIIU+i0I0kIeQ87gQarotyJIVX0CAt5PFXCXHaQX6E0E6UC9A0E6KI8qZ/YgnaInCFpFPUXQ6qZPRNDqrnciaPXQJyFo7adPRtBibFv761MQtA7QpyJoHahPQ9A6SJ+OoNVPvw1Bi7FtMbYtxrY1Tr8dAWfnoOtl/Q4EXRyBuzbpMxCI0/U7EYhf6nchEIynYDzFxfrdCMQl+j0IBOMpGE8xXL8XgUjzLhAT9fsQiEy/H4FgPAXjKd7XH0AgFup/RiAW6X9BID7IYygYT7FW/ysCsU7/GwKxPo+h2EAZT/Gz/ncEGfR/IOBVvIDzVMBPxweZqv8TAe+lCbgjDrIL9H8hyAbq/0aQMZ6cv4Jsiv4fVPis9Uqhm/4gKpyzKoW++n9RKeyv568P0P+HSuFAHj+I9qMl/WFUOJdVCnkMK5zLKoWxdJz+CCqF8fqjqLS9rD+GCme0Stsm/XFUpAv1J1CRLtKfREW6WH8KFc4vFWm4/jQqUqf+DCrSJP1ZVKTJ+kxUpCn6LFSkTJ+NCueLivSO/hwq0rv686hI7+kvoCK9j7WocI6oSOv0F1GR8rhVpDxulSL0l1ApFvSXUSm26a+gUpSwAZVikXaj3WkPKlOFqvqrqBS1fktRKQ7UX0OlmMewwjG/Upyiv45Kcar+BircpVbqjGe9mz4HlTrjWe+rt1Cp7693oVI/QBeo1A/UM1TqB+lzUan30+ehUi/pb6LCeaFSZzw5L1TqY/X5qNTH6QtQ4ZnMSvvL2BuV9lcoo9q+SX8LlXhwHsP4QnoRvZgytvFw/W1U4k4sQiWepL+DSjxZfxcVnuGsxJn+HirxXP19VOJ39IWoxO/mkYzfo4wtx94Kx95KvF7P/w5j2wAt0Db9A1Qakv4hKo2i/hEqjW76x6g0uuuLUWn00D9BpSHrn6LSUPTPUGmo+hJUGpr+OSqNgfoXqDQG6V+iwrG00phCp+pfodJgbDmuVprd9KWoNPfPY8vxs9I8kB5E+9ESlamiL0Olqepfo9LUeIRx5rhaaY7Rv0GlOVb/FpWOTP8OlQ5GtWOTvhyVZJj+PSrJ8DxuCaOXTKKT9RWoJIxekukrUUnm5pFJ5umrUEne1FejkszX16CSLNDz42/p61BJ3tbXo5K8o29AJWGEk/X6RlQSRjIt0m76JlTS7vpmVNIe+g+opHvpW1BJe+bVmMr6j6ikSqEfKulA/SdUOEJWOEJW0in6VlTSqepQVLj3qfB7VSqtbvo2VFqMWOsAfTsqrQP1Hai0DqL9aInKVNF3otJS+X5N34UKR8sKR8tKa4y+G5XW2Lwau7K8Drvm0nn0TX0PKhw/K12b9J9REcMMoCIYQ8EYiklGARUx2WhDRTCGIjMkVARjKObRN+l8usAooiLeMrqhIt7mkXcoYyjWG91R4QhZyYpGD1SybsZeqGTdsRmVrAfdy+iJSsYYZrLRC5VMMXqjkg00+qCSDTL2RoWjYiWbYuyDSjY1jx5X9VWOkNVCN2NfVAvd8RCqhR5UpZqxH6ocCascCauFfA9YLYw2+qLalsek2vamsT+qbfONA1BtWwAJVY6H1bZNxoGoSnnPVqWL6MV0mHEQqlIesarUafRDVZpklFCVugp9UZUEzQwZVSmPWFVaTdfQtYaCqrTOUFGV8jVPVdpKtxkaqtJ2Q0dV2mEYqEo7eXwX3U330J9zi6AFw0S12GYcjGpRMg5BtbiXcSiqxTyS1WIv2pvKVDEOQ7WYzzvV4gV0oHE4qhwzqxwzq8UpxhGocpys1rvR7saRqNYZ1TqjWmdUOR5WOR5W64xqfbRxFKrtjGr7m3S+cTSq7QuMY1Bt32gci2r7Jrioxhcax6EaX2Qcj2p8sXECqvEw40RUY0Y17jTKqPJaTzXuMixUY2HYqMZZHsOYUY0Z1XhNHs94reGgGq/LY8KVZDXeauT/lW2Gh2q8HZtRjXcYPqrxTiNANd5lVFCNdxtVVOM9Rohq/LMRodqAUUO1UTBOQrXRlv9WQ6J7GSej2uhpnIJqo5fxC1QbjGpDNk5FtaEYp6HaYFQbFxh1VBsDjXZUG4OMGFWOltXGFKOBarO7cTqqzR7GL1FtMpJNRpIjXpUjXrXJSDZHG79CtTnG+DWqzbyXqx3zjf9DtYOR7Fif12fHBuM3qCYXYTGqycXGb1FNLjF+hypXj9XkJjqE3mz8HtVkqHEGqkm+8qkmE40/oJp0Gn9ENWGckzm0RbuMP6GaCONMVJPv8zgnK+hKuooy/gmrOllrnIVqwvgnjH+y1Tgb1WRbHr2E8U970d5Gf1R531o1LRkDUE1l4xxUOWZW03PpeZTxTC8wzkU1HWichyrH0irH0mo6xTgf1TQfB6ocS6ut7sYFqLZ6GANR5dhYbTG2HBurHBurPP9T5fmfamuMMQhVjpPVrvl5rXYtMAaj2rXeuBDVrg3GRagKxlZcbFyMqrjEuARVriSr4ibjUlTFEOMyVMXNeTzFUMrYionG5aiKTuMKVAVjKxhb0TKuRFV0GVehKkQeN8HYihXG1aiKlcY1qArGVjC2Yo1xLapirXEdqoKxFYyt2Gpcj6rYZtyAqmBss17GjahmvY2bUOU9Y1XeM1bNZGMIqhljmzG22XnGzahm5xtDUc0uMIahmg3M48mVZ5VjbDWbQqcaw1HlGBtyjA0L3YwRCDmuhhxXQ46rIcfVsDDGGImwkMczbMvjGbYtMEYhbFtvjEbYtgEuQmkwFiOULqQXGWMQShcbYxFK1+FLhNL19AbjUoTSjcZlCKU8nqE00RiHUOo0xiOU8niGUpdxC0Ipj2EofU9XGLcilFZiPUJplTEBobTaaCKU1hgdCDnShtJWQ0MobTMShFIet7DYy0gRFnsbExFyhAyLitGJsKgW+iHkmYSweDbtb0xCWBxgTEZYPMeYgrB4rjEVYfE8YxrC4lRjOsLiNOM2hBw5w3o343aEHC1DjpYhR8uQo2VYH2PcgbDOWLUzVu0LjBkI29cbdyJsZ6xixiq+0LgLYXyRcTdCrhjD+DrjHoTx9ca9COMb8vjEN1LGKp5IO437EMaTjPsRxl3GAwhjYfwZYcxYxSvoSuMvCONVeWTi1XQNZazircZfEcbbjL8h5PgZNnoZf0fY6G38AyF33GFDUdsRNlTjnwgbmvEvhA3GqtHf+DfCxgDlPoS8lynk3cUh7y4OeR08bEwz/oOQK8aw2c14ECHHw5DjYcjxMOR4GDbHGP9FyDEw7GCsOhYYDyHsWG/8D2EHY9WxkeYzS5gMzOOWDKKDjYcRJhcajyBMrsqjl1xNrzEeRZhcazyGMJlsPI6Qa8Uw6TKeQJgI40mEyXd5lJLllHHjGBgmK42nECarjKcRJtuMZxByfAs5voVpb+NZhGkfYybCdO88SnxmRZhqxiyEvI8oTPvTAcZshLyPKExTOpF2Gs8h5DMiQo51Ic+Zh+lU43mE6TTjBYRcN4atbsaLCDnWhRzrQo51YcszXkLYGmP8CmFrrPEyQo5vYdcC4xWEXfncEXZtMF5F2LXReA1hF+PWtcd4HWHXz3AQioHGGwjFIGMOQjHYaCEU+SooFFcZXQjF1YZAKK7BOwjFtUaGUEw25iLkWjEUXcY8hBzfQsEYiuXGmwjF98Z8hGKFsQChWGm8hVCw9sQ2422EYrvxDsKMMcx60z7GuwgzxpBPEggzzXgPIe//CbP+xvsIswHGQoS8/yfkJ/fDbKKxCGHWaXyAMJtkfIgwm2x8hDCbYnyMMJtqLEaYTTM+QcTn7UfYSXfR3XQP/Tm3AFqgbVSiRdrN+BRRobvxGSKuOSOOjRHHxohjY1Tw1GMQcfcdFcYaSxBxbIzaFhifI2p7y/gCUdvbkBC1bTS+RNS2yfgKUdsuuIjadhtLEbXtgYOoLc9LJA3EGkTSIDrYWIaIq9OIq9OIq9NIuoxeTq8wvkYkXWl8g0jK8xVJ1xrfIpKuwyJE0vV0svEdImmKsRyRNNX4HhHvUIqk6fQ22mWsQMTxNpK+o8uNlYikbViPSNpurEIk7TBWI5J2YjOi4l60J+1Fe9M+dG9jDaKibqxFVDQK/RBxjI2K/Y11iHiPa8R7XKNiJ51krEdUnGxsQFScUuiNiM/Jj07baWxEdNouYxOi03YbmxGdtsf4AdFpPxtbENVh/IioXjB+QlRvM7YiqkvGNkT1orEdUb2bMRBRPd8vRFzZRhyrI47VEcfqqM7ccacf1ccaOxBxrI7aF9C3jJ2I2t82diHiTj9q32TsRtTO3LXvNvYgat9j/IyonbmLB5pAFA8yC4jiwWYbovhCU0IUX2QWEXFsj+LLzG6I4svN7ojiK8weiOIr86zFzF18Lb3O3AtRzNzFk+kUOpVOM3siiqebvRDFzF3cZfZGFAuzD6KYuYuXm3sj4lnWiGdZo3iHuQ+imLlrMHeNnua+iBq9zP0QNZi7Rh+zL6LG3ub+iBq6eQCiBnPHMT9q9DcPRNQYYB6EqHGOmR/pNEuIGpNMGVFjsqkgajB3zTbMQdSUTBVRs2hqiJrdTB1Rs7tpIGr2ME1Ezb3yXDR70l60N2WOOEdEzRPyHDVPpGVqUZs6lHlsMo9N5rE51jwYUXOceQgiXlmOOvI9ctQxl86jb1LmuoO57njLPBRRx9vmYYh4/iHq2GQejqhjs3kEoo4fzCMRdWzJ897xI91Bd9Jd5lGIOnabRyPq2GMeg6iD9ZBckHdxMtA8FlEyyDwOUTLYPB5RcmGe5eQierF5AqLkEvNERMmlZhlRcplpIUou509ZFcm1po0ouc7M/+b1posoyVcFUXIjvYkOodNND1Fym+kjSm7PayO5g3ZRYQaIku/MCqJkeV4VyQ6606wiSnaZIaJktxkhSlkhaU+zhijN95hR2ts8CVHaxzwZEWe9KNXNUxDx6UxROoCeI89DlJ5r/gIRP4cbcdaL0onmqYjSTvM0ROmkvEJarJCWZNYRtYpmO6JWNwxD1OpOe9C9zBhRq6fZQNTqZZ6OqMUK4WwYcTaMWieYv0TUOlE9A1GrTC1qU4e61DN/hYhXhKPWWPPXiHh3bsRzzlFXZv4foq655m8Qdc0zf4uo603zd4i65pu/R9S1gL5lnoGo623zD4h4diXq2kQ30x/MPyLq2mL+CVEXK6SLFdK10zwTUdeuvCq6dudV0cXRnrNwJM7PK0RcYJ6FSAzEd4jEIDrYPBuRYJ0I1om4mF5CL6WXUdYJZ+pIXGv2RySuMwcgEteb5yASN5jnIhI3muchEjeZ5yMSrBMxnd5GbzcvQCRYJ4J1IoQ5EBFn9kgsNwchEqwTsdMcjEjsMi9EJHabFyHKWCdZT/NiRFkv8xJE/JxplPWhrJNM1w9CxOcCRfxsaZSdQ8+l55mXIuLMHvGZPFHWaV6GKMvrpFYompejVshro1boRXubV6BW6GNeiVphb/Mq1Ar7mFejVtgXD6HG8+e1Ql+q0rxOagUTF6NWONi8BrXCIea1qBUOpYfRw+kR5nWoFY40r0etcJR5A2qFo/lbJ5g3olY4Eeegxlm+Vhhr3oRa2xzsjVpbi3ZRYR6KWltmDkGtbS4k1Nq2mDej1pbXQ61tG91Od5hDUWvbCQc1yaUe9WleFTXpAnMYatJAczhqUl4VNWmwOQI1Ka+KmnQZvZxeQa80+6MmXWWORE262hyFmnSNORo16VpzDGrSTYUeqElDzLGoSTeb41CThprjUZOGYRFqPNNVk243b0FNusO8FTWe6apJwpyAGq8I16SldJnZRE362uxATfrGTFCTvuXx78wUNWm5ORE1aYfZiZq00zgJtWIvcxJqPENVK6rmZNSKmjkFtaJuTkWNa4Na8Vx6njkNNd6lXONdyrVipzkdtSIroV7EHNTqrIQ6K6Hem/ahe9N9zNtQq+9r3o5afT/zDtTqfc0ZqHHer3Her9VZCfWDzTtRqx+Cf6NWP5QeRg+nR9Aj6VHmXajVWQl1VkKdlcA1Q60+1rwbtXZWQnvLvAe19i7zXtTahXkfau1ZXgPtrIT2Leb9qLWzEtq3mQ+g1r7d/DNq7TvyjLezEmJWQuyZf0Et9s2/ohYH6INafJ75N9Ti882/oxZfYP4DtXig+U/U4kHmv1CLB+fZj1kV8WV59uPLzX+jFl9h/ge1+ErzQdTiq/Lsx1fTa+i1lFURD6E306Hmf1GLh5kPocYzdbX4dvN/qMV3mA+jFrMqYmE+glr8lfkoavFS8zHU4mXm46jFX2M9avE35hOoxd+aT6IWf2c+hVq8nMd3mE+jxhVIrdHLfAa1Rm/zWdQaqjkTtYZmzkKtoeeVwFVHrcGqaJxHzzdno8a7rGuNTvM51BqTzOdRa+6X573Z13wBteb+5ouoNQ8wX0KteaD5MmrNg2g/WqIyVahKNfMV1Jq6+SpqTcN8DbWmSQ82X0etyaposiqarIomq6J5FD3afAO15jHmHNSax+YV0mSFNFkhXI3UmmPNFmodc8wu1Dpa5v9zdNfhblT5G8DfZAb4LZTi2vaOQ90FMpaZc2K41gtUkSLFpVgyU6yKS3FYvLjLLskMDosui8OyOCwORXbZ3zPvP58nT27ubc/7/c45Y0kyVDo/Fv3Q+akvR6XzS98TqHR+LeqesPrJuL4nUUmm9j2FSjKt72lUEs4GyYy+Z1BJZvY9i0oyq+85VJJ5fc+jkswvqpYcQ4/tewGV5Li+v6GSHF/ULjmBnkhX9L2ICvcHKkne9xIqyRN9xfNP9r2CSvJUsRUnb9G3+15FJXmn7zVUknf5zHt9f0cleb/vdVSS3/r+gUrCOvKIuJJu0PcGKmkx51e4b1DhEXElHdD3JirpwL63UOF+QiWd3vc2KumMvndQSYs9/0p65rbropKe1fcuKmmx91jpsqbdTeimdDO6Od2Cbkm3olvTbei2dAAd2PceKt1Bfe+j0u0r6tXVKGvatfo+QKVr9/0Tla7T9yEq3e36/oVKd/u+j1DpDu77GJXukL5PUOmypl3WlPsPle7ivk9R6T3e9xkqvW7f56j0WNPeT31foNL7pe9LVHq/9n2FSsaaZuP6/o1KNrXYlrNpfV+jwnW/ks2gM+msvm9Q4Zpeyeb3fYtKxppmx9Lj6PF936GSndD3PSoZa5qt6PsBFa7dlSynT9An6VN9P6KSvdX3EyrZ24M6qGTv9P2MSvZu31pUsveKrTJ7n/7W9wsqGWvKI/RKvkHfr6jk/fp+QyXfsO93VHiEXskH0IF9/0GFa3oln973X1TyGX1/oMLP06vw8/Qq+Vl9/0MlL2rqlgZqgFsahGvglvqoRnWtBLdkaGW4JVNT4JYsTYVb2g5T4Za219aBWxqsrQu3NERbD25pqPZ/cEvDtD/BLQ3X1ofLddktnawV/0qxL+eWH8WGcMuP0b/Qv2r94JYf14rHXa0/3HJRL7f8k7YR3PIv2sZwy79qm8DlWuwq47RN4SpFvVxlmrYZXK7IrjKDzqSztM3hKgu0LeAqB5bWg6ucQE/UtoSrnKRtBVdZjJfhKqu0reEq55aKv5/TJ+iT2jZwlae0beEqb+AruMqb2gC4yluDOnCVt/E1XJ7VdHn9yOWRuKv8qg2Eq/bXBsFVN9L64KobD7oSrroJvoHLM5yuuo2mwVW31XS46gDNgKsO1Ey4XG1dXhty1RmaBVft4G24akJTuoSeodlw1TM1B656lrYdXB6Vu9FAbXu40SBtMNyIdYw0bQjcSNeGwo2MomqRSS3KOkbb08F0iDYMbjSUvztMGw43Gq6NgMtV1Y1O1kbC5XV2N35UGwU3fkwbDTf+izYGbvzXomrx47RLWcf4J20s3PgXbRzcuJhLXa6krhinjYcrWEcxTZsAV0zXJsIVrKOYqU2CK2ZpO8AVxR6UKw7UdoQrWEdxIj1Jq8AVizUXLt955ArWUbCO4olBo+CKJzUPrijmT1ewjuJNzYcr3irqJVhHnnF1xdpBA+CKX7QALs+4urI/3YhurIVw5SZaFa7cWovgym20GC6/1c6VAzQBVw7UJFyujy6vRrlyhlaDKztaHa5MtAZcmWpNuHKJ1oIrz9B2giuLPShXnkVZx/ZAHAO3PUjbGW67T9sFblvTdoXbZo3aQ7Td4LaHa7vDbY/Q9oDLtcxtL9b2hNt5uNimOo/QR7W94HYeK6rAdc3t/ER/1vaG21mr7QOX65qbjNP2hZtM1ybD5XGxm8zUpsBNZhXJJwu0qXCTA7VpcJOTtelwk1O0GXCTU4stKDmNLqPL6Qq6kq7SZsJNztVmwU1ybT+4yRPa/nAT1iJ5UzsALq9YuclabTbcdFNtDtx0M20u3HRzbR7cdAttPtx0S20B3HQr7UC46dbaQXB5xcrlVSo3naEdDDedWWwdvErlpqfTtnYI3LSjLYSbJkW2XWbbHaQdCrfbV+TZ1ehg7TC43SHa4XC7w4v+747QjoDbPUlbBLe7WDsSbo/Z9h7RjoLbe7RIssdsub64vZ+0o+H2fi7y7K0tzMZqx8DNxmnHws2ma8fB5ZriZjO14+FmzDZboJ0AN+MclZ1MT9FOhJudqp0EN2O22TJtMdxsuXYy3GyFdgrcbKV2KtxslXYa3Oxc7XS4WV6kyjsE3OwNrQ03e1PrwM1+1hK42VothZtvqi2Bm2+mnQE331w7E26+hXYW3HxLfAs334purZ0NN99GOwcu749y8xnaUrj5TG0ZXF6lcvnZrS4/u9XNO9pyuHmRrVcqsvVKg7QV8EqDtZXwSkNwDbzSUDqMDqcjtFXwSiMxBV5pFB1PJ2jnwitN1M6DV5qknQ+vtIN2AbzSjtqF8EoV7SJ4/PQDr3SSdjG80mLtEnjlu7EhvPI99F56H72fPtBnwCs/qF0Kr/yQdhm88sPaanjlR6DAK/+sXQ6vXNTL4/riKeO0K+Ap09EPnjKj72t4ykztSnhKUS9PmaNdBU+Zq10NT5mnXQNPmd93EjzlVO1aeMpp2nXwlNPxMjylTc+hS+ky7Xp4ynL8DE9ZRc/V/gxPOU+7AR6P6Twl026Ep+TaTfB494KnPEmfok/TZ+hL9GX6Cn2Vvkb/Tl+n/9Buhqe8od0CT3lTuxWe8iOKcf2k3QZP3Rjfw1M30dbAUzfVboenboZv4anbanfA4zU4Tx1IB9GZ2p3weMeCp55O29pd8NSOdjc8lZ0QsROiQdo98KLB2r3woiHaffCiodr98CJ2QsROiEZoD8CLRmoPwovYCdF47SF40QTtYXjRRCyHF02iO9AdaUV7BF7kao/Ci07CZfB4Lc+L79Yegxffo/0FXnyv9ld48X3a4/Di+7UuvPgBbQy8+MGi7vFD9GHKToh/1nrwYnYCVyhPjNMyeIKdIGZoOTwxs+gBwU4Qc7Qn4Im5pfXgiXl0Pj2VnkZP156EJ9raDHg8P+yJpdpT8MSyovqCnSDYCeJcyh4QmfY0PJFrz8ATT2jPwhNPas/BE09pxb/7tPYCPMEeEC8NKv7ay9rf4IlXtBfhiVe1l+CJ17Ti3/q79go88br2Kjzxj6Li4g36Jv1x0AB44iftNXiSPSA30f4OT25aVF+yB+S2dID2Ojw5UPsHPDlIewOeLGYDT7IHJHtAtrfZCp7saG/Ck4n2FjyuYl57kPY2vPZQ7R14bda9PZKO0t6F1x6tvQevPaaoe3ssHUfHa+/Da08oat1mD7TZA+0KdbUP4LU97Z/w+K0EXuf2Ykvv3EHv1D6E17lL+xe8zt3aR/A69xT17fxM12ofw+Oq5yXjtE/gJaxvMkP7FF4yU/sMXjJL+xxesp/2BTyeDfaSA7Qv4SWzta/gJacXlU3a2r/hJR3ta3hJUlSW93V4yRJ6Bj2TnkXPpudo38BLlmrfwkvYAwl7IMm17+AlT2jfw0ue1n6Alzyj/QgveVb7CV7ynFa85vlBbXjJC4M68JK/aWvhJS9qv8BLXtJ+hZe8XNQ0+Z7+QH/UfoOX/FRUNt1Y+x1euon2H3jpwKKm6SDtv/D4faleqml/wEt17X/wUoPPNGmL7qQDXrozH+9Cd6W76SV46e56GV66h67AS/fUVXhckb10Bp2prwOPK7KXdmiirwsvTfX14KVLirmCK7LXHaT/H7zuUEyF1x2m/wlel33SHUVH6+vD647Ri9eP1fvB647TN4TXHa/3h9dln3Qn6hvB607SN4bXZZ90Xerpm8Dr+vqm8Hrsk94d+mbwenfqm8Pr3VV0Re9uyj7psU96a/Ut4PEI0cvGUfZJNqOofjaTzqL70f31LeFlB+hbwctm8xn2SdamHX1reFmibwMvS/Vt4WVL9AHwsjP0gfCyM/VB8LKz+qbCy87W++Bl5+gavGyprsPLlukGvGy5bsLjUaSXPaFb8LKniypnz9Bn6XP0efoC/Rt9kb5E2ScZ+yT7QbfhZT/qDryMfZJvrG8HL99E3x5ezj7JB9E+fTC8XONjXR8Cj98j5uVNfSi8vKUPg5fvxL+wsz4cXr6LPgJevqs+El6+mz4KXr47f7qHPhpevqc+Bh73Lrx8hj4WXj5THwePexcePw3e46fBe3mqj4eXL9EnwC9tiGPgcx/DLw3SJ8IvDdcnwS+N0HeAXxqp3Q+/VHSLXxpNx9Cx+o7wS+OwHH5pPJ2gV+CXdtBd+KUd+YxHfd2DXwowBT6/pd0v34YN4ZfX6D788u16AL98BxT45Z/pWj2Ez/0EXym6wleW0eV6Fb6yQo/gKyvxM3yu9b5yLn1Sj+ErT+Fr+MrT9Bn6Lf1OF/CV73UJX/kB38NXN9Zr8NVN8C18dVO6GdX1OnzV0BvwVbO0JXx+f6iv2tShktZonTb0Jny1qbfgqy3+/T30neCre+o7w1f30neBr+7NV06mU+h0fVf46gx9N/hqqu8OX12i7wE/6ofH4fPsrs8V348G6XvCj4ZjKvxoBB2p7wU/GqXvDT8are8DPxqj7ws/GltkHrEuEesSTdAnw4920KfAj3bUi99lXSJfnwY/CvTp8PnN6X7MusRr9Bnw49uLWsSsS8y6xGv1mfC5avuCdRGsi1hOV+iz4IuV+n7wxSp9f/jiXP0A+OJJ7Wn44il9NnzxtD4HvmBdxLeDBsAX3+lz4Yvvi8QE68IV05eb6PPgy031+fDlZvoC+FIvqiMN/UD40tQPgi8t/WD40tYPgS9ZFyn1hfBlTT8Uvqzrh8GXDf1w+LLJv8m6yD3onvoR8OVe+iL4/J4jX07Wj4TPT7rw5XT9KPg87+rLVD8avlyiHwO/vV5Rl/b/0T/R9ekGlFVrb1vUqz1APxZ+e6B+HPz2IP14+O3R+gnw22P0E+G3x+onwW+zRu2J+mL47Um4EH57B7qjfjL8dkU/BX7b1U+F3/b00+C3ff10+O1Ab8Pnt5n7Hdarw3pxFfY7a/UO/KQ49veTafRpPYGfPKOn8JNn9SXwk+f0M+AnnxVVSD6nX9Av6df0G/qtfib85LsisXRTupl+Fvx0c/1s+OkWRUXSLelW1NbPgZ86+lL46Xb6Mvjp9kWe6WA6hA6lw+hoOoaOpePoeDqBTqST9OXw0x30FfDTHfWV8NMKn3epR30aU6Gvgp9K/Vz4aY3/c9Y93VM/D366l34+/HRv/QL46T76hfDTffWL4KeT9Yvhp1P0S+CnU/VL4afT9Mvgp9P11fBT9kOa6pfDT5foV8DvrqdfCb/7f/pV8Lt/0q+G311fvwZ+l/3Q7adfC5/nZv3uAP06+N2BxZbbHURH69fD747R/wy/y222y37oTtRvgN9lP3TZD90daYW61KO+fiP8bsDfCvWb4PfYDz32A1dbv7dWvxk+z8H62TT9FvjZ06VN4GfP0Gfpc/qt8DP2Q/a5fhv87At9DfyM/cD3AfnZN/rt8LNviwwz9kPOfsg3o5vTLfQ74Odb6nfC5zGvn9vUodvpd8HPt9fvhp8P1u+Bn7Mf8qH6vfDzYdr/4Ofsh3yMfh/8fKx+P/x8nP4A/Jz9kE/QH4SfT9Qfgp9P0h+Gn+9Q2hR+vqP+CPy8oj8KP3f1x+Dnnv4X+Lmv/xV+HuuPw8+F3oWfS/4/2Q85+yHfk+5F96b70H31Hvx8cmld+PkUOlXP4OfT9Bx+Pl1/An7OfuA1XD9foj+JoDRQfwpBqahvUBqtP42gNEZ/BkFprP4sAq6VQWmi/hyC0iT9eQSloo5ByddfQFAK9L8hKIV8TVV/EUEpwhQE5dXYEEH5cnoFvZJeRa+m19Br6XX0evpnegO9kd5Eb6a30FvpbbTonICrcFBeq7+EQCk6JFCe019GoHyCrxEon9LP9FcQKJ/rryJQvtBfQ6B8OfA7BMpX+t8RKP/WX0egfK3/A4Hyjf4GAnVL/U0E6lb6WwjUrfW3Eajb6O8gUIvjoEAdQHVq0O3o9vq7CNTB+nsI1CH6+wjUoXx+mP4BAnW4/k8E6gj9QwTqSP1fCNRR+kcI1NH6xwjUMfrhCNSx+B6BOk7/BIE6Xv8UgTpB/wyBGuifI1BD/QsEalX/EoEa6V8hUGP93whUwd/ai+5N99G/RqDuq3+DQJ2qF/+HaShGMZ3OoCldon+HIBqof48gGqT/gCAarf+IIBqj/4SAq3PA1TngMXgQTdJ/RhCxEyKfBvpaBBE7IarqvyCIIv1XBPFq/TcE8eX67wjiK+iV9Cp6Nb2GXkuvo9fTP9Mb6I30JnozvYXeSm+ja/T/IOC6H8Rr9f8iEOwE8Zz+BwLBThCf6v9DID4zgEB8XqQkvqBfFhUXX/Hxv+nX9JtCuSXdim5NtzFKCOS2RhmBHGAoCKRuqAgkO0GyE+T2dDAdQtkJchgdTkfQkXQUHW2sg0CO4b/CTpDjjHURyPHGegjkhNKmCGRg/B8CGfKnVRrRmLITJDtB7m38CYHcp1T8hX3pVGN9BHKasQECOd3oh4D7DIFkJ8glxoYIeLQetAcZ/RG0+4qZoa3R0cZGCNpjjI0RtMcamyDg/kDQHk8n0InGpgjak4zNELR3MDZH0C7WgqAdGFsgaLND2lUaGVsiaMfGVgjaopg3OrdhcwSdNcbWCDrFvlzQuYOypp21xjYIEtY0ec7YFkFSHN0EyQv0E2MAguRTYyCCdNti7OkAYxCCdKDRhyAdZGgI0j5DR5BqhoEg1Q0TQWoYFoLUNGwEqVXkn7IWKWuRjjYcBClrkbIW6ThjOwRpYGyPIA2NwQjSqjEEQcr8093o7nQPYyiCdE9jGIJ0L2M4gnRvYwSCdB9jJIKUtUinG6MQcI0OeEQcpMk2uyJIU2M0gnTJth8h4BFx0B1kjEHQ7TPGIuhqxjgE3dHGeATdMcYEBN2xxkQEXIuD7nhjEoLuBGMHBN2Jxo4IuC4HXJcDrstBNzAqCLqh4SLoshbdiMaGh6DLWvRYi94aw0fQu90IEPTuMEIEXKOD3lqjioBrcZA9Z0QIsue1pxFkLxgxguwTQyDIPi1yyFmLfAAdSAfRPqpRnRrUNCSC3DJqCHLWIh9l1BHko40Ggpy1yFmLfJzRRJAHxRaRh7RqtBDkrEXOWuS7GzshyPcwdkaQ71lknu9F9zZ2QZDvY+yKIGct8unGbgi4PgY86gzyxNgdQZ4aeyDIlxh7IuTxZlgaZOyFsNSHFQhLmrE3wpKOqQhLBt2Obm/sg7A02NgXYWkInxlKh9HhdAQdaUxGWBplTEFYGm0Uz4zBhQhLY+k4o4KwNN6YhrA0gc8ENDSmIyxVjRkIS5ExE2EpNmYhLBW1C8tF7cLyGmM/hOXbMQJh+Q5jf4TlO40DEJbvMmYjLN8NBWH5HvozXWvMQagU+1qh8gx9lj5HnzfmIlSKbS1UPqIfG/MQKp/ge4TKp/QzWsyroWpSy5iPULWNBQhVxzgQobqdcRBCtZgbQ3UwHUKH0mF0OB1BRxsHI1TH8K+NpeOMQxCq442FCNUJxqEI1YnGYQjVSaVNEao7GIcjVHc0jkCoVoxFCFXXOBKh6hlHIVR942iEamAcg1ANjWMRqlXjOIRqxL+8C93VOB6huptxAkJ1d+NEhOp04ySEXDFDrpihusRYjFA9wzgZIe96CqOBxikIo0HGqQgj9kakGachjHTjdIS83hpG2xlthNH2RgdhNNhIEEZDjBRhNNRYgjAaZpyBMBpunImQx8thNNI4C2E0qqh4NJqyNyL2RjSOjqfsjYi9EYW0SiPjbIRRbJyDMBLGUoQxeyNmb8TsjfgOeie9y1iGML7bWI4wZm9wPQ3jtcYKhIK9IZ4xViIUzxqrEIrnjHMRiueN8xAK9oZgb/A+olB8YpyPUHxqXIBQfGZciJBrbijZG9KiNnXodsZFCOX2xsUI5WDjEoRyiHEpQjnUuAyhHGasRiiHG5cj5LoZytHGFQjlGONKhFwrQzmOjqcT6EQ6ybgKodyBj3ekFepSj/o0oCGtUvaG3MW4GqHc1bgGodyttC5CuTudblyLUM4wrkPINTSUS4zrEcoziq7g9dywva3xZ4TtAcYNCNsDjRsRtgcVHdJmn7Q14yaEbd24GWGbfdI2qUW3M25B2N6+qGZ7qHErwvYw4zaE7eHGGoTtEcbtCNuxcQfCtjDuRNiWxl0I2zXjboTtejEbtBu0SVuFXGfDDnugc3dR9849xj0IO/ca9yLs3FdUvHM/fYD+SH8y7kPY+dm4H2FnLcYgTC4prYcwuQu/I0yeLHojecp4AGHytPEgwuQZ4yGEyWfGwwgT1j0dapQQpsOMRxCmw41HEaYjjMcQpiONvyBMRxl/RZiONh5HmI4xugjTsUYPYTrOyBCm440cYcqapqxpOonuYjyBMN21qEU63XgSIdfTkPczh+kS4ymEKWvB+5nD9Cx6Nj3HeBphutR4BmG6zHgWYbqcz99O7yjsblvUqDuADqSsXZe162pUN55D2DWM5xF2zWJF6LJ23e2MFxB2WbvuUDqMDqcjaGz8DWFXGC8i7ErjJYTdmvEywm7deAVht2G8irDbNF5D2G0Zf0fIdTnssXY91q53D73XeB1h7z7jHwh79xczfI+14/XosPeT8QbC3s/Gmwh5PTrMWLvsUryCMLvTeAthxjryfqQwe8p4GyHPKoc8qxxmnxnvIMxYx3woHUaH0xF0JB1FRxvvIszHGO8hzMeicBwdb7yPMJ9gfIAwn2j8E2E+yfgQYb6L8S+EOeuYTzc+Qsi1OOSxapgvMT5GmLOO+ZnGJwjzs4xPEeZnG58hzM/ha5bSZcbnCPnJw2HOOuZ3lPqjWjKNL1AtWcaXqJZsTEW15BhfoVqKjX+jWhLG16iWJC5EtVQzvkG1VMdyVEsN2qQt41tUSzsZ36Fa2tn4HtXyldgc1fJVxg+olu83fkS1/IDxE6rlB6GgWn6I/mz8jCqvKVeVIvOqUmReVS6jq0sqqsqdxlpUlSL/qvKk8QuqylP6ragqnxm/osr1tKp8Qb8sVEcbv6GqjjF+R1Utsq2q4+hE4z+oqpOM/6Kq7sRX7kx3Mf5AVS2yrapT6TTjf6iq002gynWtqp5hllBVzzTLqKrLTAVVdbmpoqoWGVZVZhiZWIFqZJnroBrZ5rqoRo65HqpRXOQWCcoMI2YY1c3/QzVihhEzjFp0J7pzYcwMY2YY348RqMYPmH9CNX7QXB/V+CFzA1S5BlXjtWY/VAUzFJdSZihWmxuiKu40+6Mq7jI3QlU8aW6MqngKX6MqPqOfm5ugKr4wN0VVMEM5mo4xN0NVjjU3R1WOM7dAVU4skpSTzC1RlcxQ7mxuharcpchNMkM51dwaVTnN3AZVWRxNV3kMVZVn0DPpMrrc3BZVyQxlMZ9U28ywbZkDUG0Xa3e1PYFOpJPoDnRHWjcHotpumINQbTfNPlTbzLDD3DrMrXNvkVvnPsoMOw/QB+lDpoZq52FTR7XzSJFk51H6GGW2nbWmgWrnF9NElfe4VpPLioQT9mfypGmhmjDP5AvTRjVhhikz5PFRlcdH1XSc6aCaMsN0krkdqukO5vaopjvypxXqUo/6NKAhrdKI7mQORjXducg8nUynmENQTaeaQ1Hl+1aq6Tl0KV1mDkM1Xb7t0ah2mXPXMoej2mXO3QnmCFS7E82RqHYnmaNQ7e5gjkaVx0TVbp02aJO2zDGo9phzjzn3mHPvPnMsqj3m3HvAHIdqjzn3HqIP00fM8aj2HjUnoMr7gqo8bqr21tJfzImo9phzxpwz5pw9aU5CNWPO2Rf0S3MHVHPmnI8xd0Q1H2tWUM3HmS6qOXPOJ9EdTA/VfEfTRzWvmAGquWuGqOaeWUU1980I1TwwY1Tz0BSo5lVToppHZg3VfCezjmrOnPPJZgPVfIrZRDWfWqTK7xSrco6t5kvNFqr5MnMnVPPl5s6ISkXOUckyd0FUss1dEZUcXIioNJ5OMHdDVNrB3B1RaUdzD0SlirknopJr7oWI5xKjkl/IuTTiXBqVi5yj8n3m3ojKj5r7ICo/Zu6LqPwXczKi8l+hICr/Yk5BVC4yjMq/0d8LlSLPiPNqpFxOr6C34wNEyh3mVETKneY0RMpd5nRESpFzpHxpzkCkfGXORKT8G98jUkebsxCpY8z9EKlVvIdIjcz9EalN/rRFdzIPQKQWuUXqZHM2InWKOQcRP7M9UpduWzy/zJyLSF1uzkOkrjDnI1JXmgsQqTeVNkCk3lzIeTWKLGqbByKKHPMgRNy3j6IJ5sGIoh1wHqJoR1qhrnkIosgzFyLiWbiIc2nEuTSKmWF8n3koovhRrIMofoz+xTwMUfxX83BE8S/mEYjiX81FiOLfzCMRxb+bRyESzFCsNo9GJC43j0EkrjCPRSSYobjDPA6RuBO/IxJ3UWYovjSPRyS+Mk9AxHNokRxtnohIjinSk1XzJETcl454bSuSLXMxIrmTeTIiyQzlZPMURHyXesR3pkdyKV1Gl2/9EiK5wjwVkVxpnoZIMkN5s3k6orZdpNd2zDai9nZmB1F7ezNB1B5cJNMeQkfTMWaKqD3WXIKoPc48A1F7vHkmovYE8yxEbc88G1HbN89BxDk24hwbde4v0us8QB83lyLqdM1liDq9ohs7Gf2N/m4uR9T5j7kCUee/5kpEnT+KzuQ7DaPkSnMVouSqIsmEeSZ3mOciSj4sMkz+ZZ6HKPnIPB9R8rF5AaLkE/NCRMmn/Oln5kWIks/NixElX5iXIEq+NC9FlHxlXoYoYebpaHM1onSMeTmidKx5BaK02DeIUo/6NKCheSWitGpehYjzbZRKWqOsUdoyr0aUTjavQcR3x0f87s6Ic2+UTqcz6Aq60rwWUbrKvA5Rem5RnfQm83pE6c2l/oi6rFHXodvR7c0/I+oONm9A1GWNuqPNGxF1x5g3IeqOLfq8O46OpxOoR33zZkScnyPOz1HvfvMWRD3WqPc47Zq3Iur1zNsQ9VijHmvU+53+h/7XXIOo94d5OyK+1y/KrjTvQJSxRtnt5p2IsjvMuxBlrFH2ryKZ7CP6Mf3EvBtR9ql5D6LsM/1VRNxPjrIv6Jf0K8oacT6PeC4rysea9yLivnGUs0a5b96HKA/M+xHlofkAorzKV7JGvB4U5TXzQUR503wIUd4yH0aUTzYfQZRPMR9FlE81H0OUTzP/giifbv4VUT7DfBxRzhrlK80uonxVUZ2cNcqL7SguDTZ7iEtFFeLSSDqKjjYzxKUxOA9xyaO+mSPmmai4FBaW7zefQFwuko/LPZqZTyIu5+ZTiPkOu7j8h/k04vL/zGcQK8AYxEqJXmk+i1i5ynwOsXI1PkCsXENvo2vM5xErt5svIFbuwO+IlQ/MvyFW/mm+iFj50HwJsVJUJFbHmi8j5p5wrHrmK4hVn88HNKRVGlFJa+ariNW6+RpitWH+HbHaNF9HrLZK6yJWJ5v/QKxOMd9ArE4330SszjDfQszve4r5fU+xusIsXGm+gzgabL6LOGKGETOMRpnvIY5Gm+8jjphhxAwjnwbmB4h5xiaO7zf/iThmhjEzjDOam/sgjp8wP0Qc/1EkGf/P/BdiAfMjxIIZiitL6yEWV5kfIxZXm58gFsxQMEOxxvwUsbi9SE8wQ/GB+Rli8c8iAfEhZYZyrPk5YjnO/AKx9MwvEfOsSMyzIrEMza8Qy6r5b8QyMr9GLJmhrJnfIJb1IjHZoE3KDOVkOoVOpzPMbxFzVo/lcvM7xHKFWfydlUUftgdjBeL2EPMHxO3iqDluD6PMsz3K/BFxmxm2ffMnxO3A/BlxOzTXIu5chs0Rd1bTy81fEHeuMH9F3LnS/A1x5yrzd8Sd+83/IOZMHneeNP+LuPOU+QfiztNFtp1n6H/of83/Ie78YQFx539WCXECq4w4KVkK4qRsqYgTxVoHcaJa6yJO1ilqkaxL16Pr0w1oP7oh7W+thzjZyPo/xMnG1p8QJ5tY6yNONuVPN6Mz0Q9xMovuR6+2NkCcXGMVj68tKptcR1nlZI21IeJ0rNUfcTrO2ghx6lkbI059axPE3MeO09DaFHFa7M/EaWRthphzfpzWrM0Rp6xOOsPaAjHvn495t16cHkWPpsdYWyJOj7W2Qpwex2eOp6xmutzaGnG6wtoGcbrS2hZxusoagJhrQdxlZbtDrIGIu0OtQYh5PiTusrLdUVYfYs7tcZdbRzewNMTd0NIR91jZ3mrLQNy73DIR967ACMS9K+lV9H7LQsz5P+49SZ+ybMS9py0Hce8ZczLi3n+s7RD3/mttj7j3B4Yj7v2vMAMt0TJVqErXsQYjzta1hiDOWNmMlc02sIYizvpZwxBnG1rFK/ujeLwR3ZhuQje1RiDONrNGIs5Y2WyWNQpxtp81GnF2dbH9ZtdYxd+81hqLOGNls9uscYizNdZ4xLzqEefjrAmIuVLEuW9NRJwH1iTEeWjtgDhnZfPI2hFxLq0K4rxmuYhzVjafYXmI85mWjzifZQWIc1Y2P9oKEefH4GPE+bFWFXF+nBUhzo+3YsQ5K5tzruMKEucr6SpLIM7PtSREaahVgygNs+oQpeFWA6I0AhdCcB0RpVFWE6IU4DyIUmi1IEpVayeIUsSfxlQUlosqi/Jqa2eI8v3WLhBcU0T5afqMtStE+VlrNwh+TosoP09foL/QX+lv1u4Q5d+tPSCUdTEcQlnP2hNC+T/zIwjlT9ZeEMr61t4QygbWPhBKP2tfCGVDDINQNrcmQyhbWFMglC0xBkLZik5HPwhlhjUVQplpTYNQZlnTIZRrrRkQynXWTAjlemsWhPJnaz8I5QZrfwjlRusACOUmazaEcjM+gFBuobfS26w5EMoa/A7BqxtCHWfNhVADax6EGuI9CB59CDWy5kOoMV8jqLQWQKi10roQ6kw6yzoQQt3POgiC3wki1IPowdbBEOohVgyhLuQzh9LDrEMg1MOthRDqEXxmkXUohHqkdRiEepR1OIR6tHUEhHocPoZQj7cWQagnWFUI9UTrSAj1JOsoCHWxdTQEj3qEupQuo8vpCrqSrqLnWsdAqOeVNoBQz6eX0EsLo6FYARENs46FiIZbx0FEI6zjIaKR1gkQ0aiiiyL2UhTSqnUiRBRZJ0FEsbUYgmeiRMxeildbJ0PE91unQHBtFTF7KX6GPmvZEPFz5lKI+HlzMkTMXop/sU6FiH+1ToOIf7NOh4h/L7pIsJfEevT/rDaE+JPVgRDr85kNaD8rgRDsJbE53YJuaaUQYitrCQTvSBRihnUGhJiJARBiFr22tB6EuI5eT/9Mb6A30pvozfQW60wIcat1FoS4zTobQqyxzoHg1RAhx1lLIWSxty8ke0myl2REY2sZBO8WEFJayyEke0myl+QsawWE3K+oF7+vREj2kjzYWgkhD7FWQciF1rkQ8lDrPAh5mHU+hDzcugBCHmFdCCEXWRdByCOtiyHkUUX/yKMpe0keT0+gJ9KTrEsg5GL+K+dYl0LIpdZlEHKZtRpCLrcuh5ArrCsgJHtJspfkudaVEPI86yoIyV6Sl1hXQ8hLrWsg2sOLXmqPsK6FaI+0roNos3/asXU9RFtYf4ZoS+sGiHbNuhGiXbdugmg3rJsh2k3rFgjuUYjOautWiM791m0Q3HMQneetNRCdF6zbITp/K3qm8yL9kf5Ef6ZrrTsgOr9Yd0J0fi06JOln3QWRsEOSra27IZJtrHsgkm2teyGSAcVskwykg+j4oluSCXQinUR3oDvSCnWpR30a0JBWaURjKqikNVqnDdqkLboT3ZnuQnelu9Hd6R50T7oX3ZvuQ/elU+k06z6IZLp1P0Qyo+j85Lain5M11gMQSbGnKpJiT1XwvKJIx1kPQqTjzS8g0glF96ax9RBEyu5NpfUwRMruTWeaDYh0lvUIBL9xTPAbx0S6wHoUIj3QegwiPcj6C0R6cNF16SF0YTGnpYdaf4VID+MzJ1mPQ6TsRn7ym+Anv4n0HKsLkS4tbQmRLqPLrR5EusLKIFJ2ZnoePd/KIdKLip5ML7aegEgvwScQ6aXWkxBddmZ3BB1pPQXRZWd2YyqopDVapw3atJ6G4B6R6K22noHg2UjBPR/Re56+QP9mPQvRe9F6DoJXgkTvJ+t5iN7P1gsQvbVFN/Z+oezMrJ/1N4iMnZltTbeh21ovQmQDrJcgsoHWyxAZOzMbb70CkU2wXoXIJtJJdAe6I61Ql3rUpwENaZVGNKaCSlqjddqgTdqiO9Gd6S50V+s1iGw3Pt6d7kH3pHvRvek+1t8hMnZmNtV6HSKbVnRjNp2yMzN2ZraG3m79AyJjZ3J/TOTj6HjrDYicnZnH1psQubDegsil9TZEzs7MZ1rvQOSzrHch8v2s4pX7W+9D5AusDyDyA0tbQ+QH0YOtf0Lk7Mx8IT2UsjPzk6wPIfLFxWrOzwgV+dnWvyDyc6yPIHJ2Zs7OzJdbxeu5/nKfTeTszPx86xOI/CLrU4j84qIn80uszyDyYv2VpZFYAVkaZX0OWRptfQHJY3/JfTNZXm19CVku+k2WH7C+giz/DetAll+0/g1Zfsn6GrL8MhTI8iv0Vfo9/cE6FbL8o/UNZPkn61tIpZ/1HST3u6Qy0PoeUhlk/QCp9Fk/QioaxkAqOjXoPtZPkMq+1s+QymT0g1SmWK9CKlOttZBKUTup3G79AslzBVIdb/0KqRZ1kWps/QapCqt4fr/SupDq/tZ/INUD8DakOpvOoXPpPOu/kOp8Pl5g/QGpFjWS6iHW/yDVhTYg1ZPwMaS62C5BqifbZUj1FL7+VHoaPZ226Rn0TFuBVM+yVUj1bHsdSLWol1SX28X/6gJ7PUj1wqIWEWsRjaKj7f+D5DkEyX0bGa+2/wTJq2YyfsBeHzJmLeIX6Uv2BpDxy+aHkPErdj/ImLWIv7c3hIx/sPtDxj9iOGT8U6FgLbjfIsVAOoj22RtBCs3eGFLoRV0EayH2KdIW+9LJdAqdSlkLcbu9CSTPOUg53t4UUrIWMrY3g5SiqIJkLeT+xvqQ8gB7c0g5294CUs6xt4SUc+2tIOU8e2tIyVrIBfRAextIeYi9LaRcaA+AlKyFXExPtgdCylPsQZDyVLsPUp5ma5DydFuHlKyFPMM2IOWZtgkpzyryl2dT1kIuty1I7j/I9gjKWrRZi/Zo24ZssxbtRVgF2T7SdiA7S4u6dJbZ20F2ltvbQ3ZW0JV0FT2XnkfPpxfQC+lF9GJ6Cb2UXkZX24MheY1Pdh6wh0B2XrGHQnZetXaD7LxW1Lfzd/o1/YZ+aw+D7HxnD4dM+tkjIJMN7ZGQSXGsKpONqG6PgkyMYutLTOtlyMQqqpzY1KHFTCiTNfZoyLRqj4FMI3ssZBrb4yBTVjM9wB4Pmc4uqpnOsSdApnPtiZDpPHsSZMoKpgvsHSDTA+0dIdNDim0qXWhXINNilpNcfyXv35D8FFbJz5+R6ZlFXbrDbReS66bssiLdUbYH2R1d1KLLinRZkS4r0ltq+5C9ZUViveV0BV1JV9Fz6Xn0fHoBvZBeRC+ml9BL6WV0NWVFeg/YAWTvlWLr671KX7NDyB4r0vu6mP1639hVyN63dgTZ+67Y7rJ+dEM7hsz62wKSZw9kplODmsXcmFm2hMxsuwaZsSJcm2S2ppjx8qpdh8yjogp5TFmR/AA6m86xG5D53CLzfB6dbzch8wV2CzI/0N4JMmdF8oX2zpD5ofYukFx3ZJ4W+edn0KIWtdJQe1fUSsPs3VArDbd3R600wt4DtdJIe0/USsX+TK1U1KJWOtLeC7XyUntv1Lh21MoP2PugVn7N3he1cpFPrfw6/Qf9iv7bnoxa+Wt7CmrlbzAcNaW/PRU1pcinptj2KNQUx56GmrKd9TJqyvYYg5oymA6hRT41ZY29CWo8jq6pkT0dNbXIpKbOtmegps6xZ6KmFpnU1EPtWaiph9n7oaYebu+PmnqEfQBq6iJ7NmrqkfYc1NTiWKamFscytWioPRe1aFgx0mg4HUFH2vNQ47FqLVpkz0ctOtJegBrn6lr8gH0gavFrWAe1+O/2QajFr9sHoxZz7PFX9iGoxf+2vkUt/roYdcyxi/72QtTERvahqAm7SEA4dDvre9TE9tYPqInB1r2oCY5d3GYfhppYg99Rk0VX1OQB9uGoydnFSOUcylHLQ+lh9HD7CNTkEXy8iB5pL0JNHmUfiRqP4GrtQ4uatg+jh9tHodY+wj4atfYi+xjU2kdib9Q4R9U6D9jHotZ53T4Otc4/7ONR67xhn4Ba581ipJ236Nv0M/o5/YJ+aZ+IWtK/GHuykX0SasngYqTJEHsxasnQYqTJsGKkfAd3LRlHb7NPRi3hqNPiSLaWzrJPQS3dzz4VtXR/+zTU0gPs01FLZ9tt1FLmkDKHlONNj7Q7qKVH2QlqKcfLmaeWnkXPpucUdg+1U9S6h9lLUOseXoy6ewRdRJkDZ4Za7wH7DNR6rxcV7/2DvmGfiVrvTfss1Hpv2Wej1mMOPebQ+9w+B7XeF/ZS1HpfFglkzCHbyF6GWja4qHs2hA61l6OWDbNXoMZ3KNeycfZK1LLb7FWoZcwhn2mfi1o+yz4PtXw/+3zU8v3xGmr5AXS2fQFq+Rz7QtQ4M9Ry5pAfSY+yL0ItP9q+GDV+ZlSN+5w1fi59jXcF1HjnVb10CFahXlpoX4J66VD7UtRLh2Fv1EvX4mjUS9fZl6FeuhN3o166q7D8oL0a9fJDWAf18lv0bfty1Mvv2FegXn4XCurlT+in9DP7StTLn9tXoa70t69GXdnIvgZ1Zbh9LerKCPs61JWRGIO6MoreUFoPdeVGehO92b4edeUW+8+oK7faN6CuTi6ti7o6hU61b0RdncbH0+2bUFdn2Dejrs60b0FdnYXXUFcPoLPtW1FX59i3oc55o67Oo/PpInokPcpeg7p6tH076tEh9h2oRwvtO1GPDi2SiZhPdKd9F+rRXfbdqMcP2vegHjOTmJnEb9N37HtRj5lJzEziT+37UI8/s+9HPf4cw1EX/e0HUBfF3FgXw+kIOtJ6EXUxyn4QdXFDkYC4sUhA3FQkIG7G76iLW+ithbxeU5dT7IdQl1Pth1GX0+xHUJfT7UdRlzOKBORMykwkM5Gz7cdQl3Psv6Au59p/RV3Osx9HXc63u6jLRXYPdXmknaEuj7Jz1DmT1NvsmfZC+wnU23faT6Levst+CvXOg/bTqHeYQ+cd+xnUOxx75z36Pv2A/pN+SP9lP4t65yP7OdQ7H9vPo975xH4B9c6n1umoJ/3tv6HOvZ16cmXRD8lV9Gp6Db2WXleklFxfpJT8uUiJdx/V0yn2i6in8+yXUE9Z6/RweoT9MurpIvsV1NMji3F1Oa7uQvtV1Lt32q+h3r3L/jvqvQft11HvcVy9d+x/oN7juHrv2W+g3nvffhP13gf2W6j3/mm/jXrvw+J/3vtXUd/eR/Rj+gn9tDDrb7+DOvcZ6hnHlV1lv4t6dnUxiuyaYhTZtcUosuuK+mbX0z8X5pPt91DPp9jvo57PK+qYz7c/QD3nuPIj6CL7n6jnR9ofosFtvFEqxtUo3Y4b0CjdQe+0/4UGt+tG+UE7QIPbdaP8Dn3X/giN8gf2x2iU/4nhaCj97U/Q4DreUC4qrYeGcjG9hF5KL6Or7U/RUC63P0NDucL+HA3lSvsLNJSr7C/RUK62v0JDuQa/o6HuU1oXDXVfOtn+NxrqFLyGhjrP/hoNdb79DRrqUfa3aKhH29+hoR6Dj9FQjy2M9rO/RyPavxhdxDFGHGPEMUZ32D+gEd1p/4hGxDHGD9o/ocHttBFzjPG7lGOMOUbR3/4ZDW6PDcExiovttWiIS+xf0BCX2r+iIS6zf0NDrMazaIjL6RX0SnoVvdr+HQ3BMfKdXA25r/0fNORk+79oSI5RzrP/QEMWPdmQR9Gj6TH2/9CQHGN7PwdotPd3Smi0DyjG2J5N59C5lGNvL3TKaLSLOarRPsxR0Ggfjl3QaB/hqGi0F/H5O5110GgzjfazuBmN9nN4GI328/QFZ1002q9iIhqdB5310OC23Ogwnw7zSfo7/4cGt8dGcp7zJzSS85310UgucDZAI7nQ6YdGcpGzIRrJxUUOydX0Gqc/GilzSPd1NkIjnexsjEbKHNJ5ziZocNtspAvogfQgejA9hC6khzqbopEe5myGRnq4szka6RHOFmiki5wt0UiP5GuOcbZCIz3WKR4fVySZHl/Y3Q/nodHd39kGje4BzrZodGc7A9DoznEGotFlnpwHGt2FziA0uoc6fWh0D3M0NLrMs3sEXeToaHTvdAw0usyzyzy7rzomGr0HHQsNzhiNHtPrMb2sv2Ojwa2+kZ3nOGhk5xf5ZBfQC+lFlOllTC+7xtkOjZzp8X1PjXyysz0aOdPL5zmD0ciZXs708gOdIWjkBzlD0cgPdoahwaODRr7QGY5GfqgzAo38MP7u4fQIusgZiQb3Hxr5MfRYZxQa+XHOaDTyIr1m6QBnDJql2c5YNEtzcB6apbkO0CzNc8ahWZpPF9AD6UH0YHoIXeiMR7N0qDMBzdJh2AXN0mu4H83yg/YZaHL+aXLOaXLOaSr9nYlocs5pKgswAE3lQHoQPdiZhKZyiLMDmsrJpfXQVE6hp9LT6Cp6rrMjmsq1Tn80lWJGbaqTnQqanG2a6hw61/4aTXWe46LJvYKmusDx0FQP5E8PcXw01YVOgKa6yAnRVI90qmiqR/GVR9PjnAhNlVlFBxT5RLMps4rm0nk4As1oPl1AD6QH0YPpIXShE6MZHVqkFDGr6DUkaMYPYgSa8UPmLWhy7mpy7moKZsW5qymYlTjQEWiKgxyJpjjYqaEpDoGKplhIT3b+hKY4xamjKU51GmjyM6+aYpXTRFOci2fRFNc6LTQFE+M+RlNOcXZCUzIxOdfZGU05z9kFTTnf2RVNuYDPMzF5CF1IF9Ejnd3QlEc5u6PJua4pj3P2QFMe7+yJZnuOsxea7bnO3mi2mUCbCfCO6GbnIfseNDkXNTkXNZP+zj5oci5qJvOK8Sbzi/EmC5x90UwOdCajmZzsTEEzOcWZimZyqjMNzeQ0ZzqayenODDSTtjMTzaTjzEIzSYqxJyldQlfQlc5+aCbXOvujmVznHIBmOtWZjWY6rRhRehQ92pmDZnqCMxfN9ER7FzS7c5x5aHbnOvPR7HIsXY6Fdx03ew/ZT6PJmaHJmaHJOxCanBma2TxnAZrZfOdANLMFRaWyA+nJzkFoZqcUdclOpafR02mbdpyD0cwS5xA0s9RZiGbGsWQrnEPRzFbyNdfS65zD0MynOoejmU9zjkAzP8pZhGZ+tHMkmvkJRXXyE52j0OKW3irNdY5GqzTPOQatUtG9rdIhdKFzLFrlFRiBVnklXUXPpefR8+kF9EJ6Eb2YPkgfco5Dq/yBczxa3PZbSj+6oXMCWsocDEBLmeuciJZyUmk9tJTF9GTnJLSUIpOWkjqL0VKWOCejpZzhnIKWciZfcxY9my6jy51T0VJWOKehpazk715Lr3NOR0u53mmjpRT7Wi2+86KlTnM6aKnTnQQttdiXbqlHOSla3Opb6knOErTUxfgYLW7pLW7prWgene+cgRa36Fa00DkTrXiFcxZa8UrnbLTiVc45aMXnOkvRis9zlqEVn+8sRyu+wFmBVnyhsxKt+CJnFVrxxc65aHHbb8UP2QFa8QfOeWhx22+Jfs75aIkNnQvQEsxKzHUuREswK7HYfhctcbJzEVqCWYmULqFnOBejJc50LkFLnOU00BLMSixzLkVLLHcuQ0us4CuZlWBW4jp6vbMaLcGsJLOS05zD0ZLTncvR4nFHSx7lXIGWPNrZEi15knMlWnKxcxVa7WKGbLVnU+bWZm5t5tae71yNVnuBcw1a7WKGbHFOaLUXOtei1Vle5NBZ4VyHVmclNkarcxG92Lkerc4lzp/R6lzK11xGV1Om13nIuQGtzsPOjWh1HsE6aHXeo+/TD5yb0OIM00rWpxs4N6OV9HNuQYvXf1sJE07mOreilSx2bkMrOctZg1ZytnM7Wsk5RXrJUucOtJJlzp1oJcuLrNLpzl1opTOcu9HivNFKj3buQSstVttWeiw9ybkXrXSxcx9a6cnO/WilpxR91T3AeQCt7mznQbS6c+hcOo/Opwuch9DqMivOOa0us+otdx5Gq7fCeQStHrPqMavexfQS51G0epc6j6HVu8z5C1o8O9riTNXi+yNavYedv6LVY1Y9ZtV733kcrd4HThctzmCtbH2nh1a2QZFS1q9IiVckWxmzyuY6GVrZ4iKH7Cx6tpOjlZ3jPIFWttR5Eq1s2f9zcNfhcpMJF8BPJpdSRXdx2QVuvMVdJ7nYTDLJSGZyK7hWaAt1g0kGd69hhSKFCrC4Ncnw7aL1AnXcHVqc78n55/fMkzv3Qs5J3jdvJlNuZ1btgZ3/xantQZ3/w6ltZtW+hI7pfA2ntsd2Zu+ZlCXWnkyndL6BU9tTO99EQciu8QrC6fSMzrdQEM7ESBQ4XhWEYZ1voyAM71yCgjCCWy6iI/ESCsIaXISCsDYzN7NzKQq5LI1Cbja9i95L76Nz6P10Hn2UPkufo893LkMhl6VXyG2gGzuXoyD2hIGC2KtzBQpi786VKIh9oKMgalSnBu1PB3SuQkHcn6/P6lyNgnh25zsoiFmeBfHqzndREK/pfA8F8dpsS8fAzjUocLwqdIyhYzvXotAxrnMnFDrGC7ug0DGlcx0KHVM716OQZ2750zs3oJA/o3MjCnnmxrGrkB9Gh1Pmlr+ocxMKeeaWX4OzUTBnYjsUzFmd76Ngzu78AAWTiZlMzLyv80MUzDmdH6FgMjFzXufHKJhMjCNbwXyu8xMUzOezlEwmZm7o/BQFc2PnZyhYPTs/R8Hq1fkFClbvLAeLiVlMzNI7v0TBMjq/QsHq3/k1CtYA7I6CtX/nNyhYZ3V+i4J1NjpQsJiYdXXndyhY1/A1E+sa2Pk9Chy1Cl1MrGssHdf5AwpdTKxrCp3a+SMKTSbWPL3zJxQ4OhWaTKk5vPNnFJojOjej0AyzfMLZnVtQCJlGeG/nLyiE92V7F87p/BWF8P7O31AIH+j8HYVwbucfKIQPdv6JQvhQ518ohA93/o1C+IgEFMJ5koBC+Ch/9036Fn2bLqHL6Qq6kq6SciiEqyURhfAdqQOF8F0p2/6e1AOFcI20NQrhWrpO6olCuJ4/3SD1QiHcmB2lUQ+6Ne0p9UYh6pVlHjH5SJf6oBCdkaUdnUnPkvqiEDHtVjYvFFrdUj8UWgOlbVBoMeEWE26NlbZFoTVO2g6FFhNuMeHWVDpN2h6F1qX4GAWu3Qrx6dIOKHB8K8TDpB1RiIdL/0AhHpHlHIfSP1GIo+x1MlvaCYXkLmlnFJJ7pV1QSJh5MofeTx+QdkUhmcvXD9KH6MP0ETqPMvPkTWk3FJK3pN1RSN6W9kAhWSLtiQI/PyokK6S9UEhWSnujkKzKskpW03fou/Q9uoaupevoerqBMvOUmadbS/9CIe2Z5Zwy85SZp7r0bxRSZp6eKe2DQnqWtC8KKTNvB9J+KLSza9RCeyBl5m1m3h4rdaLQHidJKLSZeZuZt6fuNx+F9jRJRqGdZV7kWFoUTpcUFDl+FoVhkoqiMAkvoShMplMkDUVhKl9Po5dKOorCZZKBotCU+qOYu1sagGLuHml/FHP3SgegmMu6KPIZ0WLuNfo6fUM6EMXcm9JBKObeQvZbS6WDUcwtg4Fibrl0CIq5FdKhKPIZ0aK4tXQYiqICHUVRpZp0OIqijt1RFM+gZ0pHoChm19JF8XzpSBTFC/j6QjqUDqPD6Qh6ER2Z2RFIR6HY0S0djSLH0mLHWDpOOgZFjqXFjgl0Ip1EJ9MpdGqnh2LHNOlYFDuYLcfbYv506TgU80Ol41HMD5NOQDHPbPOTpTyK+SmSiWJ+qmShmJ8mdaGYv1Q6EcX8ZbgaxXwz07wb26Fo3iOdhKJ5b5ahyWz5zGTRfE06GUXz9SxP840sT/NNymzNpdIpKJrM1lxOV2RaPaRTUbS23u8+FC1ma6lSAUVLk4ooWszWYrbWmZKNonWe5KBonS+VULQukFwUrQslD0WuN4vWMKmMojVcqqBoMVvrIqmKosVsed1Y7OqWaihy1C12jd3vGxS7xkk+ihx1i13MtmuiVEexa5LUQLGL2XI0LnZNlQIUu6ZJ3Sh2MdtmtpouNi+UBqLYHCoNQrE5TBqMYnN4lm1zBB1Hx9MJdCKdJA1BsTlZOg3F5lpch2JzXWbInMN7pNNRDNtZtuGr0hkohv8nnYli+F9uWSqdhWK4TDobxagjSzLaSjoHxahHlmG0NWWekSqdi2KkZRlGzJPjZ5HjZzE6SzoPxehs6XwUo3OkC1CMzpUuRDE6TxqKYnQ+cihGF9ALpWEoRkOl4ShGw6QRKEbDpYtQjEZII1GMLuJ7RkqjUIxGSaNRjEZLF6MYXSxdgmJ0SZZ/a6A0BkWOycXWUDqMDpfGotgaIY1DsXWRNB7F1khpAoqtUdJEFFujpUkoti6WJqPYukSagmJrjDQVxdZYaRqKrXFZLxzPi60J0qUotiZKl6HYmiQ1UWxNlkIUW1OkCMXWVKmFYnyBdDmK8YXSFSjGQ3EeivEwOly6EsV4hHQVijH7isdLV6MYs694onQNivEk6VoU48nZuRCvxXMoxusyE/aV3CNdh2LSlq5HMXlVugHF5P+yIz/5r3QjislSvl4m3YQivzVQTLeiPaSbUUzZFz+5K6aqdAuKqSbdimLKvjj2FtMzpdtQTM+SbkcxPTtLOz2HnivdgWJ6nnQniin7StlXeiEdSofR4XQEZV/pSDqKjqYXS9NRTC+RZqDI7wUU24OkmSi22Vd7mDQLxfZwQUCxPYJeREfSUXQ0vZheQsdIs1Fsj5XuQrE9Trobxfb47MxqT5DuQbE9ke+ZRCfTKXSqdC9s4TyMhC2cL90HW7hAmgNbuBDnwRaG0xHS/bCFi6QHYAsjpbmwhVHSg7CF0dJDsIUx0sOwhbHSI7CFcdI82MJ46VHYwgTpMdjCRFwNO3cdtoOdu57eQG+kN9Gb6S3SfNi5W6UFsHO3SQth527n9jvonXQ6nUHvpvdIi2Dn7pUeh805yM4tpjFNaErb0hOwc69iAOzcUrpMehJ2brn0H9i5bJy0cyvpKrqJvk8/kJ6CnftQehp27iPpGdi5j6VnYec+kZ6DnftUeh527jO+8/NMMUdF2kG3oj2kF2CL2XFoi4r0ImxRxe6wOa/Z4pnSS7DFi5GDLV4ivQxbHCO9Alsciw7YHYOlxbD5GajdcQG9kA6VYtgdw6QEdsc4QYDdMV5KYXdMkNqwOyZyyxQ6VXoVdsc0fAybc5adPz/rN38BZeN5Np4fQS+iI+ko6f9g50fjJdj5MdJ/YefHZs3mx9HxdAJl4+b10v9gmzdIr8E2b6Q30ZvpLdLrsM1bpTdgm7dJb8I2b5fegm3eIb0N27xTWgLbnC4thW2ya86JtnmPtAy2ea+0HDbnRNtcLK2AbbJrM5FWwjZTaRVssy2thm2ya5Ndm8vocukd2OYK6V3Y5krpPdgmuzY3Zf2a70trYJsfSGthmx9iL9jmR/Rj+gn9VFoH2/xMWg/b/FzaANti15YobYRtdUibYFtbSe/DtnpIH8C22LWlSB/Cttg151nbOlP6CLbFrq1L6BjpY9jWWOkT2F2DpU9h87Ndu4tdd10ofQa7a6j0OeyuYVmbXey6azydQNl1F7vumkqnSV/A5hxqN0dJX8JussHmxfQSOkb6CnZzrPQ17PA6bA07vJ7eQG+kN0nfwA5vlr6FHd4ifQc7vFX6HnZ4m/QD7PB26UfY4R3ST7DDO6WfYYfTpc2wQ3YX3ittgR3eJ/0CO8yu1e3wfvqy9Cvs8BXpN9jhYul32GEs/QE7TKQ/YYdp1le4nK6Q/oIdrpT+hh2yr3CTDNjh+1kj4ReyADv8ktu/ol/Tb+Qc7PBbWYQdfid3wA6/5/Yf6I90M91Cf8kaDH+Vs/+33+QesMPf5Wzf/5B7wg7/5Ou/5F6ww7+z8z1C9luRQHNyb9iRKPeBHfXI/q+ireW+sKPsOt/m2sqOJCpTRe4HO1LlbWBHZ8jbwo7OlLeDHY3JjoRorLw97GicvAPsaHx27rcGyzvCbvF4aPF4aF0o/wN2a2jWcovHQ2ua/E/YXFvZ8ajsnI1HyzvBjtl1fEk2Ssdj5J1hxzxzk+vkXWAn18u7wk5ukHeDndxIb5J3h51wTE5uobfS2yjH5OQOeQ/YyZ3ZMZBwTE7YdXIvvU/eE3YyR94LNtdldvKyvDfs5JWszWQxjeV/wU4S+d+wE3adsOtkhbwP7GSlvC9srrnsZJO8H+yEXSdf0C/lTtjJV7IEO/lalmEn38gK7ORb/vS77AxNvpdV2MkPsgY7YdcJu062yDrs5Bc5e/0r3/8b/Z3+Qf+kf9G/5f6wU8gDYKeCvD/sNCcfADsVs5+m7DrdWj4QdtpTPgh22ks+GHbKrlNZPgR2qsiHwk557qdnyIfBTs/MWk7ZdTqWjqPj5cNhtwfLR8Bus+s2u25fKB8Juz1UPgp2m123p8lHw+aazhHW4gU4nEkdzqRO7hZ6K72N3k7voHfS6XQGnUln0dn0LjpHPgZOLmvQyc2jj9KX5WPh5LI2ndxK+Tg4uVXy8XBy2erYyb1D36Xv0Q10o3wCnNwmOQ8nl7Xp5H6QTTi5H2ULTu4nWYST+1nugpPbLJ8IJ7dFNuCIPeWT4Ii9sCMcsbd8MhzeVXNEicryKXBERT4VDmdSRzyNnk7PkAtwxCxtRxxHx0sfwxEnyEU44kR0wBEn0cl0Cp2K1+F0DJZtOJxtHc62TseFsgOnY6hcgtORteBwVnU6psounI5psgeHs6qTXyOX4ZhsxLxZrsAxb5GrcMxb5Roc8zZ6O72D3kmn0xmyD8ecKdfhmLPkBhxzthzAMdmIOUfuhmOyEXOePBCOyUbMl+VBcEw2Yq6kq+TBcMzV8hA45jvyaXDMd+XT4ZhsxNwgnwHH3CifCcfclHVhshHzB/oj/Yn+TDfTLZlWT/ksOBYbsXrLZ8Ox+sjnwLHYiCXL58KxFPk8OJYqnw/HOk2+AI51unwhHOsMeSgci41YbMQaTydIL8OxJsrD4FiT5OFwrMnyCDjWFPkiOPyMw+F34pyuIfJIOJwTna4L6VB5FBzOiQ7nPodzn9M1TR4Nh3Of03wWJ8IJs6tQJ7xevhhOeIN8CZzwRnkMnPAmeSyc8GZ5HJxwujweTjhDngAnnIlt4YSz5IlwwtnyJDjhXfJkOOHd8hQ44T3yVDjhvfI0OOF98qVwwjnyZXA4uznhA3QufZA+RB+mj9B5chNO+KgcwgkfkyM44Xxuf5G+JP0KJ3xZbsEJ2Wz4rnw5nPA9+Qo44Rr5SjjhWvkqOOG6rNNwPd0gXw0n3Jg1FfWUr4ET9ZKvhRP1lq+DE/WRr4cT9c2a4mcfDlevTqTKN8Dh6tXh6tWJKrRKa9Sng+kQ+UY40WnyTXCi07MGowl0onwznGiSfAucaLJ8Kxw+/+m0hsi3wWmdlvXVOp0OlW+H0xom3wGnla1KnNZkOkW+E05rqjwdTmuaPAMOZzQnfhY1OMn16AknuYHeSG+iN9Pp8kw4yQx5FpyE3SWz6Gx6F72b3kPvpffROfJsOMn98l1wkgfku+Ekc+V74CQPyvfCSR6S74OTPCzPgZM8It8PJ5nH33qUPiY/ACdhd8mL8lw4yUvyg3CSl7PWOOs5ybvyQ3CS9+SH4SRrsD+cZC1dJz8Ch3cLnYTdJewu7Ul70d7yPDhpH/lROGlf+TE4/CzG4UrWSVV5PpxUkxfA4UrWSSvyQjhpVV4EJ63Jj8NJ2V3K7tIh8hNw0tOyvlJ2l06Qn4STTsQOcNJJdHJmm921h8j/gdM+TX4KDp9ZddpD5afhtIfJz8BpT5KfhdOeLD8Hpz1Ffh5Oe2rWV3sazborCQvgoiQspIvo4/ILKAlPyC+iJDyZbcndhp4o5W6nd9A76XQ6Q34JpdwD2Bal3Fz5ZZRyj8mvoJTLMi/lnpcXo5R7QY5Ryr2LASjl3pMTlHLrsD9KufVyilJug9xGKZdlWxJ7y6+iJPbBjiiJfeX/Q4nffiqJmvxflHinsSR68v9QEsvyayiJNfl1lESf2wfLb6AkDpHfRIlzSkkcL7+FkjhBfhslMcuwxG+ClzqG0NPkJSh1ZLmVOobSYfJSlLhSK3WMpxPkZSh1TJSXo9QxSV6BEj99LuWZW36hvBKl/CJ5FUr5x1FDKf9EpsnEzNvl1SiZd8jvoGTeKb+Lkjldfg8lc4a8BiWTiZlz6WPyWpTM+fI6lMzn5fUomS/IG1Ay35U3omS+J29CyWRi5nq6QX4fJZOJWb3lD1CymJjVV/4QJX5HqWRp8kco8f5hyfJoWf4YJasmf4ISv5dUsgbLn6JkDcmy4phfssbLn6FkTciyspgYx/ZS1xD5c5S6TpO/QIlPm5Q4qpe40il1jZe/RInfVi51TZS/QonfNirx20alZrb2LDUn0kl0Mp1Cp9LHsjyb8ymzbS6Uv0YpvE3+BqXw9iyx8LEsq3C+/C1K4QL5O5TChdkxFj4vf49S+IL8A0phOzvGwlfp/+hr9HX6Bn2TvkXfpkvoUrqMLqcr6Er5R5TCVfJPKIWr5Z9RCt+RN6MUvitvQSl8L2skZDvhevkXlMIN8q8ocZwvccVRiraWf0Mp6in/jlLUS/4Dpaiv/CdKHOdLHOdLkUo1qst/oRSxtags/41SVFOAEsf5UjSQDlIElKLB0u0oRWwwYoPReCWTDUZsMJpEJ9MpiohSNBUKShz/S60hSgdKLfbYGq9shVKLPbYmZsYTlB4oxROVrVGKJyk9UYonK71QiqcovVGKpyp9UIrZXTxf6YtSvEDph1K8UNkGpeQ2ZVuUktuz1hJ2l8xXtkMpWaBsj1LC7pLnlR1QSl7Ick7ayo4oJewuYXfJa8o/UEpeV/6JUvKGshNKCbtL3lJ2Ril5W9kFpWSJsitKyVJlN5SSZcruKHG1UkpWKHuglKxU9kQpWZW1k6ym79B3KbtL2F3CMyvZoOyFEsf5UtpD2RuldGvlXyilPbPzK+1F+yr/RonjfInjfClVlX1QSjVlX5RSXdkPpZTdpWWlE6W0pkgocZwv8ZuzpXSQIqOUDs76StkdVxmldLyioJSyu3SioqKUTlI0lNLJSvZfmaIYKKXsrj1Y6Y8SVyKl9jhlAErt8cr+KLUnKAeg1M66c4UpyoFwhakYCFeYphwEV7hUORiucJlyCFyhqRwKV8i6c4X5ymFwhffwENzcrcrhcHNZX25uvnIE3NwC5Ui4uawvN/cf+hR9mj5Dn1eOgpvLenRzbeVouLlXIcDNvakcAzf3lnIs3Nzb3LJEOQ5ubin+BTe3jC5XjoebW6GcADe3QcnDzW1UTLhiD8WCK26NHeGKfZUuuJwLXK4vXFFWToQrKspJcEVVORmuqKEHXFFXToErGtgdrtiflqhLPeVUuGJZKcAVa0oRLucOVwxot2LDFQcqDlxxkFKCy3nEFccrLlxxguLBFSdyyyQ6WSnD7Rgo9IDbMUipwO0YrFThcm3idlwsCHA7LqFjlBrcjrGKD7djnFKH2zFeacDtmKAEcDvYV35K1lSefeWn0UvpZUo33HyTrx9TMucr28DNv6sMgptnayb7MufTBcpguCb7MtmX+ZQyBK75tHIaXPMZ5XS45vPKGXBN9mW2lTPhmuzLfJO+RdmXuUQ5C665VDkbrsm+zOV0hXIOXHMD9odrblTOhWv1UM6Da7Evq69yPlzORK4lKRfAtWTlQriWknVkqZR9WTo1lKFwLfZlsS/LVYbBtTxlOFyrjF3hWjVlBFzLVy6Cy2/Rula3MhKuNTDrwhokvwmXs5jLlYtrTaDsy5qkjIJrTYYCl//Klts1SBkNl9/UcHk3z+26WLkYbtclyiVwu8YoY+DyyXCX/yKHy3+Rw+Uc5/Jf5HCbjylj4TbnowY3XJAlHy5UxsENFynj4YaPKxPghk8oE+GGTyqT4Ib/USbDDZ9SpsANnwbghs8oU+GGzyrT4IbPKZfCDZ9XLoMbvqA04YZtJYQbspdwqRLBDZcpLbgh8w9XKJfDDVdm+Yer6AblCrjhRuVKuFEH9oIbbaVcBTfKxi436kV70z60r3I13Kifcg1cftfV5ROebrQf7aSSci3cSM46igzaX7kObjQgyz/anx5ED6aHKNfDjQ5VboAbHabcCDc6XLkJbnQEf3ok7aIn0pOUm+FGJyu3wI1O4ZZTqU0dWlJuhRu5ym1wo7pyO9yoodwBN+IxEHUrd8KNeD5Gk5XpcKMpWcuc49zWQGUG3NYgZSbc1kXZmdgaqcyC2xqVNdgarcyG27pYuQsuv8vmxo8pd8ON2WnCTpOFyj1wk0XKvXCTx7PWkifok8p9cJP/KHPgJk9xCztNnlHuh5s8y9fP0efpC8oDcJO2Mhduwk6TpXSZ8iDchJ0mK+hK5SG4ySrlYbjJBuURuJyP3LRD2RtuupUyDy7nIzftpTwKN2WnaR/lMbhpX77up8yHm26jLIDLJ13dlJ2mncpCuKmkLIKbstOUnab9lcfhpgOUJ+Cm7DRlp+nBWTvpIdk5mB6anYPpYfRw5Um46RHKf+Cm7DRlp+mJylNw05OUp+GmJ/OdpyjPwE1PVZ6Fm7LT1FGeg5uWlOfhpi7fU6cN5QW4aaC8CDftVl6Cm7LTdLLyMtx0ivIKXM59bnugshgun7Jw2yOz1tpss30xzXr0hBYGwhPmwYUnPKr0hSc8psTwhKxZL5etOr3cXUoCL3e3ksLL3aO04eXuVV6Fl7sP/4SXm0PvV/4PHlc3Xm4uXUAXKv+Fl3tR+R+83EsYAC/3svIavNwryuvwcouVN+DlYuVNeLlEeQteLlXehpdrK0vgcWb0ckvpMmUpvNxK/AtebpWyDB5XRl5uvbIcXm6DsgIeV0ae2KGshCdupayCJ/ZUVsMTe0GHJ/ZW3oEn9lHehSf2Vd6DJ/ZT1sATt1HWwuPz/J64D92X7qesgyd2KuvhiQOUDfDErHFPPIgerGyEJx6hbIInHsktR9Gj6TH0WHocPZ6a1KJd9ETlfXjiKdgVnniq8gE8saB8CE8sKh/BE23lY3iio3wCT6zzPQ3lU3hioHwGT+xGDp44Rfkcnpg17vHZYK+jW/kCXsdA5Ut4fNbOy7eUr+DlL1e+hpdn1/lHlW/g5R/LWs6za5Ndm3cp38Iz7846Ne+h91J2bbJr8376gPIdPK7LPJNdmwuV7+GZLwLwzJeUH+CZLys/wjNf4ZbFNKYJTWlb+Qme+aryMzxzqbIZnrlM2QLPZNcmuzbXKb/AM9crv8IzN2Qtc03nWR3Kb/CsrbAjPKun8js8i13zSTyPT+J5Vl/aj25D2bXFrq19lT/gWfuhBzyrkw5Q/oRnsWuLXVsHK3/Bs47I2rGOVP6GZx2l3AzPOloFPOsYVYBnHUuPU3PwrONVEZ5l8j2W2gHP6sq6s06k7No6lRZoURkOz7L52qHs2mqoW8GzAjX7v2LX1hQ6Vd0aXld31mzXQMqWmy21J7zm5ZgDrzlP7QWv+ajaG16TLXP+9Xg/0Atn0dlqH3jhXWpfeOEDaj944Vx1G3ico71woZq5SN0OXpiN517YVreHF76q7gAvXKruCC9cpv4DXrhc/Se8MBuTvZCthavUneCF2TrCC9+ha+hauk7dGV64PmswylFR3QVe1FPdFV7US90NXsQGIzYY7UH3pHvRvem/6L/V3eFF+6h7wIv2VfeEx/nXi/rTAWr299lmdJC6N7zo4Czb6Dh6vPoveNEJ6r/hRXlqqvvAiyz+lC1EDXVfeFGg7gcvYgsRW4imqp3wommqBC+6NDv74pYqw4uZfzxPzbY8qqrw4sdUDR7nSi+ZqerwklmqAS+ZrfaHl9yVnU3JA3SuOgAe51MvWUgXqfvD43zqJW31AHjJq+qB8Dgnesky9SB4nBM9zolewvyTVXS1ejA8ruM83q/zkrXqIfCSdeqh8BLmnzL/VFQPg8e50kt7qYfDS3urR8BLmX+6h3okvHRP9Sh46V7q0fDSvdVj4KX/Uo+Fl/5bPQ5euk+WdrovZf5pf/V4eOkA9QR4nCu99CA1Dy9l/inzT4+nJ9A8NSnz53znpQ0aqCa8lPmnzD+dSqfRS1ULZaGldqEsXK6eiDLXa2VhvnoSyrks23JurnoyyrkHsS3KuYfow/QRuoAupIvUU1DOZZmXc236qnoqypyJyrllagHlXJZ5ObeCrlaLKOeynMu5NaqNcm6t6qAsAnuhLAo0p+yNsiiqJZTF3qqLsthH9VAWs9GpzPVaWdyN7q6WURb3QA+UxT2pQfurFZTFAWoVZc5EZfEAtYayeKDqoywepNZRFrNsy2KWVZmzQ1m8TG2gLDbVAGUxVLtRFiMoKIstenlmvqUORDmfHbfl/Ex1EMp5ppefrw5G2WR65lx1CMom0zMfUk9D2XxYPR1lk+lx/C+bC9UzUDYXqWeibD6unoWyyfTMV9WzUTaZnrlMPQdlc7l6Lsom0zOZnsn0zDXqeSiba7PELKZnCer5KFs59QKULRE7omz1pn3UC1G2+qpDUebYXraYnrW7Ogxlaw91OMoW07OYntWfDlBHoGztr16EsnWAOhJl68AsMesgyvSsQB2FMsfbsnUZbdKQRupolK1Wlqd1uXoxys0QA1FuRuolKDdb6hiUOQKXmzPVsSg3Z2VJ8q5duTlfHYdyc4E6HuXmQtRQbm6gG+kmdQLKzfcxGuWQmYdz1Ykohw+qk1AOH1Inoxw+rE5BOXxEnYpyOC/LPHyUMv9wYZZ2uEidhnL4uHopyiHzD19VL0M5XK42UeYoXQ5XqyHKHJnL4Ro1Qjlk5hHUFsqRoF6OcpRdIZejfuoVKEe7ZNlGu1LmHO2uXolydIB6FcrRgerVKEfMkKNrOaqp16Ac+eq1KEd19TqUo4Z6PcrRFeoNKEdXqjeiHF2l3oRydHV2BEbX0Gsz45Z6M8rxTPUWlONZ6q0o875ZOZ5PF6i3oRwzvZjpxRvV21GON6l3oBwzPY6l5WSueifKyYPqdJSTh6CinDxMH1FnoJzMU2eizM8+yhxvy8lCdRbKySJ1Nsocb8sJ00teVe9COVmu3o0yx9hywiOW42o5WaPeg3LC9FKo96KcClluXF+U037qfSinu6hzUE53Ve9HOd1NfQDldPfsaEwPoAfSg9S5KKcHqw+inNbUh1BO/ex4S+u0Qa+gV9Kr6NXqwyin16iPoJxeq3ajIkzHHFSEGXSmOg8VYZb6KCrCAvUxVIQst4qwjq5X56MibFAXoCJsVBeiwnGykpurLkIlN099HJVclk+F97gqufl0AV2oPoFKrq0+iUruVfU/qOSWq0+hwvGwwvGwwvGwklujPo1KLsunkvuL/p0pQn0GFTHLqiL2pf3UZ1ERt1GfQ4VX4xVxO7q9+jwq4g7qC6iIO3LLP9RhqIj/VF9ERdxJfQkVcWf1ZVTEg9ADFfFg9RVUxEPUxaiIh6oxKmJNTVARfTVFRczyrIgNeh29nt6gtlERb1RfRSU/Xf0/VPIz1P+ikp+p/g+VfHYWV/IL1NdQyTPDPDPMr1dfRyW/AaNRyW/MNB9Q30DFnKu+iYo5T30LFZMZ8r5TxZyvvo2KuUBdgoq5UF2KitlWl6HCe0oVc7m6HBWOihVztboCFY6KFXONuhIVjooVkxmafyt7o2JBXYWKxQwtZmj1U1ejYm2jvoOKtW2WkrWd+i4q1vbqe6hYO6hrULF2VNeiYv1DXYeK9c8sMWsnujNlhtbB9BB1PSrWoeoGVKwadkXF8tWNqFh1dRMqVkN9HxWLGVrXZ+lZN2TpWTdCQaU5Xf0AleaMLLfmAvVDVJoL1Y9QaS7Kcms+Tp+gT9L/0Kfo0/QZypyb69WPUWky5yZzDrOVSCW8X/0ElXCe+ikq4aPqZ6iEj6mfoxLOh4pKuED9AhWOipUwoSltq1+iwvtFlXC5+hUqHBUrIY9bjoqVcI36NSrhWuVKVMJfsszDX+lv9HfKLsK/1W9QifpmyUf91G9RibbJ0ou2Vb9DJTqErw9Vv0clOkz9AZXo8CzJ6Ah6JK3ThvojKlGg/oQKrzMr0TX0WnpdlnB0fZZwPD1LNWa28QK6UP0ZlXiRuhmV+HF1CyrxE+ovqMRPqr+iEv9H/Q2V+Cn1d1RiZhs/o/6BSsxs4/Xqn6jEG9S/UImZbTJH/RuV5P4sw2QefVQDKsljfM1skwWagErCbBNmm6RaDpWknY0GvG9TSZZrIiocMyscMyscMyvJGq0DlWStthUqyS9aD1T4FFMl+U3bGhU+xVRJ/tJ6opL8rfVCJe2r9UYl7ZclmTLbdFvKbNND6WFaH1TSw7W+qKRHaP1QSZktryErvIaspIG2DSq8hqykzDa9Nks1vS5LNb0eCqrC09q2qArPaNuhKjyrbY+q8BxqqApr6Fq6TtsBVWG9tiOqwgbtH6gKWW7V3Bztn6jmstyqucfofLpA2wnVXJZVNZfQVNsZ1dxybRdUOWZWc6u1XVHlmFnNrdF2QzW3VjFRzW3GXqjmtmi7o5r7RdsD1dyv2p6o5n7Tsu2/a3ujmvuD7/mT/qVlf+1v7Iiq2Jv2UVejKvbV/o2qmGVYFY/Q9kFVzPKpigHt1vZFVRyo7YeqOAg5VMVr6LVaJ6r814qqHWdm5p+Gi2r+GfqsJqGaZz555pNfq8mo5tepC1HNr88y4dhY5dhYNedkaZjMx2Q+5nxNQdVcoGWvmQ/vDFTNVNNQNZdrOqocD6vmas1AleNh1Vyj9UfVzK6xq+ZmbQCq5pZsT81f6K/0N/q7lr3/D+0AVM0/tQNRNf/SDkLVZD68kqxafbSDUbX6ZslYzMc6gh6pHYKqxXysbjpQOxRVa5B2GKrWNVkyVnbFUu06Qzsc1a4ztSNQbT6bJdN8TjsS1ebzWSbNF+iL9CX6Mn2FLtaOQrUZa0ej2ky4JaXMs7lWOwbVkFmF87VjUQ0XaMehGi7Ujkc1XKSdgCrX5lWOb9Uw1fKohsvVHVENV2gmqlyPV8NVdLVmoRq+o3WhGq7RTkSVV33V8Cf6s3YSquFm7WRUQ+bJ++FV3g+vRr2zoyjqo52CasR8oiO1U1GNjsqSiY6mA+kgrYBqlF2fVKOrs5RaZ2hFVFtn4idUW2dpNqqtszUH1dY5WgnV1rnZcdU6j55PL8iMmWT8nJb5vOahGr+glVGNX9QqqMYvaVVU45e1GqrxK5qParxYq6Max+o4VONEa6AaM8l4jRagGq/VulHlCFblCFZNFtCFdJE2EFVe9VU5mlWTVBuEarIcAqrJCrpSG4wqV9bVZDX9SRuCavKzdhqqyeYspYS5cR1dTXtpp6Oa9tbOQDXtkyWWMrf0SO1MVNOjtLNQTZlbytzSQdrZqKZXaeegmmZXxdX2GVlibebWPoueTc/RzkW1zdzazK19vnYequ0LtPNRbV+In1ATFsNFTYi1C1ATEmUb1IQsjZqwRrsQNWGtNhQ14QuMRk34UhuGmrAZD6AmbMnMLdKORS33uDYctdwT2gjUck8CqHHsquVS7SLUcm0IqPEObS33A/ZCLfejNhI1Mdv3mthLG4Wa2FsbjZqY7XtNPIoerV2MmniMdglq4rHYFTVxsDYGNXEIcqiJV9ArtbGodWT7W+s4WxuHWsc52njUOs7VJqDWcZ42EbWO87VJqOW5j/lYm4xaPjt3annuI8eiWn6tNgW1PPcx/6U2FbU89zG/RZuGmrkIKmrm4/QJ7VLUTO4jx5+amWqXoWa2tSZqvDar8anCmvmjFqJmcR/59GDN6q1FqFncR4v7aB1Nj9FaqFncR2uwdjlq1hDtCtSsK7K9s66EgloX97HrbHqOdiVqXefy9Xn0/MzmYu0q1JqxdjVqzXXaNag1s3G11tygXYtac6N2HWrNz7jlc/qFdj1qzS+zPW1u1m5ArblFuxG1kPsbcn/DJ7SbUAu5vxw3arwuqoVt7WbUeF1UC7m/4Y/aLahFvbVbUYu4j9EedE+6F92bHkAPpAfRg+kh9FDtNtSiw7TbUYsO1+5ALTpCuxO16Ej+lLlFzC06RpuOWnSs1g+1aHCWXjREm4FadJo2E7XodG0WalErO06iy7XZqLVm42fUWndlxou1u1CL46x9XtvU4vXa3ajFG7R7UIs3aveiFjOr+HPtPtTiL7Q5qMVfavejFm/WHkAt3gIftYRZJY9rc1FLntAeRC1hVhwZakmqPYRa0tYeRo3XObXkB+0R1JIftXmopb21R1Hj+V5L99AeQy3dU5uPWrqXtgC1dG9tIWpc99XSA7VFqKUHaY+jljKr9BDtCdTSQ7UnUUsPwz6opYdr/0EtPUJ7CrX0SO1p1NKjtGdQS4/WnkUtPUZ7DrX0WO151FJmlQ6hp9HTtRdQS1tZSunl2ouotWfjXdTaWVa+sFh7Cb6QZeXz2sbntY0vrNNehi+s116BL2xACb6wUVsMX9iE0fCF9+lnWgxf+BwPwBc2awl8IcvNz2W5+bnHtRR+7jvsBT/3Pf1Ba8PP/Ygd4Yu9tVfhc0zwxd3o7nQP7f/gi3tiT/jiv7T/whf/rf0PPp9V8EVdew0+74P5Yn86QHsdvri/9gZ88QDtTfjigdgHvniQ9hZ88WDtbfjiIdoS+OKh3H6cthS+eDx2hS+eQPPUpBbtoifSk+jJNKDddCAdRAfTIfQ0erq2DL44BTn44lRtOXxxmrYCvnipthK+eJm2Cr7YpKG2Gr4Yae/AF1vai/B5L87vmIl34XfM0jJna+/B72Bf+cXaGvh59pVfo62Fn1+rrYOfX6eth59fnzWVZ1/5jdoG+PlN2kb4efaV/0zbBD/PvvKbtffh59kXx0DffFz7AL7JvszvtQ/hmz9oH8E32ZfVW/sYvtVH+wS+xb6s3bVP4Vt7ZE1Z7Mv6F/239hl8S9M+h2/p2hfwLUP7Er7VX/sKvjVA+xq+tX/WhXUAZV/WQfRgeghlX9Zx9HjtG/jWCdq38C32ZZnad/Ati1vYl3Wi9j186yTtB/jWydqP8HnN5lvd2k/wrYHaz/CtQXQwHaJthm+dpm2Bb52u/QLfmqL9Ct+amuVvTaOX0stok4Y0oi3Kvrqma7/B75qRtdbF7rpmab/D75qt/QG/6y7tT/jNxdpf8Jvsrvmm9jf85ls64Dff5pYldCldRpfTFXQlXUVX03fou/Q9XYDfXKPn4DfXZu03N+ki/Ob7egf85idZ+81P9a3gNz/Te8Bv8hhobta3ht/kMRCCCnpP+Jwj/PBxvRd8Pv/gR3303vCjvnof+FF2bexHu+l94Ue76/3gR/vo28CP9tW3hR/tp28HP+rUt4cfSfoO8CM5O38jhb+lUk3P/qaeNRudoP8DfpTX/wk/MvWd4EcWt3fpO8OPTtR3gR+dxC0n67vCj07JOo1OpXXaoIG+G/yoGxr86Ax9d/jRmfQsera+B/zoHH1P+NG52VkZnUfPp6PoaHqxvhf86BJ9b/j8BpMfjaXj6Hg6gU6kk/R/wY8m6/+G35qenaGtGfo+8Fs8Blqz9H3ht2br+8Fv3aV3wm/dzffco0vwW/fqMvzWfboCvzVHV+G37tc1+K0HdB1+a65uwG89yPc/lBkv1vvDj2N9APz4TX1/+PFb+gHw47f1A+HHS/SD4MdL9YPhx8v0Q+DHy/VD4cc8fuKV+mHw41X64fDj1foR8ON39CPhx+/qR8GP38uOmXgN5fETb6Lv60fDj3n8xJ/qx8CPP8uOnJjHT7xZPxY+50o/gX4c/ETQj4fP5yv8tA/tq58An2t2P91Nz8NPd89GiXQfui/dj3ZSicpU0U34qapb8FNN74Kf8phJT6B5alIeM2kXPZHymElP1k+En56inwQ/5THD+wB+2tBPhp8G+inwUx4z6Rn0THoWPZueQ8/VT4WfnqcX4PMb0346Si/CT0frNvz0Yt2Bn16il+CnY3QXPr8J5fMZRT8dr3vw0wl6GX46Ua/ATyfpVfjp5GzcaPOYac/Qa/DbM3UffnuWXoffns3tHPPbd+sN+O178Cv89r30PjqH3k8foHP1AH77Qb0bfvshfSDqvP6vC7E+CHUhuzau8/q/LrxJ39IHoy68rQ9BXViin4a6sFQ/HXVhmX4G6sJy/UzUhRX6WagLK/WzURdW6eegLmTHRl14Xz8XdSE7NurCp/p5qAuf6eejzuuBurBZvwB1YYt+IerCX/BRF/7OzEEfinpOQBF1sa8+DHXei6iLO9Ad6T/oP+lOdGe6C92V7qYPR13MjqW6qFFdH4G6aOgXoS7210eiLp6ij0JdPFUfjbpY0C9GXSxiV9RFW78EddHRx6AulvSxqIuuPg510eNPy/p41MWKPgF1sapPRF2s6ZNQF319MupiXZ+CutjAbqiLgT4VdTE7furiefo01MXz9UtRFy9ADnXxQjqUDqPD6Qh6ER2pX4a6OEpvoi6O5t8Zo4eoi2P13VEXx+kR6uJ4vYV6x9365ah3ZMdAneudej6miX4F6lzv1PPsNP8WfZsu0a9EPb806yu/jC6nK/SrUM+v1K9GPb9Kvwb1/Cb9WtTz7+vXoZ5np/lP6Wf69ajnP9dvQJ3rpnp+i34j6nl2mv9bvwl1E/rNqJvs1Oqr34K61U+/FXVrB/021K0d9dtRt/6h34G69U/9TtStnfTpqFs76zNQt3bRZ6Ju7arPQt3aTZ+NusVOLXZq6fpdqFuGfjfqVn/9HtStU7AP6taptKDfi7pV1O9D3bL1Oahbjn4/6lZJfwB1y9Xnom55+oOoW2X9IdStStaaVaU16tM6ZadWQLv1h1G3zssasc6nF+iPoG5dqM9D3RqqP4q6NUx/DHVruD4fdWuEvgB16yJ9IerWSH0R6tYo/hY7tcbQsXSc/jjq1ngoqHfdrT+Behc7bbLTJjttJvqTqPP+T53XBvXmkuy8a27S/4M6Pxmsc61Xb36uP4V68wv9adS51qs32VFzi/4M6s2/9ONQb/6tP4s6P8WrR/2ydqJt9OdQj7bNzqNoO7o93UF/HvVoR/RGPdpNfwH1iF1Emv4i6pGuv4R6ZGTJR/1pgRb1l1GPmFvUrb+CejQw29NoEB1OR2RHdXSRvhj1aCS3MJNorB6jHo3TE9QjZsJ1XJ3ruHrrbj1FvcV8YuYTx3ob9ThRtkGdd3XqMfOJl+ivoh5v0v8PdX72V+f6rh5/Tr/Q/4t6/KX+P9Rj5hNv0V9DPf5Lfx31+O/suOXndPW0H91GfwP1dFv9TdRT5pNur7+FerqD/jbqKfNJd9OXoM65rJ7yWE11fSnqqaEvQz1lPinzSYv6ctRT5pN26ytQTwfqK1FPmU/KfNIR9CLKfNIx+irU07H6atTTcVkyKfPhvFBv36W/g3r7bv1d1DkvNITF+ntoCLG+Bg2O8w2O8w3hbbpEX4uGsElfhwbXbg3e82kIX+rr0RC+wgNoCF/T7+j39Af6I/2J/kw30y36BjSEP+CjIfxJ/9I3oiFkeTbE7fRNaIjb6++jIe6C3miIu+ofoMGxuiHq+odoiNmx1BD70wIt6h+hIQ7UP0ZDzPJpiBfTS/RP0BDH6J+iIY6FgkbHbP0zNDruyvad42EjH+ufo5FP9C/Q4HjY4BjYyC/Rv0Qjv0n/Cg2ugxq8F9TIf6l/jUb+K/0bNPLc9/x3+rdo5Lnv+R/079DI/6h/j0b+J/0HNPLc9/xm/Uc0uG5q5Lnv+T/1n9DI/5XtdZ77bm2n/4yGtb2+GQ1rF30LGtau+i9ocExrWLr+KxqWof+GhtVf/x0Ni/tuFfU/0LAGYjc0rEH6n2hY3HfrEv0vNKwx2V5b3Peu2frfaHRx35svZ/vefIUyB44hjWZiAI1maghoNJcaOTSaywwRjeZyowON5gqU0GiupKvoJmMrNDi2NJrMp/kl/crogUaT+TS/od8aW6PR/M7oiUbze6MXGs0fjN5oNH/EIjSaPxl90Gj+bPRFo7nZ6IcG1xeN5i/GNmg0fzW2RaP5m7EdGs3fje3RaP5h7IBG809jRzTCLcY/0Ah/Qfb6V/ob/Z3+Qf80/olG+JexExrh38bOaEQwdkEjEoxd0Yhyxm5oRGL2zqjD2B2NaCu+7kG3pj1pL9qb9qF9aT+6nbEHGtH2xp5oRLsYe6ER7Zp1F7HBSKcG7W/sjUY0wPgXGlG2qm1EBePfaERFI3ttG/uiETlZpxGbjQYZ+6ERsdmIzbbOx09otGbiZzRas4xONFqzDQmNFvuN2W/8iiGjwTGwEceGgkacGCoacWpoaMRLDR2NeJlhoBEvN/qjEa8wBqARrzT2RyNmv7yeb3BsbMTsN/7SOACN+CvjQDTir42D0IjZb/ytcTAa8XfGIWjE32edxj9Q9hv/ZByKRvxzdpzHm43D0Ii3GIejEf/CLb/S3+jvxhFoxH8YR6IR/5ltSbYYR6GR/GIcjUbyK/2N/k7/oH8ax6CR/IW90Uj+zkxBBZqjIu0wjkUj3co4Do20h3E8GunWtCftZZyARtrbyKOR9jFMNNK+hoUGR/hGup3RhUa6fTYupbsYJ6KRst9UM05CI9WNk9FIDeMUNNL+xqlopAOMAhop++Wo3kiL1DaKaKSOYaORst90kOGgkbLflP22z8PnaLTPp1cYJTTa7Lo9y3DRaM/OWm5nXQfCs3ARCM8ZHgLheUNGILzALS/Sl+jLRhmB8IpRQSAsNqoIhNioIRASw0cgpEYdgdBGtuVV+jZdYjQQCCuNAIGwyuhGIGwyBiIQ3jcGIRA+wGgEwof0E/op/Yx+bgxGIHxhDEEgfGmchkD4yjgdgfC1cQYC4RvjTATCt8ZZCITvjLMRCNkxEwg/GecgEH42zkUgbDbOQyBsQRFB7g/shyD3p3E+ArHDuACBuBV6IxD70n7GhQjEbYyhCMRtjWEIxO2M4QjE7fnTXYwRCMSsqUBUqGpchEDUjFMRiNnaMOA8EohFahsjEYjZ2ReIA+kgOtgYhUAcAg0B55dAzJoKOq4wRiPouBI/I+i4id5Mb6G30tvo7XQ6nUFnGhcj6JhlXIIg/6wxBkH+OVyDIP88fYG+SF+iL9NX6GIa04SmxlgE+bYxDkGePebfNsYjyC8xJiDIrzQmIshn53WQ30TfNyYhyH9gTEaQZ4/5T4wpCPKfGlMR5D8zpiHIf25ciiD/RdZO/kv6Ff2afkO/pd9R9pj/if5MNxuXIchvMZoITPZoskerwwgRWOzRYo9WP7oN3ZZuZ0QILPZo7WK0EFjs0WKPlmpcjsDSstYs9mgVjCsQWEXjSgSWnbVmsUfOj4E1yLgKgTU4685ij5wrA86VQdcVxtUIuq40rkHQxR67bjauRdB1i3Edgq5bjesRdN1m3ICgiz12sceuGcaNCLpmGjch6JqVnY/N24ybETRvN25B0LwjO/uad9JnjVsRNNlvk/022W+T3TVT4zYEzbZxO4Imu2v+j75GX6dv0DeNOxA03zLuRNB825iOoLmEf2ElXWXMQND8wJiJoPmhMQtB8yNjNoLmx8ZdCJqfGHcjaH6a9dL8xbgHQfNX414Ezd+M+xA0f8/OtXBz1lG4hbKvkH1FHcYcBNFWxv0Ioh7GAwiirY25CKKexoMIol7GQwiinbKmop2NhxFE7ChSjUcQRJoxDwHv3QXRKfRUWjAeRRAVs3a4Fgiibsq+okHGYwg46wWc9YLWCPyEoHWRMR9Ba6SxAEFrlLEQQWs0t18h5BC0rjQWIWhdZTyOoHV11lHrGnotvY5eT2+gN9KbjCcQtG7mX7iF3mo8iaB1m/EfBK3bjacQtO4wnkbQutN4BkFruvEsgtYM4zkErZnG8whabD++DaMQxLcbLyCI7zBeRBCz/fhZ4yUE8XPGywji541XEMRsP2b7cWosRhC3jRhB/KqRIIjZfvyakSKI2X78htFGEL9pvIogfou/9TZl+zHbj1cZ/4cg/iA7u+MP6UfGfxHEH2eNx59Qth//Qn+lvxn/QxCz/WSz8RqCZIvxOoKE7SdsP+3A3gjSrWgPujXtSXsZbyBI2X66s/EmgpTtpyrVjLcQpLrxNoL0FGMJgvRUYymCtJC1nLJ9rnSCtNtYhiAdaCxHwJVOwDkx4JwYtIfjcwTtEXQkHUVHU7bfvpJeZaxA0L7aWImgzfbb1xqrELSvM1YjaF9vvIOgfYPxLoL2jcZ7CNo3GWsQtNl+m+23b6W30dvpHcZaBO07jXUI2tON9QjaM7LG2zNp1n63cAdGoVu409iAbmG6sRHdwgxjE7qFp+GiW3iGPkufo8/TF4z30S20cQ26hVeND9At/J/xIbqF/xofoVv4n/ExuoXXjE/QLbxufIpu4Q3jM3QLK/n+Vcbn6BZWG1+gW3gHJXQLHxlfolvIGu8WfjO+Qrfwu/E1unM/Yz905zZjb3TnthjfoFvMuusWexnfolvMuusWdza+QzdXW92ibnyPbvEk7INu8WTjB3SLpxg/ols8FbuhWwxot/ETujkbdnM27O64Ssihu+Nq42d0d/w/B3cdJkeRvwH87enduCsWiBCi7UuEEKCnN45GgPGZ7nHZE869p8Pd7wzOcDng7jggOMQToKeD+6FBEjSCnuBEfk+9/3yeeXpnoeqtqm+1bX4z+zMkun47+3Mkui6a/QUSXRfzp5fSy2Z/iUTX5SK3rivolfSq2V8h0XU1P4sznITNVO3L6OX0itlfI8G3iRL2xtkHkLA3zT6IhL159iEk7C2zDyNhb1WAhM1U7QfpQ/Rh+gh9VJGQsB8TedqP8whTtV9QYkjYL4o8baZqv6PISNhM1f6Kfq10IRH/VGQYZ6rxz4XOAKUbCWeg0g8Jh6k645X+SPA6LuHMUgYg4TBVZ4kyEAlnqUjSYarO+Yr4fkIZjAT3pgT3pkQvU+39Nf0N/S29iDLV3kuVIUj0XqYMRaL3cmUYEr1XKMOR6L1SGYFE71XKSCR6r1ZGIcFrwIR/pTIaCf8qZQwS/tXKWCT8a5RxSPjXKuOR8P+qHIGEf51yJBL+9SJnn2n7G5WjkOBek/BfUI5Gwt8l8vF30zfom/Qt+jZ9RzkGCZ/p+UzP/1qZgEQgKkki6K8ci0QwQBGfB4rEuI8kgvHKRCR49ZQIZs1+EolgtjIJCd5HSvD5TiJYogjPFekF59HzlSlI8JlOgntHgntHYu2VIqW1VynHI7H2amUqErxWSoRXihkVXkWvptfQa+lf6XXKCUiE1yvTkAiZQ8gcWHUT4QvKdCRC5hDuVmYgEb7B42/St+jb9B1lJhKsxomQOYRfK7OQYEVNRP2V2UhEA0TfI+bAipqIxisKErxflIhm0dmKigTvFyWixYqGRLRE0ZGImEN0nmIgEZ0v+s7nFAlW0UR0gWIisYM57LhKsZDgdUSC1xFJ6Tp8E0npeqUHSekG5UQkpb8pc5CU/q7MRVL6B85CUrqR/pNuoBuVeUhKm5T5SEri3CbJupSUXlBOQlJ6UVmAJOtSUnqFvkp3KScjKe1WFiIpvaOcgiTrVVI6oJyKpCSu+5KyyCQp91dOQ1IeoNhIyiKTpDxOiSMpj8cEJFmpkvIsOpsqioOkvFjpRVJegqOQlM+l5ymLkGSlSsoXKIuR7BI5JLuuUpYg2XW1shTJrmuUZUjazMG+XlmOpH2DsgJJ+2+4EEn778rpSNr/UM5A0r5RORNJmzmwCiX5TmPS3qQINytnI8lKkrRfoC8q5yDJSpK0mYP9qrISSXuXsgpJeze/846yGklWmKR9gB5U1iDpMAenPx2gnIukM1A5D0lnnHI+kg5zYG1JOrOUBJLObCWJpKMoKSSdxUoaSYc58H510jlPySDJ2pJ0LlCySPYyh96r6NVKDsnea5Q8kv4NSgFJn333b1RcJH32l+8oJv3Niockz2OTPI9NsiYk/ReUIpL+i0oJSZ/99V+mO5Uykv4rSgVJ/1WliqT/mlJD0n+dP92l1JH0mYP/jtJA0n9XaSLpizOWJM9Xkz4z8Q8qLST9Q2KG+IeFgdjFksFApQ/JYIyYIcFYOk75BpIB8+Fdl2SgKN9EMlCVbyHJuy7JYLHybSQD5hMspyvo6fQMeiY9i55Nz6Er6Sq6mq6hzDk4T7kASdafZHCB8h0kg+9gOpLBd4VrrxZpr71G+S6Sa68Vq4/3q5PhDcr3kAz/pnwfyfBG5QdIhsycbzMmw83KD5EMtyg/QpJnj0nWn2T4wuzzkQxfVH6MZMjMQ2Ye7lR+gmT4Cr/zKn1N+SmS4evKz5AMd/EIMw/foe8qP0cy3KP8AkmeJSZDZh4eVHwkw0NKG0ne907yrC8ZDVQCJCNmHo1V1iIZjVMuRDJi5hEzjxTll0hGqvIrJHknJBktVv4PyYiZ8wwwGa1Qfo1kdLryGySjM+iZ9Cx6Nj2HrqSr6Gq6hp5Lz1N+iyRrXTK6gH5H+R2SETPfwcx3XKP8Hskd1yoXIcl74CnWvZR0Pb1BuRgpScz5lHQj/afyB6Skm5Q/IiXdjLOQkrYof0JKEvmnpBfpS8qfkZJeVv6ClLRTuQQpaY9yKVLSXuUypKR9uBMpaT89pFyOlHRYuQKpGJQrkeIzzVRsHB2vXIUUz8pS8kDlaqTkURiElDxauQYpeYxyLVLyWExASlaVvyIla8p1SMk6JiPF95dSci9dRBcr1yPFGpiSlyo3ICUvU/6GlLxc+TtS8gqMREo+X/kHUnJCuREpOYmZSPH+eUr+jvJPpOTvKjch1SVyS3VdQ69VbkaKzxNTrJMp+3rlFqTsG0RWrJMpm7nZ/6Q30ZuVdUjZW5RbkeI9jZTN3OyX6MvKbUjZO5XbkbL38Mhe5Q6k7H3KnUjZzM0+RA8L41DuQorPDVNx5hYfr9yNlMPcnIHKPUg5o5R7kXJGKwpSzhiRmMPcHJVqynqkHF3ZgJRjKBuRcpibs0jZhJSzWNmMlLNE2YKUs1TZipSzDMcg5SxXtiHlMDfnfJqgSWU7Uk5KuQ8pPrNLOd9V7keql7n1XqM8gFTvtUqIFJ/ZpXzm5l+vdJDymY9/sxIh5d8iZpe/jm6im+kWZQdSrLEpfx/drzyIlP+e8hBS/vsiE/8D+iE9pDyMlH9YeQSpNpRHkWozq/Y45TGk2uOVx5EKILIKJBqjMmWGwUDlCaSCEWLuBSPpKOVJpILRIj1e16eCGXQmnUVnU4Uy50BTnkIq0JWnkQoM5Rmkgl7lWaSCRcq/kAqWK88hFaxQnkcqSIokg5TyAlJBWnkRqSCjvIRUkBV5Bjn6PeVlpFg5U2uvUXYitfYBkefaEO8hFV6nvIJUeL3IM2Sq4c30FuVVpMJ1ymtIsYqmws3K60iFYudKsYqmQqYa7qfv0feVXUiFHyi7kQqZanhIeQMpVr9UB5SpdsYpbyLVGa+8hRTvOaciSXkbqSimvINUJCvvIsVqmYoGKnuQiphqNFLZi1Q0StmHVMRUo+nKfqSiGcp7SEUzlfeRimYpHyAVzVY+RCpiqpGqfIRUpCkfIxVx1UeG8m+kol7lP0hFi8QsjZbTFcp/kYqYapSiaZpR/odUlFU+QSrKKZ8ixaqY2nGN8hlSO5jnDpFnWhJnwmnpGnot/avyOdLSdcoXSEsi57R0C12nfIk0/2I6Ld1Gb6d30DuVr5CW7lK+Rlq6m0fuofcqB5CW1vPzBrqRbqKblYNISx8oh5CWPlQOIy19pAJp6WPcibR0iB5WJaRj49QY0rHxqCIdO4TjkI4dFsqgkiojLcfULqRlWe1GWu7i8W7aT+2HtNxf7Y+0PEAdgDTPMNPyCHUg0vJIVXw+HhOQlqfSE+g0Ol0djLQ8A5ORlmerQ5CWFXUo0nKcRxzaqw5DWhbjkpYX0yV0KV1Gl9MV6nCk5SxGIi3n1BFIy3lVfC5gJtKySz0hz1rTXVfRq9VRSHddo45G2uZ42deoY5C2r1XHIm3/VYyOfR3leNkcL3udOg5p+1Z1PNL2beoRSNu3q0cibd+hHoW0fad6NNL2XeoxSNt3qxOQtu9Rj0Xavlc9Dml7vToRaXuDOglpnvGmbY6XvVmdjLT9AX6DtP0h/UidgjTvDKdZvdP2YfV4pOPj1KlIxzle8UPqCUjHD6vTkHagTkfakdQZSDsxMQqOTLvUmUg73eospJ1+6myknf6qgrQzQFWRdjhezghVQ9oZKcbFOV7VkXamqgbSzgmqibQzTbWQdqarPUg7M9QTkXZmi9FxFMrxchx1DtJOrzoXaYfj5XC8nCXqPKSdpep8pJ1l6klIO8vVBUg7K9STkXY4Xk6O5mlBXYi046qnIO1wvHh2ne69Sj0V6d6r1dOQ5tV32r9cjJd/Bb2SXqXaSPtXq3Gk/WtUB2l/g9qLtL9RXYQ0d4S0v1ldjLTPnP0P1SVI+x+pS5H2mbPPnP3D6jKk26JGpVn50+0xdKy6HOn2OFV8Zv7tQ+rpSLcPiwwDUImOUM9AOmCewWQ6RT0T6YBZBY56FtJBr3o20sEi9RykA2YVLFFXIh0sxWCkg2XqKqSD5SKTYAV1qaeuRjooqmuQDkoin6BMK0JeoafXXqWei/SFMVGFLpTV85AOLxfJhFeo5yMdXqkmkA6vElUovFrM5/AauoFuVJNIs86nw81qCumQWYUfqmmkw49EPiGzCplVeFh5A+kO1AzSrOfpDrPqjFWzSHfGiZQ6zKpzSM0h3Tms5pGOoBaQjphVNEJ1kY6YFd9OTEdTVA9pvmGYjhy1iHTUq5aQjhapZaSjxWoF6WiJSCliVtEytYp0tFytIR2tUOtIR8wq8mhRbSAdldQm0lFZbSEdMasdV6p9SPOuY/pBZvWgrH4DGekifBMZ6WJ6Kb1MFV6ufgsZ6QpciIx0L11PN6jfRoa1NyNtUi9ARtqsfgcZ6QP1u8hIH6rfQ4b1NiMdVr+PTAzqD5DhWWsmNkb9ITKxseqPkGHtzcQOqz9GRob6E2RkkU9GHqH+FBlZ5JORJ6s/Q0aegsnIyL3qz5GRF2EwMvJyukL9BTJyWfWRkUUfM12XSzFkuq5Q28h0XTn7dWS6RH8z3aK/mW72175IDZCx2V/7UnUtMvZl6oXI2JeL3tnsr83+2uvVXyJjb1B/hQxrV8bepP4fMnzilrE/UH+NDJ9qZexDqjhyWP0tMnGov0OGZ5uZ+Bj198jEx6KKTJz9jR9WL0LGgXoxMg7764xQ/4AMa1HGmaz+ERmH/XV61T8h47C/znL1z8jwTDLjlNW/IMO3xTK97G/vFeolyPReKXrKJ0qZRezvIvbXv0j0xb9YvRQZ/y+i1/4l9FL1MmT8y5QvkPHZX3+9ejkyvGuX8dlff5N6BTJ8ApXx36Pvq1ci43+gXoUMzx4zvMrO+AfpIfVqZHiVnWmPoqPVa5Bpj1GvRabNHNoH1L8i0z6oXodM+5B6PTLtw+oNyATDRBrBcPVvyAQj1L8jw3qSCSar/0AmYCZBr3ojMgEzYd3IBMvUfyITlNUGMkFFvQmZoCqSCWpC1oTMhcwhvEi9GZnwYvUWZPjMJRNeoo5BJrxUXYdMeJlIIGQO4Xr1VmTCDeptyIQb1duRCTepdyATMgee72XC99U7kQnFWXSG53sZXvlmwoPqXciEh0Tfee6X6TCHzmj1bmQ6Y0QCHebQOaDeg0znoOh15xAVdTUTDVPvRSYarq5HJhohEmCtyEST1Q3IRMwh6lU3IhMtUjchEy1VNyMTLVO3IBOVxQyJKupWZKKqug2ZiDlwvWe43rPSRep2ZLnes9If6B/pX+gl6n3ISnfiQmSlu+jd9B56r3o/stJ69QFkpQ1qiKy0Ue0gK21SI2R5Ny8rvUffV3cgK4lMstJB9UFkYyOwAtnYSDpKfQjZ2GhUkZXF3p2VZdqP9qcD6EA6hA5VH0ZWHqY+gqw8HBOQlSfSSXSy+iiyrBVZXtVm5UXqY8jKizEYWXkJXao+jqy8DCORlau0pj6BrFxXn0RWbmAmsqwVWdaKrH2R+hSy9sXq08jaf1CfQdb+o/ossjazsi9R/4Wsfaf6HLL2XerzyNp3qy8ga9+jvoisfa/6ErL2epSQtTeoLyNrb1R3ImtvUl9BljUka7+nvoosn5JneQcvax9UX0M2zqziI9XXkY2PUnchG2dWTkzdjawjq28g6/Sb9TmyTn/1TWSdAepbyDoD1beRdYao7yDrDFXfRdYZJpJxmJUzUd2DrDNJ3YusM1nkwzqT5ZVs1lmk7kPWWazuR9ZZor6HrLNU5OMwK4dZOTVaV99H1mmoHyDLOpNlncn6f1I/RNb/s0jG/4v6EbL+JerHyPq3ipnj30Zvp3eo/0bWv1P9D7L+XSIff5P6X2RZZ7K8Ys36++l76v+Q5RVr1j+gfoKsf1D9FNn2MJFPe7j6GbLtEernyLZHql8gy3OSbCCpXyIbxNSvkA1k9Wtkgy71ALJBN3/aTz2IbNBfZBIMoAPVQ8gGg/jTwephZIMhyoXIBkP504kakA0miZQCZhUs0iRkg7pII2goLyAbNLUYskFLzB/WnCxrTjb8kyYjG/5Z60I2/IvWjWx4iUggZCbhbVo/ZMPbtf7IhneIHMI7KTMJN9HN2gBkeb2ZDfeL2RK+J9IImUl4QBuILN96ynaGaYOQ7QzXBiPbGSFmS2ekMII2BNlI0oYiG8W0YchGsjYc2ahL9C7q1kYgG/XTRiIbMZOImUQDtVHIRoO00chGg/nTITzOTKKJ2hhkI2bCv4bIRou0schGzCRq0KaQlSfLypOTbtXGISfdpo1HThK9y0mbtSOQk8SVdY73x3LSPrpfOxI56YB2FHK8t5+LDaFDtaORiw3TjkEuNlybgFxshHYscjHR05wMTEJOlrTjkJNj2kTkZBkTkJMnauK4aG2OlSEnL9KEizESOXmJkKs+1y1rU5Czb9WOR86+TbTQZjvtzXSLNhU53o/K2fu0E5DjvaacfUCbhpx9UJuOXJztjA/VZiAXH6bNRC4+HPOQi4/QZiEXH6nNRs5hOx2JxkQLHbbTmagpyDlsJ1dlzlmkqcg5izUNOYft5IrLLZKFvrg6y/nr1OeQ82/VdOR8ttnfohnI8f5Pzt9D99J9IlWfbeaunfMPaiZy7WGahVx7uNaDXHuEaG17pHYicu1R2hzk2qLy5AK2OZC0ucgFbHPANgddtJv2o/3pADqQDqKD6RA6lA6jw+kIOpKOoqPpsfQ4OlGbhxxXYi5YrM1HLmAmIXMI12knIRfeKhIImUO4RVuAXLhVOxm5kDmEe7WFyIX7tFOQC5kDd+1ceFA7FbnOMO005Docrw5z6IykozQbuQ5ziCBaEkk0psWRi2TNQS7q0nqRi7q1RchF/bTFyEX9tSXIRQO0pchFA3lkkLYMuWiwthy5aIi2ArloqHY6ctEw7QzkouH8zgjtTOSikTw+SjsLOd7tyUXMITpOOxu5aKJ2DnJcfblosbYSuUjkkOfdlby0TluFvLQFJeSlrdpq5KVt+A3y0na6h+5Vf408d+e8dFBbg7x0SDsXeb5Tneday8eG0mHaecjHRCb52CjtfOS5a+dlSUsgL8e0JPKyjEnIy6O0FPKyaG1ePlZLIy8fp2WQlxdrWeS5yvJcZfluMXvzvLeQt9dpOeRtttbequWRt7dpBeT59lre3qMuQd7eq34Pee6PefsgPaS5yPMt6DxXXD4+VPOQjw/TishzxeXjo7QS8tw381xreSdG2VpnlFZG3mFrnWO1CvLOcZiMvLNYE99na7nW8otkrYY877vm/Zu1OvL+LVoDeX+d1kTe36a1kPfZWn+P1oc8nz3l/UPKG8j7h7VvIN8eJFrYHkyHaN9Evj1U+xbyXGv59kg6Svs28lxr+WCUdgHywWjtO8gHx2rfRT44Tvse8sFE0ULO/3wwmU6hi7XvIx8s0X6AfCB27Xwgdu08d6L8hbL2Q+QvPEX6MfIXnioMb9J+hHx4syY+3yKSD9fRbdpPkA+3az9FPtyj/Qx5PtPJh4e0nyMfHtZ+gXyHfekM1nzkO0NEyztDKfvSGam1ke+MEr3geslHo0Ta0WgtQD46VluLfHScdiHy0UTtl8hHk7RfIR9N1v4PeZ7Z5vn3dPloCV2q/Rr5iH3hPpJ/UNZ+g/yDp2i/Rf7BU7XfoSDdpP0eBelm7SIUJNGXgrSO3kpvo9vodu1iFKQ92h9QkPZqf0SB878gHdT+hIJ0SPszCpz/hdggOlj7Cwox0a9CbKR2CQqx2aiiEFOoSjWqU4Oa1KLz6Hx6El1AT6M2jVNHKIusCvJo7VIU5DGYgAKf3RTkY+gEeqx2GQrycfzmZO1yFHjGW5CPp1PpCXQaXap9HwV5mXYFCvZN2pUo2DdrV6Fg36JdjYK9TrsGBftW7VoUuN8VbGZlb9f+igKfrRTsvdp1KHD1FeyD2vUo8G8QClx9hfgg7QYU4oO1v6EQZ1bxkdrfUYgzq7ii/QOFuKrdiEJc0/6JQlzXbkIhbmg3oxA3tVtQiDOrOLOKz9fWoRA/SbsVhfgC7TYU4swqbmu3oxCPa3egEHe0O1FwmJUzmo7R7kKBz2sK/DvxgjNBuxsF51jtHhQcZuVM1u5FwZnCz8fTqfQEbT0KzjRtAwr8m6+Cs0zbiIJ/k7YJBf9mbTMK/q3aFhT827StKPi3a9tQ8O/QtqPgi7O1As9gC/7d9B56n3YfCv79Ij1/j3Y/Cv5e7QEU/H1aiAL334J/QOug4B8Us649SItQaA/WdqDQHqU9iALrQKE9ho6lzLOtaA+h0Da1h1FoW9ojKLR7tEdRaJ+oPYZCe472OArtufzmPO0JFNrztSdRaJ+EW1FoL9CeQqF9Mn+6kJ5CT6WnaU+j0La1Z1Bo92rPohCM0f6FQjBWew6F4AiRKv82oRAcRY+mTDuYoD2PQnCCyDOYpr2AAt/YLATLNBWFYLn2Igq8p1cIb9JeQiG8WXsZhfBW3IFCeBu9nd5B79R2ohDepb2CQni39ioK4T3aayiE92mvoxAy1XCPtguFcC/SKIT7tN0ocDcvhAe0N1Dg+XCBlarQGay9iUJnlJiZndHaWyh0xmhvo9AZq72DQoepdhTtXRQ6pkipY2l7UOj0aHtR6JzII3O0fSh05mr7UejM45H59CTtPRQ6C7T3Uegw1c5C7QMUOqdoH6LQOVX7CIXOadrHKHRsfr9XGI0RKUVjtX+jEB2h/QeF6EjtvyhER2n/QyE6WvsEhegY7VMUognaZyhEJ2ifoxBxLUdMNVpGl2tfoBCJVF1J7E2udLP2JVzpFtwBV1pH79W+giut176GK23QDsCVNmoH4fLc25U20/vo/dohuNIz2mG40rM64ErP6xJc6QU9BpfPrF3pJbpHl+FKInlX2qd3wZX2691wpQN6P7g8V3djg/T+cGOD9QFwYyJ5NzZaHwg3NkYfBDc2Vh8MNzYTVbixWXS2PgRuTNGHwo2JuerGFujD4MZO1ofDjS3UR8CVx2mL4Mrj9ZFw5SP0UXDlIzEJrnwCnaaPhssnKS6fpLg8z3f5XNvlG4muvIQupcvocn0MXHmFPhZu1+XYD7frCn0c3K6H9PFwu57Ae3D5PNq1b9aPgGvfoh8J12a29r10Pd1AN+pHwbU36UfD5fWCazNb+379GLj2M/oEuPazuAmu/Tx9QT8Wrv2ifhxcPtd27T36RLg2s7X30f36JLj2AX0yXJvZxgfpU+DGB4tU48w2PpqO0Y+HGx+rT4UbZ7bxWfoJcOOz9Wlw44pINc5s4wvoyfp0uPGF4rMzTmTojKdHUGbrMFtnmj4DLp96uI6jz4Tr9Oqz4DqL9NlwncW6AtdZoqtwnaW6BtdZputwneWYAtdZoRtwe5lt7xW6Cbf3IeyG2/swfYI+KfRv0S24/jq9B64vKoPr30Y30c36iXD9LfocuP5Wkaq/jW6nzNm/X1sA139AnwvXD0We/hP0SfoUfVqfB9d/Rp8P1+dY+C/qJ8H1X9LF776snwzX36kvhOu/wu+/Sl+jr9NddDd9g75J36Jv03f0U+D67+qnwvX36KfB9TmmPsfU36/bcH1xFe/yzobLazHXP6jH4fqHdAcu76C67QF0oFhN7UF6L9w2x73NcW9z3Ntj9EVwuUe47el0Bp2pL4bbnqUvgdueLUa5zTnQ1qmhL4XbNvVlcNuWvhxuu4fHT6Rz6Fw6j86np9BT6WnUpnHqCLkLuME0fQVcPv1xA0c/HW7A+RCs0M+AG5yunwk3OEM/C25wpn423OAsUcfWXinmCd+Kd3lN54br9HPghrdqm+Fyp3BDzodwM91Ct+or4Ybb9FVwQ86HkPMhvJ8+oK2GG3I+hE/o4vOT+hq44VP6uXDDp/Xz4IbPiJkQcj6EL9KX9PPhhi/rCbjhTj0JN3xFjF34Kn2Nvk530d30DfomfYu+Td+h79I9lPMh5HwI99P39BTc8H1dfOZ8CA/SQ3oGbnhYz8LtDNBzcDsD9TzcziAx+h3OB+5ubme0XoDbGaO7cHmH2e1wPnRm6B7czky9CLczSy/B7czWy3A7nA8dXa/A7Ri6+KaJpXA7Fu3Ra3A7J+p1uJ05egNuh/OhM09vwu3M11twO5wPnVP1Prid0/RvwO3Y+jfhduL6t+B2HP3bcCPOh2iafgHcKK5/B27k6N+FG3E+RCvo6fQMeqb+PbjRWfr34e6I9B/A41WtJ63TfwhPulX/ETxJzARPup3eQe+l6+k2ul3/MTzpPn0OPEnMBE96QP8JPCnUCvCkh/AbeNLD9BH6KH2MPq7/FJ70hP4zeNKTuAme9DLdqf8cnvQe0vCk9/VfwJM+0H140oe4E570Ef2YHqKH9Ta8WD+sgBfrTwfoAbzYQMyDx53Ri43W18KLjdEvhBcTo+bFJtJJdDKdQo+nU+kJdJr+S3ix6fqv4MVmYCm82Gz9/+DFFP3X8GKq/ht4MU3/LbyYrv8OXszgd+bov4cXm6tfBC82T78YXmw+xG+dpP8BXmyB/kd4sZNxK7zYQv1P8GKn6H+GFztV/ws87raePE2/BJ58GibDk239UnhyXL8MnuxgCjz5THqWfjk8+Wz9CnjyOfqV8OSV+lXw5FUYCU9eTdfQc+l59HyaEHY9gN3wukL9anhdHXwJryvSr4HXtUO/Fl7Xg+Kn3f2ky+F199f/Cq97gH4dvO6B+vXwugfx+GAh7zN49jr9Bnj2rfrf4Nm36X+HZ9+u/wOezfnD3dyz1+s3wrM5f+zt9D56v/5PePYD+k3w7FC/GZ79kH4LPPthfR08+xH9Vnj2o/pt8OzH9Nvh2Y/r4ree0O+EZ3P+2Jw/9k79Lng254/9Pv1Avxue/aF+Dzz7I/1eePbH+np4fP/Bsw/rG+DFOX/i/fWN8OIDxJyJc/5w9/e4+3vxMfomePGx+mZ48Yn6FnjxSfpWePHJ+jZ48Sn6dnjx4/X74MWn6vfDi5+gPwAvPk0P4cWni1kR5/yJz9Y78OKKHsGLq/oOeHGNx3XK+ROfoz8ILz5XfwhefJ6YOXHOn/hJdIH+MLz4yfoj8OIL9UfhxU/h8VOFPKPwnGn6Y/Aczh/H1h+H58T1J+A5nD8O549zFj2bnkNX0lX6k/Cc1fpT8Jw1+tPwHM4f5zz9GXjO+fqz8PiWndf7gP4veL2h/hy8Xs6f3kh/Hl7vDv0FeL2cP4sGSNPhLRqovwhv0SD9JXiLBosj/u36y/B8zg3/TnoXvZveQ+/Vd8Lz1+uvwPMf4JFQfxWe39Ffg+dH+uvw/B2invgP6rvg+Q/pu+H5D4uZ4D+ivwHPf1R/E57/mP4WPP9xHuc88Xfqb8Pz/y1miP8f/R14/n/1d+H5/9P3wPM/0ffC8z/V98HzP9P3w/M/19+D53+hvw/P/1L/AJ7/lf4hPP9r/SN4/gH9Y3j+Qf3f8PxD+n/g+Yf1/8Lj+x4e3/fw2jEq0y7aTTn32v31/8FrjxEzrT1W/wRee5yoP3wnxGtP1D+F154k5kb7eP0zeO2p+ufw2nN4ZK7+Bbw250mb84RnCF4wTf8SXjBdzIFgBo3rX8ELOAeC1frX8II1+gF4wbn6QXjBefoheMH5+mF4QcIAvPB2sa7DOwwJXninthleeJcRgxfebcjwwnuMLnh8nuuF641ueCHHKAxph0Z0h9EPXvig0R9e+JAxAF74sDEQXviIMQhe+KgxGF74mDEEXsgxCjlG4U5jKLyQYxT+h/6X/o9+Qj+ln9HP6Rf0S/oV/ZoeoAfpIXpY2AGVaMwYBq8jG8PhdbqMEfA63cZIeJ1+xih4nf76AHgdjlFnrDEaXmecMQYe30XxOtxTOpOMsfA6x4tx6UylHKPOXGMcvM48Yzy8DseIu7YXTaPTjSPgRRyjKG4cCS/iGEWr6RrjKHjRuRgILzqPnk8TxtEo8r2RorTeOAZFSeRflELaoZExAUVph3EsitKDWIui9JhxHIqSyLnIPbco7TQmohjrQhrFWDftZ0xCMdYf81CMjTMmoxgbb0xBMXYEqijGjqRH0aPpsfQ4OtE4HsXYJGMqirF5xgkocu8rytONaSjy3byiHDemo8i9rCivpmuMGSjK5xozUZRFH4ty0piFopzCSBTlb9JvCfneSNFmf2321w6N2SjaHUNB0Y4MFUV7h+ipzf7ajxkaijb7yz2iaO80dBTj7G+82zBQjPczTBTj7G98HB2vnYhi/AjDQjF+pNGDYvwoQxxhf+Psb/w4Yw6K8YnGXBTjYm0W4/MM8bvsrzMdk1B0ZhjzUXTixkkosvYWHfbXWUPPNRag6LC/TtI4GUWH/XXYX+dbxkIU+d5I0Wd/fXEVXPQ30k10M91Ct9JtdDu9j95vnIKi/4BxKop+aJyGov+YYaPIelhkPSz6O404iv4rhoOiL87Ai/5rVJyBF9vMqt1t9KLYHmcsQrE93liMYvsoYwmKbWbSPoZOMJai2D7WWIZi+zhjOYqsUcX2XGMFiu15xukoskYVA+YTzDDOQJH/ElGRf0FWDFSqUZ0aNG6ciSJrVzFghgEzDM41zkIxYIZB0jgbxYAZBn30G/SbxjkoBt8yVqLIelUM1xurUAw3aJtRDDcaq1EMNxlrUAw3G+eiGG4xzkMx3Gqcj2K4zUigGDLP8D4x08L7jSSK4QNidoUhfcxIocjaVWTtKoY7jTSKvKIphq8aGRTD14wsiryiKXaYZ6fbyKHYGWfkUeyMFyl1jjIKKHaONlwUO8cYHoqdCTx+LD2OMs/OXKOIYkfU/CLrSTGabpRQjGYYZRQj5hkpRgXFSDWqKEaaUUMx0o06ihHzjOJGA0XWmSLrTDFaYzRRZJ0pss4Uo6TRQpHvKhejPqMPxegbxjdQjL6JqShG38JUlKQNxjdRkjYa30JJ2oQLUZI2G99GSdpiXICStBVrUZK2Gd9BSdpufBcl6T7jeyhJ9/P4Y8b3UWItKkm7jB+gJO02foiSJK7ySpK4yiuxLpVi3caPUIqJ2luKjTV+jFJsnPETlGIit1JsDp1r/BSlmMikxJpTip1EFwjl6cbPUJJnGD9HSZ5p/AIleZbhoyTPNtooyYoRoCTrxlqUZMO4ECXZxGSUZIv2GL9EST7R+BVKPCcvybYh/psit5K8xvg1SnLS+A1KrFclvjNcknO0TCu0Smu0ThvGb1GSm8bvUJJbxu9RsjeIDO2NlHnam+kW4yKUbOZpb6Pb6X2UedqPGRejxFpXsnfR3fQN4w8o2cyTda8U7zb+iFKcecbHGn9CKT7O+DNKceYZZ57xucZfUIrPMy5BiTWtFD/JuBQlVraSM4POpLPobKpQ3bgMJccwLkfJMY0rUHIsXUfJ6RGJOScaV6LEs9OSYxtXoeSsNq5GyVljXIOSk8RAlJyUcS1KTtb4K0pOzrgOJYdJOhXjepScqnEDSk7N+BtKTt34O0pOQ8xGp0lbQn+LyM3favwDJZ+J+UzMf4w+btyIks+U/N3GP1HyOet8psSKV2p3Gzeh1B5n3IxSe7xxC0rtecY6lNrzjVtRClTR00AzbkMp0I3bUQoM0buAfQxONO5AKZhj3IlSMFf0NJhH59OT6AJ6snEXSsFCfmYmgW3cjVIQ53/HoauNe1AK1hj3ohSI3b8UJGjSWI9SkDI2oBSkjY0oBRljE0pB1tiMUpATOQR5YwtKQcHYilLgGttQCjxjO0pB0bgPpaBk3I9SUDYeQCmoGCFKIXMLtxodlMJtRoRSyNxC5hY+buxAKWRu4W7jQZTCN4wsSrwnU2JlK3W6jYdQ6owzHkapM954BKXOPDGjOvOFEXOLNONRlCJd9C5ibhFzi040HkMpmmM8jlI013gCpWie8SRK0XzjKZSik4ynUYoWGM+gFJ3Mby40nkWJ/45oKbKNf6EUxY3nUGJlK7GylaI1xvMo8aypFCWMF1CKksaLKEUpkU+UphmapcwtytMCdalHi7REy7SCqShL24yXUJZEVmXpCeNllKUnjZ0oS0/hJpSlp+kz9Fn6hvEKyqxyZVa5cqzbeBXlWD/MQ5lnX+XYOOM1lFnlyrLIrSxrxusoy7qxC2VZ5FaWT6YLjd0oy6cYb6Asn2q8iTIrVVm2aZw6xlsoy738/iLjbZTlxcY7KMtLjHdRZjUry2uMPSjLYhco80ysLJ9PE8ZelO1txj6U7e3GfpTtJ0RP7SfpU8Z7KNtPG++jbD9jfICy/azxIcr2G8ZHKLPylFl5yvFu42OU4/2Mf6PMM65yfJzxH5Tj443/ouywj45m/A9lRzc+QdlhHx320VlITzE+Rdk51fgMZec043OUHdv4AmXePy87jvElyk6v8RXKziLja5SdxcYBlJ0lxkGUefZVdtYYh1B2zjUOo8yzr7LDPjoJEyj720wJZX+7GUPZf0r0zn/alFH2nzG7UPafNbtR9v9l9kPZf87sj7L/vBhN/wW6i+42B6Dsv2EORJn1pMx6Um53m4NQbvczB6PcZt/bA+hAOogONoeg3B5iDkW5PZRHxtCxdBwdbw5DORD1thwolLkFmjkc5UCsqTJrUTk4jdrmCJR5r7jMf5O5zL/TLwfMJFjJ76wyR6IcrDZHoRysMUejzDpTDhLmGJSDpJjhgVgv5XCbORZl1oRyyHzCp+kz9Fn6L/qcOQ7l8HlzPMrhC+YRKLNulMPd5pEo815umXWjzLpR7nSbR6Hc6WcejXKnv3kMyp0B5gSUO8ynM8g8FuXOYPM4lDtDzIko872IcmeMOQnlzlhzMsqdceYUlDvjzeNRjmaLWRQp5lSUI5Vq5gkoR7o5DWXWnHLEfCKbxs3pKEeOOQNlviNRjphPxHyiVeZMlKPVIpNoDWU+UYImzVkos55UpG3mbFRYByrS8/QFU0FFetFUUZFeMjVUeOVVkXbSV+ir9DX6Ot1l6qhIu5FGhfWhEus2DVRiA3APKrGBpolKbJBpoRIbzCNDzB5UYkPNE1GJDTPnoBIbbs5Fhe9XVGIjTeEocz4qsdHmSajExpgLUImN5e+Oo+PNk1HhHciKPM1ciIos9qCK7JinoMLnfRU+76vIi81TUZFFPhV5Jb+zyjwNFTlp2qjIzMFmDjxLqdjMwX6BvkhfMuOo2C+bDio2c7BfMXtRsV81F6FiMwf7dXMAKvYuczEqNnNgDanEu80lqMSZQ3wgHUSZQ3wIHUqH0eF0hLkUlfhIcxkq8VE8MpqOocwhzhzi483lqPBOWsWZZq5AxWEOjmOejorTa56BirPIPBMVZ7F5FirOEvNsVHj3rOKsMs9BxUmaK1FxUuYqVHgeUvG3mqtR8beZMVR4HlLxXza7UfF3mmtQ8V8xz0XFf5WfXzPPQ8V/3TwfFX+X6K/Pvre7zAQq7W7RwvY4M4lKW+wLlWClmUIlWGWmUQnE/KwEa2iSpswMKkHazKISiD2uwv29Em6l28wcKlzLlfBlupO+Ql+lr9HXzTwq4S6zgAqfxVQ6XaaLSoft6YwzPVQ6480iKlwvlWiVWUIlWm2WUeF6qURsT5SiabOCSpQxq6hKoj1VaSvdZtZQ5dqpSrvMOqqc/9VYl9lANSb+X1XO1WpsvNlENXYElqIaO5IeQyfQY80WqrHjzCKqsYk8Iq7Qq7xzXpXPomfTc8w+VOWV5jdQlVeZ30RVXm1+C1V5jfltVLkPVrkPVrkPVuUETdOMeQGqsjhnqMrinKFqsy/2VvM7qPIsvcr5X7V3md9FlXO4Gu8yv4dqnH3hfKvGx5vfRzV+hPkDVOPsS/wY84eoxieYP0I1fqz5Y1Tjx5k/QTU+0fwpqrzbUHXONH+GqnOW+XNUnbPNX6DqnGP6qDorRUucVXQ1XWO2UXXONQNUnfPMtag655sXouokzF+i6rAvToZmzV+h6rAvnLdVf6v5f6j6u8xfo8p5WG3HqGz+BtV2l/lbVDknq232pT2eHmH+DtU2+9I+ih5t/h7V9jHmRai2J/A7E82LUW1PMv+Aanuy+UdU21PMP6HaPt78M6rtqeK3eCezGkwz/4JqsFSMV7CMLqcrzEtQDU43L0U1OMO8DNXgTPNyVIOzRPuDc80rUA3OM69ENTifRxLmVagGSfNqVAMxD6tB1rwG1SBnXotquMX8K6qhuMKqhrvM61DlPK92Yub1qHZk8wZUO12i5Zzz1Q772xlv/g3VzhHm31HtHGn+A9XOUeaNqHaO5k+PoexvZyKdJMa6M5mfp9DjzX+i2plq3oQq7wpWI1F7q9FSMb7RMvNmVKPl5i2oRivMdahGp4u+RGfQMyn7G51Lz6Psb5SgSfNWVLnuqlGW5szbUN3xL8lAdcdz5u2oSVvMO1CTtpp3oiaJ9V6TXjfvQk3aZd6NGtdgLQYqmfegFouZv0UtJkN8HkfHm/eiFjvCXI9a7EhzA2qxE8yNqMWmmZtQi003N6MWm2FuQS02E0tRi80Scq+pca+pyUvNrajJyzAVNfl8mqBJcxtqsmh/Tc7SnLkdtS7R/lrXc+Z9qNlbzPtRs7eaD6Bms/3263SXGaLGdVeLs/1xyeygFo+JNsfZfq7BWnw8PYIeSU+g0+h0OsP8J2rxmaIXcbafe0TNYfsdMT9rzjIzQs1h+52EuQM1J2k+iJrD9jtsv5MzH0Ktl+3vfc58GDV/i/kIar6YezV/F91tPoqaf0i02T8sbMN8DLW2JFrSnmk+jlp7lvkEau3Z5pOotRXzKdTaqmhVW6M6NYRcR7Vgmvk0aryurPG6shb00kXmM6gFi81nUQuWmP9CLVhqPodasMx8HrVAzLdacAZlv4KE+QJqAfsS5MwXUQu3mC+hxrVTC9n+cLf5MmrhIXMnauFh8xXUOjBfRa3D9ndm0lnma6h1ZvOzYr6OWkc1d6HW0czdqHV08w3UOmw/10WNd8trEdsfOeabqEW95luoRYswHrVoMV1Cl9Jl5tuoRaeb76DG9VLjuqhFCfNd1LgWalHO3IO6tMXci7q01dyHurTN3I8696C6tIvuNt9DnXel6rxeq0tv0bfpO/RduofupQfoQXrIfB916TDuQT2mUo3q5geo86lxnWuhLk8zP0Rdnm7sQl2egSmo8xysLjvmR6jLyzEedXmF+THq8un8fIb5b9TlM83/oC6LOlCXz6bn0PNpwvwv6rLYf+tcR3XuX3U5Y/4PdTlrfoK6nDM/RZ37V93ean6Gur3N/Bx17l91mznYu+kb9E36lvkF6vbb5peo2+/Qd82vULf3mF+jzves6jZzsA+aB1C3D5m/Rd1mDnHmENfMg6jHdfMQ6nz6WeeaqjvT6HTzMOoOc+A5WN1xLKDuMAdnBWUOzhn0THqWJaHunG3FUHfOsWTUuR7rjqj/dSdpdaHO9Vh30lY36k7G6oe6k+V3xN5X595X97da/VH391gDUOf7RXX/K/o1PWANRN0/aA1CvS3mcL2tUNUajHpbs4agHsRELwKZdtFuOt0ainowwxqGejBT9CuYRU+hp9LTrOGoB7Y1AvUgbo1EPXBE74KV1ijUg1XWaNR5XVYPUtYY1IO0NRb1IGONQ32tqC31tc/Rz6RZqK/93BqPerjFOgL1cKt1JOrhHuso1PmeTJ1Pyurh19bRqIcHrGNQDw+KvnBt1juKNQH1jmodi3pH1JZ6xH5FsnUc6lGXNRH1qNsSR6bz+AxrMurRTGsK6hH7FbFf0anW8ahHp1lTUY9s0ZcoTtmvaCVdZZ2AesR+RSkxXlHamoZ6lLGmo849q77jOWsG6jvYrx2fWzPR4J3nhrTZmoWGtMWajQbvPDekPZaCBtdjQ9pH99MP6If0I0tFQ/rY0tCQ/s0j/6Gf0c/pF/RLS0dD+oqfv7YMNGIin0ZMsUw0YoctCw0ZVg8asmSdiIYcs+agIcvWXDTkLoifdlvz0JD7WfPRkPtjEhryADqQDqKD6RA6lA6jw+kIOpKOoqPpGDqWjqPj6RH0SDrTmoyGPMs6CQ15NqagIStUpwY9mS6kp1gL0JBPxXg05JV0lXUyGrI4q2zIa2jaWoiGnLFOQYM7coM7cqNLjE6ji6PD+9gNe7N1Khr2Fus0NHgfu2HvsWw07L1WHA17n+WgYXN07A+sXjTsD61FaNgfWYvRsD/GSjTsf1tL0LD/Yy1Fw+bo2J+LsbO/sJahYX9pLUfD5ujYX1sr0IhzdOKKdToa8UMi//hhoQMq0RiVKcfI6ab9aH/rDDScAdaZaDgDrbPQcAbRwXQIHUqH0eF0BB1JR9HRdAwdS8fR8fQIeqR1Nhq8o95wZlnnoOHMtlai4XCMHN1ahYZjWKvR4N2whrPQGo6Gc4q1Bg2HY+RwjJxV1rloOKut89BwOEZOmoqrvwbPOhq9HKNejlEvx8jfZJ2Phr/ZSqDhb7GSaPBspOHvs1Jo+PutNBr+eyJb/30rg4b/gZVFw/9QjIv/XyuHhv8/K4+G/4lVQMP/1HLR8D+zPDT8z60iGv4XVgkN/0v+FzhG/tdWGY22alXQaGtmEY3gKKuKRnC0SCA4hk6gx9Lj6EQ6iU6mU+jxdCo9gU6j0+kMymyDWVYNjWC2VUcjUKwGGoFqNdEINKuFRqCLcQ8Mqw+NwLS+gUZgWd9EI+ixvoVGcKJIPphD59J5dD49iS6gJ1vfRiNYaF2ARsBxCVZZ30EjWG19F42A48Iq3VjLsWCVbqzlWISbrO+hEW7GXWiEW6zvoxFutX6ARrhPjEi43/ohGuF71o/QCN+3foxG+IEYhZBjEf6X/o9+Qj+ln9HP6RfWT9DgOxKN8Cvrp2iEX1s/Q6MjduRGR7N+jkZ0lMghOtr6BRrRMZaPRjTBaqMRHWsFaETHWWvRiCZaF6IRTbJ+iUY02ZqERjTF+hUa0fHW/6ERTbV+jUZ0gvUbNKJp1m/RiKZbv0MjmmH9Ho1opnURGtEs/l9mWxejESnWH9CIVB7RKMciMqw/ohGZ1p/QiCzrz2hEPdZf0IhOtC5BI5pjXYpGNNe6DI1onnU5GtF86wo0opOsK9GIFlhXoRGdbF2NRrRQjAJ3mUa0yroGjYh1LOJYcGdp7OBYcGdpcGdpShtwIZrSRrrJuhZNSYxLU9pCt1p/RVMS49KU9lvXoSl9gZVoSl9a16MpfcXPX1s3oCkdwD1oSmJXbfIMsBnTrL+hGdOtv6PJM8CmPBkWmvIU6x9oysfz81R6Ap1mXYymPN26EU15hvVPNOWZ1k1oyrOsm9GUZ2McmrJi3YKmrPL7mrUOTVm3bkVTNqzb0JRN63Y0ZYvf7KEnWnegKc/BeDTlufQk60405QX8vJKusu5CkzW/yZrfZJ1vss43WeebrPNNe4N1N5r2RuseNO1NIh+bWdnMyt5q3Yumzazs/dZ6NG1mZX9JmZX9tbUBTfuAtRFNm1nxLLEZ16hODWsTmg6zcqZYm9F0jre2oOlMpSdYW9F0poneOdPpDDqTzqLMylGoam1D09Gs7Wg6Oo8Y1KTMymFWzonWfWg6c6z70XSYlXMSZVasvU1nlfUAmo5Y403W3ibrbZP1tsl622S9bfrMxN9qhWjy6WSTdwWbPvPx91sdNP0DVoSmf9DagaZ/yHoQTV+cOTfboOJKqhkwh2CK9RCawRzrYTSDudYjaLLmNINV1qNoss40WWearDNN1plmyDaEW+k26zE0eSewGe6zHkcz3G89gWZ4QIxLeJAesp5EMzxsPYVmB9bTaPJqrhmxDRHbEM0RWUVzrWfQjFZaz6IZrbL+hSbXV5Prq8n11eT6aknbrOfQkrZbz6Ml3Ye1aPHdgBbvQrSk1+kuupu+Qd+kb9G36Tv0XbqH7qX7rBfQkvZbL6IVg/USWjHJehmtWMzaiRbvYLRiXbRbyDXYkkVfWrLoS0uea72CljzPehUteT7Go8V10ZJXWa+hxbXQ4lpocS20uBZa9jbchZa93XodLfs+axdafEbfsl+zdqNlv269gZa9y3oTLXu39RZa9hvW22jZb1rvoGW/Zb2Llv22tQct+x1rL1o2+2WzX/Zeax9a9j5rP1r2fqxEKw4q0RiVrffQindZ76PFO40trpeWw3457Jczl86zPkDLYb84h1vOKutDtDhvW5y3Lc7bFudty2e//O3WR2j5L1sfo+XvtP6Nlv+K9R+0/Fet/6Llv4PFaPnvWv9Dy99jfYKWv9f6FC1/n2ibzza32c62bH2GVrvL+hwt3kVscT63OJ9bwTzrC7SC+daXaAVirbWCBZTtDFZZX6EViLrU4n7a4jxvcZ63OM9bnOetkG0Ot1tfoxW+bB1AK9wpWhi+Ql+lbHP4Lt1D91oH0Qr3WYfQCtnmDtvcka3DaPFOYIt3Aluc/y3O/1Y0T6Qazacn9QCtaEGPhBb3nVa0iq7uiaHFfafFddHiumhxXbS4LvqkbT0y+qTtPV3ok17EWvRJL/V0o096uacf+iTR/j5pD93b0x990j7rRfRJop19sRiVewagj/O5j/O5Tz65ZyD65IU9g9Ann4Lx6OO5fR/nc5+8qmcw+jif+7qe6xmCPs7nPs7nPntbz1D02dt7hqGPT3D67Jd6hqPPflm0wWZ7bLbH3tszAn22GOs+zs++eKxnJPrics8o9HEe9nEe9jknYxz6nIU9o9HnnNIzBn08j+1zVvaMRZ+zqmcc+jgP+3qfo2wP52HfouN7xqOPs7HP395zBPp8UT36/Pvpiz1Hos9/SbTHZ6v8vT1Hoc/f13M0+jgD+9qgUs8x6GvHeiagry2qQR9nYB9nYF9wSs+x+H+SzTtMqiLt4qdsRtfddV11zQHFnFMFFAlFMxsMoG7OOe/S4d6+nXsomgGVIG7OOZmAYQhDmBlqehpmGEAlmXMAlSzR+D33fP/8nn5qqqvO+9Z5q6pv90yuU1X9dvIOORyT63fKczG5/llciMl02mQ6bTKdNtl3y/Mw2ffIEZjsqcdvledjsqceumuy30ZulxdgMt01uQ/yQkzuE/IiTO47KlbSRz1012S6a3JjjLwYkxvU06Cexh3yEkxu3BkraVAPXTSZLkqJHnkpUqL3+gJSYquMW2INKbFdXo4U3ZI6CvIKpI6Kd/IU3ZKiW1KJMfJKpBJj5VVIJeK5Uok75NVI0RUpuiJle9CBlO2V1yBlt8prkbIc326X1yHF1U+Nh7weqfEcn6uf4uqnkmNwClLJsVIilbxdKqSSd0iNFFc5xVVOOY7veqVByq3GNKTcGnKAHCQ3k1vIrXIkUlz3lNsub0CKa51y75MfyBuRqnPe+lg5Cqn6OHkTUnUrRyNVHy/HIFVPyrFI1SfE8dZbyYnkJDkOqfrt0iJVvwMXIsV1T3HdU546fa8cj5RfLZNI+TVyAlJ+QLYi5anTU6ffEq+C3xorpB9SfruMSZ3+ffkppPwH8tNINaizMZYcR1pyPJkkJ8jPIMXnn6nGxFhhY5K8GanG7bHCBnXSDyn6IS1inWnRK29BWqyStyItPKYhLfrJprwNabFaTkRarMFNSIsBOQlpMShvR1qslXcgLYbknUiLdfKzSIv18nNIiw187yPy80iLR+UXkBaPYRzSYrP8ItJii/wS0iKON03vpblrpcU2+WWkxXb5FaTF6/KrSIs35NeQFm/KryMtdshvIC12ym8iLXbJbyEtdiOedw+5l3yXfE9+G2nxvvwO0ny+mk6cg+uRTgyX30U6EecqnUiSE+T3kE60yu8jnfik/AHSiU/JHyKd+DRORTrxGfJm8hbyVvI2cqK8GWk+X03T/2n6P03/p20vuUr+CGnLfNp++WOkbTPOoV1NMp92gBwk18qfIG2H4lzZdeR6+VOk7Qb5M6TtI/LnSNtH2c582s3kFjkZabtVppBmraW566btNnI7+Tr5BvkmuUOmkbY7+XoXuVtmkLZ7ZBZpy3zad2WAtH1Phkjb9+NM8jltOsl8JoeTzGcyKXNIJyfgNKSTreQnyU+Rn5YR0snPyDzSyZtlAenkLbKIdPJWWUI6eZssI52cGGcyyXyy3tOs97RbJStIO+bQ9ZENWUXarZU1pB1z5TbINqTdI3IK0m6rdEi7x+VUpN2eOC63V9aRdvEni7Q7Qr4tL0TavSOnIc3aT9fHkuNkO9J1K6cjXR8vZyBdT8b667eSt8m7kGaNp1njab9K3o209/IepH2fnIm0b8hZSPu1cjbSfkjOQdpvkPci7R+Jdfqtci7S/nF5H9Ke2vxe+QukPbX5I/KXSPu35a+Q9u/EeWa9pxtj5a+RboyTv0G6YWMljfEktTWorXGb/C3SrOs06zoj+tCBjGjI3yEjYr9lWL8ZsZYckr9HRsQey4j1ZKwtIx7HKGTEE/IPyIgn5R+REfGNKCOeJveQe+WfkOGn2ow4Iv+MTCI+tTOJ0eQY+RdkEmPlX5FJTJR/Q4Y1kmGNZFgjGUtttiH/joxdLf+BjF0j/4mMpTY7JP+FjF0n/42MXS//g4ylNktt9gnySflfZOxT8n/IWGqz1Gb3yvuRsYflA8jYI+hEJnmTfBCZ5Gj5EDLJMfJhZJJjcRoyyYlyHjL0W4Z+y9BvGUdtriHnI+P6Yz2uSa6WC5Bxa2T81wG2DJLryPWxZrdBLkTGPSI7kXHU7KjZPSUXIeOelouRcc/EOt2z5HPk8yT1u71yCTJun1yKjHsrzrDbTx4gD5KHZBcy9RvjbNdHyWXI1G+Sy5Gpj47jqk+UK5Dh990ZejVDr2Z8n1yJjG/IbmR8v+xBxjMuv1r2IuPXyFXI+AHpkfGMyzMuv57cQDIuejjjGZd/inw6js4/I/uQ8c/KBjL+OdmPjGdc9HnG7yX3ySYy/i25Ghm/X65Bxh+QA8j4g3IQGX9IrkWmwbgao+QQMo2b4ogajKsxUa5DpjFJrkeGPs/Q51meX1nRS66SG5AVXj6CrOiTjyIrGhiHrOiXjyErmnIjsmK13ISsWMP2AbkZWTEotyDLusiKIXIduZ7cQMaxZ1kjWdZIVjwntyIrnpePIytekE8gK16UTyIrXsInkRUvkwflU8iKQ/JsZHkGZRPDyTjGbGKUfBrZxESchmxiknwGWd60s8Pim23W9shnkbW98jlk7apYrfVkH8m4bD/ZJFeTjMsOkIPyeWTtWvkCsnZIvoisXSdfQtauly8jazfIV5C1j8hXkbVb5WvIsr6y9jnyefIF8kW5DVn7ktyOrGVc9qB8HVl7CJ3I8izIJofLN5BN3ijfRDY5Su5ANsm4kpPkTmR5Y89O2CR3Icv7edZ1y93Iuh65B1nXG6t1a8khuRdZt07uQ9atl28h6zazfYvcj6zbKg8gy5rKupfIl+VBZN0r8hCyLv6kluXdPuu2kQflYWQdddapsz5cHkG2fm6c//p5pCaNfBvZ+kj5DrL1G+S7yPKTY5afHLO8w2d9t3wPWd8j30fWU7OnZj9EriPXk5vlB8j6LQrI+q2xTtZL1lOzf5l8hXxVCWT9a+ooZP022Y+sP6gSyHpqblBzg55pnKuGIds4T7Ugyycq2YZRRyPbGBnnuXFDTH5yzPKTYyBizYHoVscgED3qQwhErzoWgYi9FAhP9pENsp9skhvJTerDCMRm9REEYgtGIRCvkdvURxGI7eo4BCK+VwRiP3mAPKg+hkDE+gN6PkgMV8cjSJyL0xAkzlMfR5AYgVMQJM4nLyMvJ68grySvIq8mtToBQcKoExGwXoLEJPJ2dRICfq8dsHYCPnUJeNYEww7F5FOXwHarTyCwPbgRge0lV6mTEVivTkFg+9jSIPvJpjoVgd2oTkNgN6nTEdjNceyWebDMg91GbidfV2cgsMyDPaDORGAPxhlgjQSskSA5XJ2FIMk8JM8jR6izESSZBz6NDJKXq3MQJK9QwxEkr1TnIkhepc5DkGQeklqNQJA0fC/zkJykzkeQjO/2QZJ5YK0FfEoT8FwLJhxSFyBg3QWuW12IwDEPjnlw/eoiBK6pLkbgNqhLELhH1KUI3KPqMgTuMXU5ArdRXYHAbYojddvVlQjc6+oqBI7xugPqagTuoLoGgTukrkXgDqvrELj4/A1Yd0F9uIo5QkkEdcZbZ7z1y5VCUL8qjqV+tdII6tcog6B+rRqJoH4d+1wfr35dqhsQ1JW6EUFdq1EI6swD72ZB/TZ1E4I6c1KfpEYjYP0GrN9g2uY4D9O2kLvI3eQeci+5j3yL3E8eIJlDnqHBtHfFJQimvUe+T34Qsx2kiMm9IvDdZI8ag8Azz75fjUXgm2ocAr9BWQT+kTif/lHyMXIjyTz77Wo8As/68syzP6CSCPxB/vUQeVhNQOCZZ+4VQYMea4xQrQga56tPImhcpj6FoHF5nJkG89y4mryGvJa8Tn0aQeN69RkEDckWRWqSeW7cqm5G0LhN3YKgMVHdiqAxSd2GgHtO0L9JTUTQv1lNQtDPPPczz/271e0I+veoOxD07yX3kW+R+9mfeeaZHvBMD/rfVXci6H9PfRZB//vkBzGbIIX6HELub6HoJnvU5xGKOM+h6Ceb6gsIxWr1RYRijfoSQhGfiaEYVF9GKNaqryAUQ+qrCMU69TWEYr36OkKxQX0DoYjXJeReF4pt6psIxXb1LYTc60LudaE4oL6NUBxW30EojqjvIuReFybi/IeJETgNYeJ89T2EiUtwCsLEper7CBOXqR8gTFzOv95M3qJ+iDBxq/oRwsRtuBhh4nb1Y4Tc00LuaeGwTeonCLmPhbZb/RQh97GQ+1jI/Sq0TfUzhHa1+jlCu0ZNRmgHVAqhHVRphHYtkgjtELmOXK8yCO2GOEbLeLmnhXabyiLkZ8aQnxlDu18FCO0BFSK0h9nniMoh5J4Wck8LkyNUhDB5vsojTF6iCgiTl6oiwuRlcYxJxptkvMlbVAlh8tY40iTjTd6uygi5d4Xcu8IJjJf7Vei6VQWh61FVhNyvQreaXKNqCN2AakPoBuOIHONy69UUhO415RC6bWoqQrcvjsK9Re5XdYTuQByFO6ymIeQeFXKPCuuMpT5CtSPkHhXWLyAvJC8iL1bTEdYvUTMQ1i+NI6ozrvot6i6E9YlxRPVJ5O3qboR8LhTW7yQ/G5P7UjiNMXKvCLlXhL5H3YOQe0XoGaNfQw6omQg9Y/SM0a9XsxD619RshHxmGPp9ag5C/5a6F6Hfr+Yi9IzRc738EXUfQu4PIfeHsDFC/QJh43z1S4SNC9SvEDYuVL9G2LhI/QZh42L1W4SNS+K4GoyxwRgbt6jfIWwwxsYk9XuEjdvVHxA27lB/RNi4k/ys+hNC7glhfxxjTqxUf0ZOdKu/ICd61F+RY53mxGpyjfobciL2Z04MkevU35ET6zEKOfEK+ar6B3LiNRUgJ7apfyInYn/mWI858Qb5JrmD3EnuIneTe8i96l/IiX3q38iJtzjyfvIAeZA8pP6DnDis/ouciL2RY13nWNe5xK3qf8glblP3I5eI85BLTFIPIMc6zbFOc3alehA52x1HxzrNsU5zlvHaNeoh5FiPOTukHkbOrlPzkLOM1zJe+6qajxzrMcc7Rs5uVwuQs6+rDuTsG2ohcvZN1YmcZbx2p1qEnN2lFiNnd6slyNk9ailydq/qQs7u4wiM1zJee0AtQ84eVMuRs4fUCuTs4ThSfl7Osa5zrOtcMj5bc8nb1ErkkhNVN3LJSaoHOdZpjnWa47eWObdC9SLnVqpVyLlu5ZFzPaoPOdZszg2oBnKs05xj7G5I9SPndqgmcm6nXISc26VWI+d2xwodNTtqdvvVGuTcATWAHOs0xzrNsY5yrKMcv7XM+RVqEDm/Uq1FzndjNHK+Rw0hx5rK+QG1Djk/qNYj59eqDcj5IfUIcn5HPIvfSe4iqcHvU48i599SjyHn98eZ8Qdiso5yrKMcfZ6jzyPRhQ5E/CVAJJarjYjECoxGJHrUJkSiV21GJFapLYiEV1sRiT71OCIR3zkjnlmRaJID5KB6ApFYy9dD6klEYo96CpHYq55GJPapZxCJt9CJKHEirkeUOEk9iyjxCfUcosTJ6nlE9G1E30b0Z0R/Ri3XiEsQtVxLXkdeT0pSqRcQ8Zv6yC5TLyKyy9VLiCxjsT1kL7lKvYzIevUKItunXkVkG+o1RLZfbUNkm2o7IstY7CDJWOyQeh2R3aPeQGT3qjcR2X1xFJaxJE9UOxAlT1I7ESU/gdMQJU9WuxAlz1G7ESWHqz2I6L2I3otar1F7EbVeq/Yhar1OvYWo9Xq1H1GrVAcQtSpxHSLHWNwydRCRW64OIXIr1GFEbmUchesmV6sjiFy830aOOt2QehuRi/0Qud3qHUT1E9S7iOonqvcQ1U+KtdWpsH6yeh9R/RT1AaL6qXHO66eRp5NnkGeSZ5FnayCqn6MFovpwjjCGHEuO00chqludQFQfr4chqid1C6L6BP61NSadH9H5UfuIeNXazycv0Ecjar9QH4Oo/SL9IUTtF+tjEbVfoj+MqP1S/RFE7ZfpjyJqv1wfh6j9Cr7rSvIq8mr9MUTt1+jjEbVfG2fPd+mPI/LL9AmI/HJYRH6FPhGRX6lPQsQqi/xq/QlEfo0+GZFn9jxX2e/SpyDyu+OVbZwYK28wbw3mrXEyeQp5qj4VUeM0Hb8+XZ+OqHGGPgNR40x9JqLGWfosRI2z9dmIGuewP/PWGKPPQdQYq4cjaozT5yJqWFyEqDGeTOrzEDUm6BGIGswbqzXq36TPR9Rk3prn6wsQNS/QFyJqXhjH27yIvJi8hLyUvIy8nLxCX4Soybw1r9IXI2perePX1+hLETXjvOXFEnQgL5aSXfoy5MUyWOTFcnIFuVJfjryIc5gXq8k1+grkxYC+EnkRV02eO0BeDOmrkBfrMAp5nox5sYPcqa9GXuzS1yAv4jznEzfhNOQTo8kx+lrkE2NxEfKJCfo65BOt+nrkW66IFbZcqSXyljrtUq2Qt11aI2+p01KnXaEN8nalHom8pU5LnXYNOaBvQN4O6huRZ3Xn7ZAehbxdp29Cnida3u7Qcc+degzydles0FJnkjqTo/VY5JNjYoVJ6kxOIFv1OORbqbP1ypiuS1vkHbU5anMr9Hjk3UqdRN516wnIux7diryLd6e8W0V6coAc1J9E3q3Vn0LeDcVq3Tr9aeQddbo3yDf1Z5B3O/TNyLudsc76TfoW5OujY1X1CfpW5Out+jbk248SFyPfnojpu/RE5D21sUbynuvrV5LdZA/Zqych71fp25H31OapzQ+Sa2OFfkjfgbxfp+9E3lObf0N/Fnn/ZqzH7yCprXGT/hzyDWprTNCfR54+zzeprZnQX0CBPizQhwXRpb+IAn1YEKv0l1AQXn8ZBdGH0SiIBjlADpJr9VdQEEP6qyiI7RiFgnhdfw0F8Ya+GQXxpv46CmKH/gYKYqf+JgoiXt8CfVigDwuJ0fpbKCTi9S3Qh4XEONLqb6OQGK+/g0Iiqb+LAk+oAp98Flpi/YWWhP4eCvRnwS7V30fBdukfoEB/Fuwq0usfomD79I9QsNRvqd8O6h+jYNfqn6Bgh2Lldrv+KQr2df0zFOwb+uco2Df1ZBTsDvwGBbtTp1Cwu3QaBfqzQH8WkqN1BoXkGJ1Fgf4sJMfpAIWk5V/Hk8mYPJUKEzbpEIVW6m+lfrdE51BwS3WEguvTeRRcQxdQoCcLblAXUXDbdQkF93qsxO3SZRTcbl1Bwe2Jlbi95D4yPiUL9TG6ikJ9rK6hUB+n21CoUw/PhQLPhQL9WWinBr9ET0HBL40z5vvIhnYo0HsFP6inouC36zoKnhr8LnK3noaCpwa/V7ej4Pfp6Sh4amjEZ1ahMZYcR1ID99gCb0QF+rDQjDUUxSJ0oCgW6xkoiiX6LhRFrKco+siGvhtFMaDvQVEMYhSK/PRdFNvI7XomiiLWVhS7yN16Fopij56NotjLln16Doq8ERXFfjK+rRWHrRbXojhsDTlADpJrySFyHbme3EA+Qj5KPkZuJOO4ivRnkf4stgwT16HY0hLTMka7WN+Lol0Sx2UZo2WMtqHnomgZo2WMvOEX7TZ9H4p2u/4FipYxWsZod5N7SMZo95Fv6V+iaPfrX6FoD+hfozhhtf4NihPWkAPkILmWHCLXkevJDeQj5KPkY+RGcpP+LYr0cLE1oX+HYusw/XsUWxmjY4xusf4Dim6J1ig6xsg9uehWkIzXNfQfUXT9+k8ouiZGo+hWk2tI5sExD24tOUQyJ26b/jOKbn8crzug/4KiO6j/iiKf5BfdYTL+tFKctlFcgeK0TfpvKNLzxfaE/juK7cP0P1Bsb9H/RLH9aP0vFNuP0f9G0VO/X0wu0f9B0S/V/0XRL9f/Q9Gv0Pej6Pv0Ayj6hn4QRd+vH0LRN/XDKPrVeh6Kfo2ej6If0AtQ9IM6HmetXoiip35P/X6b7kTRU78/QB7UZRT9Ib0IRX9YL0aRT+2K/dTfvykm66XYTOglKDaHiUtRbLaQR5PH6KUoiSW6CyXWTkl0kcv0MpTEcr0cJbFCr0BJrNQrURLduhsl0aN7UBK9uhclsUqvQkl4vqtPe5REA+NREmt1H0piSDdQ4h2jxDtGiTVYEtt0P0risG6iJI7o1SiJt9GJkngnZsswvQallhY9gFJLrLPUcoweRMku0WtRskv1EEq2S69DyS7DGJTscnIFuZLsJnvIXnIV6fV6lGyf3oCSpU67lhzSj6Bk1+lHUeIdo8Q6Ktlt+jGU7GH8BiV7RG9Eyb6tN6FkqbM1rtMSPVxqPVpvRqn1GL0FJRefLyW3VG9FyfXpx1FyDf0ESo5zuSFynX4SJd4TSvRnyW3TT6Hk3tZPo+Te0c+g5DmOX6qfRcn3xfp9Qz+Hkuc4fkg/j5Jfp19AiWd6iT4p+W36RZT82/ollPw7+mWUeV6XxVL9CsqiS7+KsojzVhZ9ZEO/hrKIxyyLIb0NZfEcRqEsnidfIF8kX9PbURbb9OsoizgnZXFEv4GyeFu/iTLXrsyztWyX6h0o2y69E2WuUdlyLtvQu1Bmzst2SO9G2T6n96Bsn9d7UbYv6H0oW85lX9NvoWy3xbMw/2V7RO9H2b7NWTiX69IHUHYc33F819AHUXb9+hDKrhnPwpyX3ZA+jLJ7Kh7ZPa2PoOye0W+j7J7V76DsntPvouyexw0ouxf0eyi7F/X7KLuX9Acou5f5rlfIV8nXYj2O2hy1uSMGKLu3jUDZUZvvMkeh7KmNa1f2DbLfJFD21MZ1LPshMwxl/1Ssyj9tWlD2z8RK/LPkcyS1+RfIF8mXzNEo+5fNMSj7V8yHUPbU5l8zx6Lsqc1Tmz9Cvo3foEInVHhzq4gu82FU6ISK6DcfQUXEqip0QkUMmY+iIjZiFCpiE7mZ3EJuJR8nnyCf1EdQEU+ZFlTE07gBFfEK+ao5DhXxmvkYKiJWVeFJV2lJmONRaRlmPo5KS4s5AZWWo82JqLQcY05CpeVD5hOotBwrrkOFjqrYpeZkVGyXOQUVOqpi+82pqFhqpqMqlprtRnMaKnaTOR0Vu5ncQm4lHyefIJ80Z6Bin4p1Wmq21GxfjcnTqtJKna3DxDWotLaQR5PHkB8yZ6LSeqw5CxW3xJyNiltqzkHF9ZvhqLimORcVOrDiqM3FO2GFVV9xG815qLhN8Vw8ZSrtnKv9Q/HI7ceaEai0f9icj0r7R8wFqLR/1FyISvtxcU78EnMRKn5pnAffTzbNxajQURU/ZC5Bxa8zl6LCnaHiOZfnXDwRKk3O1eRczWPJD5vLUGl+hK8/Sh5nLkeVt6yqWGyuQFUsMVeiKuJ5q6KfbJqrUKVnqmLIXI2q2GiuQVXEc1W51lWudbUlHrPacpy5FtWWj5nrUG05XlyHKm84VbvYXI+qXWIkqpbjW45vm0ahyvWtWo5vOb7l+FyjKteo2srxW48jP2Y0qq3HG4OqW2JGouo4pushe8k+skFyLtc0N6DqVsdzuTXkADlIUoOjBkcNXLsq167Ktau2fyyevf14cyOq7R83o1BtPyGOsf1E8iTyE+TJ5CnkqeRp5OnkGeSZ5Fnk2eQ55PCYfom5CVV6oOp7zGhUfa+JX/eZsaj6hhmHqu83FlXfNONR9YzLrzFJVP2AmYCqZ1z0TNUzLs+46JMqfVKlT6pNxtU8nvw4eYJpRbXJuJonmU+i2vyE+RSqzZPNp1FtnmI+g2rzVHMzqk3G1Tzd3IJq8wxzK6pNxtVkXM2zzW2oNs8xE1FtDje3oiYWogM10UkuIhebSaiJJeZ21Oi9mugxd6AmejEONfqtRr/V6Lca/VZrOdHciVrLSeazqLWcZj6HWsvp5vOotZxhvoBay5nmi6i1nGW+hFrL2ebLqLWcI65BrWW4+QpqLeea61BrOU9ch5qlHttpvoqaXWS+hppdbL6Oml1ivoEavVrjTaNmqYf+rNGfNfqzRn/WWk+Mx289iTyNPJ08gzyTPIs8m6Se1uHkueabqLVSj6Me12m+hZpbEs/olppvo+a6zHdQ42lYo89r/A1MjV6t0as1erVGr9bazzXfRa2dY7aPIM+P6Tm+7zTfQ81zfL+U7DLfR40nWs1zfP5epUbP1OiZGj1To2dqzXPND1Brnmd+iFpzhPkRas14/Daub5voND9Gm+gyP0GbWGZ+ijax3PwMbWKF+TnaRHyLaxPdZA8Zz9XGtW7jWrdxrdu41m0tI8Q1aGs530xGW8sFJoW2lgtNGm0tF8Uztlwck+vYZjtNBm22Kx7NLiOXkytMFm12pQnQZrtNiDauaRvXtI1r2sY1beOatnFN21o5b+v55AXkhSaHttaLTIS21otNHm1crzbXaQpocz2miDauSxvXpY3r0sZ1aWtPmBLamP8232nKaPM9cU/muY15bmOe25jntmbCVDCF+ZwiOk0VU8QijMMUsZiM+09hrqYwV1NaEqaGKczDFNtp2jDFLjJTMMWyP2OcwhinUPMU12kcprhFZiqmOPah5inUPMUvNHVM8Z1mGqZ4zuvZhzqnUKcTC9ABJzrIhaYdTnRiHBy1OWpzln1sh5kOZxeaGXCWfajHUY9z7OM6zF1w1OCowXm2+w5zNxzndZx3qlhg7sFU0WFmYirnmsq5prZ8XFyGqXaBmYWptsPMxlTOMpWzTG09QVyJqW6BmYOprgPjMNVtNPdiqttk5mKq2xz3cVti+gXmPkz17OM3kpvMLzDVbza/xFQf96mLBeZXqIu4T11sNr9GXbDdLjC/Qd2y3S0wv0Xd/f/rhWQnuYhcTC4hl5Jd5DJyObmCXEl2kz1kL7mK9GQf2SD7ySa5mlxDDpCD5FpyKKZfYH6Huu8wv0fdLzR/QN13mj+i7heZP6HuF5s/o869ou6XsqXL/AV1v8z8FXW/XMXtK8zfUPcr1RdR993s00P2mr+j7leZf6DuPVv6yAZ79vN1k1xNriEHzD9R94PmX6j7tWwZMv/GNDEPHZgm5pv/YJpYYP6LaaIDN2KaZbudb/6HaXYBW9ju2O7mm/sxzfO1n28eQLtYYB5EO9/bbheYh9DO/u1ugXkY7a7DzEO7X2Dmo913mAWYLhaYDkxn/+liIdkZ0y4wCzHddphOTLcLzSJMt51mMaZz3uluvlmC6fT2dNdhlmK6W2i6MN11mmWYTj3T/XyzHNP9ArMC032HWYnpfqHpxnQfjz+D9TVDdJgezBALTS9mcN4ZrKkZtsOswgy70HjMsGx3C0wfZrgOtQoz3EK2/H/7InIxuYRcSnaRy8jl5ApyJdlN9pC95CrSx2RtzvAdpoEZfqHpxwzfaZqY4ReZ1ZjhF5s1mOGXmAHM8EvNIGb4LrMWM/wyM4QZfrlZhxl+hVmPGX4l39ttNmCG51y+1zyCGX4V3+XNo7hLLDCP4S7RYTbiLsZ+l+0wm3AXc3uX6zCbcZdfYLbgLt+BsbibPrlbzDdbcbdYYB7H3YLtdp55Anfb+eZJ3G0XsIXt7qG4v3uYnGeewt1uftzu2e4fNk/jbj/PPIO7fdx+j4jb7xEPk/PMs7hHsN2y3T5snsM9dp55HvdYtrsH4nb3IMk+7mHzAu7xbPcPmhdxj3/IvIR7/MPmZcwU/0MHZor7yQfMK5gpHjSvYqZlu73fvIaZ9gGzDTPtgxiLmY7t7n6zHTPdA+Z1zHRs92z395s3MNM/YN7ETB+3z+L4s8T9ZgdmiQfYwnaOP8veb3Ziln3A7MIsjj/LPWB2Y5Z70OzBLPeQ2YtZ7mGzD7PcPPMWZjFXsxjLLP8g+RD5MDnP7Mcs5m22mGcOYDZzNdvOMwcxm/mZ7eaZQ5jt5pvDmO0WsKWDXEh2kovIxeQScmlM1tFsP98cwWy/wLyN2fTAbL/QvIPZvtO8i9l+kXkPs/1i8z5m+yXmA8z2S0cCc0TXSIE5YtnIozBHLB+ZwByxYuQwzBErzWHMEd0YizmiJya/3Z7D/0Ofw/9Dn2NXkCtHtmCO7R55NObYnpHHYA5/gzTHdY/8EObwtzpzfPfIY3Evf3t2r+ge+WHcy99l3WvZzv73Or5m/3vZfy77zxV8zf5z2X8u+891//+6BxMw1/WSq0hP9pGNmBxzru8e+RHM9T0jP4q5vnfkcZjrV438GOZ6P/J4zPV9Iz+Oub4x8gTcJ/rRgftEc+SJuE+sHnkS7rNssU10/F8AAAD//wgHbIigyQIA",
-		},
-	}
-
-	leaf = make([]zoneLooker, 14356)
-	uniqueLeavesPacked = "H4sIAAAAAAAA/7y9TYxcx5UuGFcpKvWgn9TMUCOxStAV0IseDNCwXnlDaAxdWWW3SEuUkyrKkrlw1WVVqiqripl0VqUkCmrm9bjx3IsHeHolLwYjY7wZoOHGW7TQs2hYV0PJ7AEtsDcEmkCjHQYHrVpYz8GpxjjUDt4YxDnxc+Jm1h9Z6gxIrC/uX9y4cX7jxIm5r78x6C7mX/n6ue7Sat5jHi8uDvKAlpa6G/NfP5efI3Xry93OYCPgjfM5ueT5/Hy+1iewtzzsUrg6XA+wu7GRDwNcz3ubFwedUDHI33knf7O7vk7qhqvD8+eG5JGzeXfQJ2gjP7ee9xbJCZ3hJkH9Xr42uOjxN/K1fEDQYL6zMT+Xr+f5+VC72j3XH26GF/lGf5ivh3t+c33+63l3GPrxTwedzmb/rVDxQn6uP+j3wnucyAc5edVv9VfyXq+zcW44WA6VQ9LxL+bnL9BnvriSDzb7w9DIF7vL+XqXwN7GSr4RLngpX+6H7/ZS99ygE3ftS/3zBAzz3hK5eHhueP5cvrHSJVUb+Vo441S+np/rE3hhuEnhRmcQPvUpM6ZIb5zqL+dL3Y0Vcka/N+i/2Q33f9l85nPh8S8vrebnOz1yQjc/3wnf9eX+MF9bXOlvbvqqbw/z5XypP1zuh+e0+4PN/p+83H8ztHUu78+foX1xZtC90Cdde2bY64aefK3bW1rpd9bY3NfPdyzd5BT1Flf6g3y5Q6uWh911+Ji+ZrO7PKQVg3x5mHd7UdVyp7fZ7Rki6PT6G/Nf7w46G5OOz+ab+fl8sDjx4tn+YKl/buKhbw1XhxcnHXgpn3+l21+deNGpTm+p/87EQ690+/Mv5OvrHRx5Y8fn8vXNiRfO5b35bw2BMU089tKwO/GGZ4aLw/OTL3t1Y2WYd6OnDaNe2Bj2Frt9eu1md62/Ru/2fL5C7wBw/vm8t9QZ5Bu0fnAuX6Kv/HxnvXM+gt13yHgwnG/xT+byc+v0+c/38/nvdDdoDz3fX+7HuLtB7jObnz836C4td+afzy9G1Rf68y8MTENpbW9x2KN4kC/S15jNL3Z6veiKi1Hnzq50F/PlflSxMsxX6Die7Q/y9fkT+eBcfzig1RubZkzRITo76Gxs0g6YHXZz+olmh6aF5HnfyHvn88Haxkr+Zo/WvrXRH8Pzs4MOJdJvdHpvdgYUbw763U1S0T/f7UUN/ObS+X4vauE3u4Nhr3OB9NE3143weDNf6pN7/2l/sDn/cmc9apapzNc7lHJeWM8Xa9/uhf7S5kp+jlb0N+rnmC87f2Y4WKN1nV6+RO89zJc66/0hbewLw3yzcz5fj0+7mH9/2F2nVRdzyodO5OvdN/K3acWb8Qmdwfn+Rnd9nXyqk72lbt7z/xp+ujF+9MVe/+3x2lP5oNNbnnCzdmezM3Ays3bsTGd9fX62u3lx/NB3Om/mk6q7vUUz4Ce067VuLz+fL9IDwze7pLtPfj9fH9LR8638fB4Nnm8Nex1Qdix+sdPbHC6uXfzKS/1hd8NJ4vrBU/3eZnexE3Xli4N8vdNb6q6S57+Uz7fzdwjunifPfskIi95yZ52+20v9tzqD+fbAvDWpPpUvdrp9int5JJlMxTC6YNBd7m9GFZvdXvf7ww6t28zP9wf96Lp38s11yk9OdXqG5jr0us6gS0fxqc7mer5mrqN1b3cX+7Vvfco8P2Knp/q9xc1axWZnMOhcrFW92V3q9OO6QSdfj2s2OoNBTl765dzqsg533pr/bp9S5MvdC91l+vyXUcfwaNDvreRRxebK/Dfytf6mkRjD9Xxlh4OzHfMiOxw0DZnLI/Hz7dVuL18mj2rnZnhHeLnXHWwOe8u0cmCUiu450jftlX6n1yUka9SpP8mHf4LDKq6f778xP3ch7/bi6v78dzrrK/Smw46pfaW7SCpfybu9i/OvdCOe/UreW+v25k/21jvkU7zSWey+0aF4OdKjXuls9NeHm/SMbn/++UHeow+cy3ub+YB2m6npRvLO1PTnQVDE1f35dj6kNDu32B90Ns5d3Bj2lkhtd3ONNGxuc/75fLC5YpSFi1H1t/orvY2o5sXu5mZc89JwsRvf7MxK/3wen4Q8jnTW3FvdNzbnZ4eDQVR9prM8XDQGxQVyyzMrQ8qkzqwMjeYTy6Iz3dVhJAzOmHG92Y8qNvuUfr9jOn4YfdjXVrqbnZX+gCo3r3V7ve6FDhmR383XhpuUCL9rGOVbaz38/Ob7LW5anvb9YT7omlqo+spL/d7yxU4+OHexY4bjRtdo7eHP9fM5MBMA51Hpgb97+dLFgQPf3wSKt3/3z3Uc2FhZzs9Bwwx8Pl9eWcqXPFoZIA0gWhv6P3vLa/01jwa9HGxlQJ3uYOjv191YWev4E40G0rVgdqUL6iH+3e+ey9c3fONnV/q95e93gaYB99f754GaDfpGfj7fWATGDnAFjTv4u7vuHvCN4bk8/L2xkvf8W7+Qv+MuMOqef8UTnXODvgf9+dmV7vypbm/F1/SW51/s+1ad6L/puurkYG24ueFe9FvGUPdv9638Yn4B3QAAO4PhhuVyBr+Yn/Od92J+fnEl3/Sv86JRdFe6HpmvNfBoc+V83ltyH+XFlby3dHHZX9pfX8t9G14c5Bu9/sV84Nv44jBfz+dfGp6/MPR3HC6uhD5/cfhW3nUf8lS+6AfQKWOa+k91Kl8z8mTgYa+77p56arix6MfWy93F/kbXHTJm7NrwnV4n9Jqp2uie64Y2fvt8+HOQu05qr/T65+fbHf9d2kbzyHu5O7d90ZBM7t/kdL7p23f64jsX1/uDJdeOV/Lect9/81e6F/Mld9u5fG0lX/eDY86IlLW85z75XKfvv9vcSt5bXvHDba7bW84v9AduvM0NOku9zlp//WJ4oTN594InhjO5oZOe66oz57rr3Q1/sLMy8N19ZqV7/sKK+xRn+msX+/7vcPNX1/O8dy4n7/3qYHj+++6Or25s/snLHT8iv9PtQAe65n5nPV/qvtnf2PQkDuzL3/27nbV8szPo9qw6i3WDzpvQSqMoGb719Xf6aPO7iuc7g/ND6HhXM5v3cvBvhYoLnfnvdAZg/LnKP80HfYpP5Uud7oDe55XOxbXVHLVcVzfXH26uzL/Q6Q+Wu/Tcuc35E5119MaEury3DsrVcGNzkK8ji13Pu9AOX/f8oLthfUGkrr/W6c2f6K6v02ojpbrRid/IB2/BWPI13xwuAqX4ihP9c/lgk9a81O0tdZCnh7r+YGn+RP+t6O6nOuvGao3b1u4MNldoxdzFpZ55zW8OB/0Lna98/fzGZmewlJ8PNb2l/sB0rcPmyrUV0wBXs7nSMfLdwuc768uD3HSTrxgAyTg4yDe7G+v5mzmpGm5sdNbJPYaLK7kxqEnNUn4hrtjo9pY74b6zK92Nbs+wJFfRv9DpreT0nG8Mz9GmvNA9N8jXgSJczbAz6G2QDjnRWd/o9ta6vuLkxnrH6IKnSA+c3NjMe8CybcW3OgN6kxcNz+j2TLeEum7nTQIG/YBe6m6c64e7v7Q6PLe+CmqJq+n3lugJw7c75823XvZVp/KlQXeJQHBVeTTodlby8+EOp7o9Q8oO9Xu5USY93Fjsv+XhtzfWw7F2PuiGz9buLy33B2A4uppBvjwMQ+GV7nI49gpYEBYAK80J7M2bZvb6pGqQr3beJBXd8290Bv0L/dDvc2v9C6vkpv03uuSem/3FtZX+ehjbZ/L19W4vdMOZ7gBo2cJX1y/mvf6bpGdefWdluT/oh479Tr40fIcgo7CF+xkuSj7bd7rrve4wdNd3+uvL/WhQvJYPNvLQ1Wfz5UHnHIEX+oP+OysXQxPPDgfdxRU2h6b+V4zW2DMctGv6yVbOrqDv3MNBd2MTVGtX01+kx/vG1PXwxc5geWi4o685la90CFhf6r7Z2SAVw0F3E17T11zsb26GS17pDHvgqmzni903jFi4YL6SR8PFtXWQqa7m+f5wOe/2rJfB1c6u5JsrhlGFiuFwLcBv5htgV3r8Rr5JLv+m0f7PDY2scVV/mq/l/Tf6pKK72iVo2MvfgHkUV/NCvp5fwM4NVefPdeljXxjmS/n6Yt4zygqpJA0/0e/114frw1ADFtMm7aMXu6ZXz+f08S/2NwY5eaUX38pX8/VOl1x2Kl8dDvoUD74/7GzkpMWnuktv5aQXXs6HA9KUl7tD8oiX+4M3+utrtGJ4vkO+XjtfNubjcp9Wrefkhu3u5mLeHZBGtvsrPVB8QsVgc/4U2pyh9pV80N/s95bJ0+by7oWc3OlMvtKlPXQmH+RvkfPPmMs38wukOa/lax2K1sG15/B38wtshq2y7ynzYwyB1lqnFDACEgoYARkFjIAmBYyACn5KtfU+f2yVrcK/v2C/QBQwlFVbflGr+UWt5hdxDWlNVRnwiyxtNZuNJHSB1rqagavxV8BpaavZaCTmdfyRooQj9gDeoLC/cFpRcjjNnoWnlWXttKLkAk5rJAkjp+HPnWbOknCaPQtP45zT0+As5Y7AD4/gAXjt7wkhBB5ZZb8YmU5wfSDsz/cOvvYq+540PyFEu96re+A9i/3mEY6Px+f7L+L7Xdpfe68BUH9o/bF3+ws0phR8qu/ZYaRm2PdwDGvdYAQwAhqMtdn3sPj2fW+Psnq4ZYxG99GAQ21Q+0AP/xJ6xH0PoLE2vfMdt+guWnfw73HI36u906MPsUG7N2DyYw+xR/Zo0IRPMLkBX1aP7Ftu7vRjf8X+qo7jml2u/Wv21+3xW9xxU/76TkobW7xTE9hf128e0P7Pj+ti3J58wfgNdn7oxIbsu0/bbBuLv5T8jXi/t7qTXzI1TgVjDYibGPBBSzJlSng0lvb4I0LNwR+YTLkH7bccmAzrnyR+r/Bmri5+XB3fUQMOs9gGxG2kDzJdeuff25QYjZcZOIWxZqtpFCfzRMaEZhYoobRWoqx1VJ3K2Gn6tyk7H5+E9/55fuULPGYMj5VJjYofX0f0vPD3eAMmNWi8gaQhe2HaVMT0+Iy5pbZqlAUja7QYkLEAUm8s4gdljPECPrV9BsPvnjlrxLQcf8I/x6jP7VhEUKHh35KiHT/DnRbXmlF4UW8vG2DfNPo6UXu+rHI6HoDQ00KUJS9tt2OPKdXeeaSOj7L6WD2MLtyRyYX+ii449G/Yrj9wz48VN+euG7Qnmx/jZ3X1bpdPVKfZ+mfTYMU6Oiw5d3SYmnFsAXp6ADQsIe7aa77nyMia9GBaM+n4btgzgmYgPd0gdJgEOhzVG32nP6uh/00d+7IHpsbV6t02Bu7yN3WMpY59A+q2Rv39DqNRkxrkGtVOppPp3S8ZP26ucde5v00B9YHg+OjkcvdjYC/txjeN4lDnqU2p0ku9QGBpq3k4Y3X3X3KsVh4if0E59AbQm++nzBjKcc61wG8mDtzD/9Upawa+Hyor3HxDGI2tBsrT6cB8ACSMAKcE7GDLHJq+Hg278YFI1K1CBHULBt6x5KGysFqZAVprWRAQTtNaZ0kAKb1GFfQGcIdwAy0o0BREp5XRaeC//hs3owAfQcmqYuynFfSueeA97qFSlNErVJIX4NCHbwmTAOYGUkiFp01bS5KCggKtpbR3q5QoCzbGQA9eNBnFdYQtrWPbS4DdOORcCDfAmmG0tdxUgfu+o8SDtOGPjJ4gH7vSpm8lr9PYmGy5C3La14/QGCs9jelW4mnMmhcIEv/WYYJkO5kaPUmAVv+FAPMVK1FErM2wpRghHuOQu5Sxs2M7to6nTLdPtRj5BpxF1CeEEBKHu+RC4fwVvEFBX+c30bspCrQon6OqfkWBVjOmWZWSgoPOZ1pgGYCnvjKifymEbY45sVDWpODC0BzczfBppDEzpiwthhtUSsINitK9qaGxWyWj1FdJHlFfRcCHTY6vIIUU9E31n0dGDSuc24AxlmDbkOshLcNpmW0bAEZA04MsbREakTxmbqbikEQku7JLiY2FWs0dNGDXh+31+IPbLnf00LtvwF088M4bcIgPPUADZswpYWb+CjsduOEVdtozFjjNc5kzyaykwJBaAKPRUx4kjNCr4JR4tVQPRtKyktJqGVwKZeS1IcQgkpJjrCDAMCLBjRZs9A/OgbVAQxkrSpz+Ps2u+BYAAQgppbk1vI+kL6elKIuyhFbzIno5bdiWBxr1Dw/+mAIenVbNJLPJGav3KXek2Wy4W2fkOTYi4svW3c0vOZOcSeYiBDVYrCAtSuE+T6U09IIVipY5O6ZJQRLAKCXyhVGpatifENzyVvh0yFubCZFj9pJLHpTcSJGHzJd3iiMAGcBI60seMJaE74tKoP/Y2O7xaQpPOBbfpXdmT/ofo9Ea1dabQZq6x/Fxz86kH9KyISOgJBygWdpq4Zhs2A/SNuODjJSdih1B+6ipX7XTcXt9e+eH7tCsSQ+ht56LSh2PlRmkmECvc0ZJMmyG6J2qCnrnt51ODeTu5xw4oQOqd2oeUwiP1bFIY1GRxsKRHZaM8sZKlCVhlMECY6yURnEDCklb9x+M5XjLSqOVzT7FEiPEARlM0fhxQqJGBTyTzCpOWXBKQEolT1nj1FqB4JhNzgivkc6aMWJ6xIzj2T3H8J2UnQfSmYnDSIsyDCP7RQNLVuKuZUDg7g5jqWNXE7VyOpkmg7rwDosPm8Rh4dn+tNZH/NCdbhBOP11SoO+jQBojZQEBS5qpu/UUPFGUCCQIHiNHweDgkfQ390Dro2BEbjTYuBApUDpg8JqRDuxTFMqCz5jRmDbDMFoQigDvgDnE0TILJfyFZWH3cvcNmK0N/tmonEkWajX4YI+tLtPAabWg2LDSEV/aTJwukxCyhA9QFKW5Zs59qvbE5uyO9/c6Md6DoUtesogSq0rNGBLQFS/QmTNnGTowzeBiCG48dDR50Agkokd2uGeNRpZl0aRliV60LG1haKIZ1KLSMMYD266MHQ/6rVRGbbKKDfwepuBtCkoY4boC6XBwQ5N9XDMi7hIDIQVKXEjOsKTRaDSMeb2A44MJ826zht5G4GkCEJwcbrQVRt6aAeZCZO3QI+LBOrGsZlPG4kFSoEHnmU3msNPwOTBCC62F+fKzzCqRMAzMH5VW1UwyDaNl1EQF2YAN+5zpZK7hmUkYLYUdE40kcS61VquFkbTexQC9g46EUZY20JHQGNOJgZSM9E6zFg6dh9inErTgUgI7M62GTjRfIoQwX2GnFZGxC+AICYxuRECDHmEENClgrAigqMwALYvwTVnpvqkeZS37TfUoq31TxmT4JD9qUAtKROYUp9eIcGTUdKf9/mZCP3AlpcBvSpQBoHNUgew3LTiQdjLXbLXCl+NSVyIyUCGCABxXNuzZfjmMtQbfTqWsT+wh9qmSvCxLY4Z+zK4oFb7Cn8FDSzRG/owxcanBPHDuWLBjHac5jQZJ1rR2LPzl5ZXAgGakLPwp+00xaN+DK4wAXQn6sZhyH0vrNGEOpE1PjY1AjXNhwM4lsz6u3fRopcBcJpwTPIbGjOQlRJ8jr8Mf9ptUMHgi5z0yscoreIajJRQwCnQEnH6FANwTHmT0CL0mI+BadDetK6XsZ8Qf+T6lcN9n1LIBJ1dGhAktOCGzXyNq50IVgjreR8HWjBqhaZ+VBeUA4GcVYUyAwQ00C/oZDoMGGx8GQEuzYbhZ0LRjYjZrNQl/NMw/SaxPSAgphBV5NjJfoEuZ87IsjJh8iH1aFEX0RWT8fSNQUqAVBdrzRzt4w5EqfNKCK/tJR62Gcx21iEqzIAR4mwgt6aqEfitsSwHgI7mgXVVi74xAE8XeCU4yy5AY49IJGTvD5cG7CNIGS5LmPUTIFAWC4h8MNd20nht7B+IV1+hlg+UZjolJay0RxUNZyown9gQFds7A0axiRelUa1UpUUJfe/kFWgHwGSSfj40KUOd1hed1Rg2wwE49GRCJH0GB1u4VPHtTwBJncW6iAFFv1JAkcaPXzlp4UQ/C3n6FJ6WSFkiltP6N4VvQ16VQ1lU53fTLmIxx75ZygLECThXao7pCVS5YtjgzKOKpUmDKphPN4C+ioVyhKIF5kyriQay0YqHltaIFK5eAnM8ELSLwe1DIF5ojN0Kgd5LE03awrUFoKsELIkGNDIXeKejo1YoXPFKbI2BeAeaBzHcovGytqsrq0FOiID362UcE4CSswsmnMvYEg9j1E8k87lElChiJo0bCkmbLjkRpbmZ69Ar7OLgnr7CPMwJs9xaExTecCE4JH60krJFyrNNIfSOPjQ1FuaV07h/XiabddoiV1mHju0oFCyN1dvfIymDokKqIe0frqsAOqQSvk7NlqvZ9uHWdZqAtA2hR2WrPqqDftA1PQDBKk5icjZYH/TbSKUba+n7j0vVb1nQUnDWJaFRIJJSPSuSjpqdKLhSORC5VVekK9YuKk/FmWZLvtwo63iveAuk0TdPETf5a++s5aSe2R6DjoEqeWb3Z9FuYVIVOFCzqURnTtipp95a2ezWKDwOytBm6t5JCSvM+vhMLw/musI+bjazViAZfISovjBLXiSnVz3kZsUENfUp61PSbkUyMsABd4ao+K6qzFC2RuWRaRORsnf1hWIpojEo0iQ3b487IQT6aBG9OUTAMDEwaSejEwAaxE23EiOtRVdLuNa+APQq9YM2fNGhsRaQmgrHOS9u9ZWmOAihLc4ttT85AWhZkzdCjBUwgITnD/AQX1n3BuZBSoY/guDd/YFgCOS+EwcelQuO/RTzAoKyLwuk7VpsESWskRiwKYoWYt11w025uV3pOHBJVx/XCJP3blBgxozwxqXXWwtcxgEp3IYSSsLj0Cvu4oDxVHyegSY9cetoBZ+NAwIYBVokGMBY5CqLlErukdVyjD/HHvmv+MyXUIdsyAitiWxGQZUR+zq4aZZm1q+aMDc+FRIampARfEzWYbKyRqpQsKF2xhPAwLpGHgVvTUY/tKO48CSlILADeL3EsmSJeUnarDNEb7JbWNylAXdsDYyUFUASAHB7BLXKDJnnOuGvWTekZBu3oV4IvxgEpwVovZsxwtNcrOAItALblQKUIuMUI2A4A/EsOhOdo/QUvGblm9OwTCLJRM7QtsEdzGkSckGuK6Ab30yOwaluyT41ayksjM70AZQKJBH+CHMnedQA+EfIwUdnJWEsxaVBJiFdAgSmlVHuiX3cXP6+3F8cd0xY7WjAoUAYeHaciGPA4717hgOeRiFEllTcYSeBJQUpFJ9hKgTrRH0iIZINOoxVutTyO/gznFQA0yEDUfioBx6vTGO2wNloZu+VpSYUjOiGnaV3Q00p/xKhpDsBj27Vo2Yd2jJ/dZ2G3DlbaB4md9Q2sY1Jj3pvWhUcFHMXjGkIIbjikiopTelEB3GxEpxl9idA/qK+WsDWlci2QxoQwQrsokF7wmahfMcbssqDgvyFKqlswVFgzPvhFwGdqtHYlFSon3g4Swg5kUcYzBkp687xk1nk4rTNjuTcIGy94aVXRDCQrzgt4vmn1BPTwmTGFsaTROHyYgtIMSugq098H//J7lPg70y9v6x+Ly6E3YFKDCH6sXoyAeSyEKBlAlHG004G5Smv4MKNyf2rHVNNpOujuRxCCij5mV5QscbAYgAoAL6n1aI3zEU71hAEGfOpMshDiogynNBYw+HLAl6oriHGCOFVwsloFoLRafzCNqSWI7m2nDZToZZ1quTQYZrBUnPJDK8nCMBIceNtj0pmSBljmWM4kj3laFLZ7nWrgAAvAhnwDaIavkFFRqiK5KmI+oQrwguP3KYw2Dl8hdaZkEJiFB0VZvF+hn6nlIpHsJylBMz/NrrQaxBbldV+osjb8HDgoidluNHjpaD4Ir9SPKjAHWCzWlDRfGzyeVaWksPIqtuEVqiDBoJc0ArVEpa7ZdHNfx9gtpZSMhRePWUMEQKw95tU9/01h1tN/U0a+qU7CNx213GfMiEYkhTGJOCf0U/BAP4mnH2s9Ohui6YjJT77Dl3uu5MybDal1yRkQaC5QllWCMueeBr2Hj/kBnQMWogKltXnD95ltNMjHEt4Xavm4gkktx9Q/u1IEhcQo936yV/h4iCx1k73TvyMewmm7xsGdFgLkppsNMg6UeqSIxsFlKZUfB6BJmnHAXP4fUOEhX09VwTcNw+Uxdit8rMfYreCOeizSpt3X1v5rjzJraD8WIkJl8lCWJpB3KHztwhh88E3d1NWnTI78+3zKpJ/zj4OADrF8DiVGtEYiNq35HJkbNu1zeOcWAYuMHhFPBTBquCOjln+3z0O8iz3Ng/cbtt8NCE7FwKqU1fqbY17wKvgndIMRQOxk3aSAWtBNChgBLQoYAS0KGAEtChgBNAhrwUgBXlifE/chO7PJXAUOOVU5Z5IULmDOGL2yqmTwErnFxc8a8wbWL0yx7Sf8czwXLELofWNMtb6TMrXz8gtQLXbEqO84/spRyXT2QGL5K7a/lEiLbpLQANZoRLRYcHAsPZQ8NvI+0oeYDIF9DzE5SgmoJBWTGdFpGvSagoKEAimJoa3RCVcQPs5Fm5AQlhh9Tv5GIqN/U1w/LseOj58vDcl+7lrzuCTAKPdAcY1G0xMmTth7WswISCiVxiSrnnPAS1ZPpbxk3jb/vRdLKDKdjMqs29tTqaYgJSCjfi5KpWUADcsq40jh+jrxw8dxBM9f2WlOCC1Qbv6ykBW6Pmct00IhqRScp9z8WMmt6xPtmwuKLBjUkhGQPRFAxtyRUVhqth3medh2mgawFRTGKbal3w3gFnhlSw66zhRjDau2G1osilIqXlDpVxDpNyLSr2l1naAjgmcKQ14QlLLSldGpZDylLD3nDoAT8H6THrmCri0pKztlaWwSr3FaC5iAz27yMjJ6dQSUb44CLdUdgfUQkn3u1VwDwNEuhHSk8KFRpCxws64AbJicPS2QD53dnWfsYQ+ulIVUlZSggsfmTkFlR3D/oLrGKaBHaEDFj4PffZaxxMZUucnzwk6eJ0mjaSO02HbgotNsW8uy5GAb15bW1VPKHPYqW7bFtuhfM+aPpyxDa0PdHRdobVw3jus1UQFSyrx1McVuSaVECRJ3ytsQykkyZG4GNJsYANSODO9xY3yCcX7nBSYoangHX0MQKGP4MYrwhjGuCaQ9CqFESuSZJ9jUh+xJWE/qQbPZTFsOKCGMjko5S0lunUU+L6RroSCGytO1MVVKIjBx9ubzrOlSYXqPc8uDHzvX2Mes1QzTYYo7i8jYv7IWSlcpya1+VymlVOn1Ox5m+LUNX9hmW3AvmCXaAisqMXIFjuDrcOZBs9EIQErJZ8ww1/pey2YAFAUrC1i1eYusgTAj1EUs+LFbKTR5K9NQCSqZFFJZnvOY7dGSApjiDNz1fujewjoyfV9XYP86qwEUAAtMPwJIkjFnBXqJ4CukzQex41tNElZQcu4XCiz4V3DBRG4CadZOySML5KVzXRphLKr/WjpQ0a8AsZoQ/m4YB3tECC9L7TJhBxoEEJEbogGNyIX2SAEiN0z33yJLukzHq5KAoIYBgBAK4C0yTEfhV6iUQMfu52VZloXRNMy4DosTP2efWsnhgPlwFYbsfewcAs49J5XiKIgKFsmeytgnIZLNEAaCEXqGDQgrBUDxUUpCxJx1CEDYh1vSgA/dZltSLDAPtIagUGU7Hjg/6EdbaQvDT0OPFtz3qF27fiuZSrNR5vtNuAAK4p4rsN8qaSfBjWZdFBiWY0Bl6Qy6qqpsuIzvt0rSGaiicGO0BUsn0KHmwmQXkjOZSwgMXYXRC1YY+1A4F5tR2XnNwoYFYFc1GqF3uJC0q7TggkvoqrIoecnRVX4LRz/O0kyFT49dJT0YZWnrYT/4KiR76B37csBhH2uwxEaHmN5RlSoobeNyK+Cj9n0MKJQZL39Renej9wBLVVk6tbG5StkoZF6PQq5gRmw6mWtlWTYaZTbw2J9mWacqbIegICg4sE6dZVnDscHCHoKBxJKWmw+Griq5oSzoEBeXipYpuMOQ5oAhGvvTmGAaQ4DhTWWz6fwYXpaUwvG3VitxmmAj5m8lK0o7r5l+SUlp6Pw/e4+9R468hxj/dQW/iFRVpaxnNwgM/CJAM1aTLIoSIrrM56ER/Vy4NSP280DMrP88MKi22HaWZjpr2M9TaS0ERj5b0mal8qSNDigE15IwkkfpfQ4E56d370mriI2cU/+x5BgXzznOmRx7zHPox6gTwPAGXpSCW57KCuC3Aj42ntSgH5vZjz3SWv+BeL0SZ3b71dJw2n8kIJomKcqyZGVJpkm03i3L+3gsB63Bb+++efjG8QiYfHxsTDD7tYGhRRF7lYqdwYHd6wrjdYiCo1C0SinKBcLDNMbCbvmVP9KJVp+ucCvTWdZKKclC9vVbYf1Huz6zVp9r85hMEdenjGNcn1LeHcOYwkbjsjschw/+c4VH0lYLl/QYpYqbgnMCn/uQCeCoEBhUeI4qJMhp4CbHw8j5g6BjSlf/QsCH9xLguL3TvWRBgDHoPVAl8eV+NVxz60J0g7KkN9DKDuSkicpoCNgL4Hs2evxjrd+3PlYUmaP/3oEmjXoMMeKzyZwYW5oiBA434D/chx0zg+1MoO/RbbZVUKC5sRxKHG5KghWBXEeUJcxsUFMORlgIdDRAa0joU7kPXApR2Q/8R817PW/R2RP3e6Dk22+HIzkLLKhp13y2D9PwMwNsV2yGa4yOtXe/Qf2IO76bqWepRIb/71aMonEsTBbI5JhffWXAiNII/MV5ADgODXu2VoRjz+idA7uu5YLpg+qGcbmjUfakH3q4k0OJwYwhkiDEdCs7B2aUltLNdHEuKrvGsUlHmzEgCsrpdKXQojDX42KwKXYrBKjC0COAJpy6FUSUOc3mzIFBaZpipJRnQdcgQUHgRwT8+IfMM6fjspJwJEvTNEuzpwEQUZgcMyZzALjSB+J9XIKTpygAXx8+Z9TCL5f69f3GMOSFscqKNpmDwnmoz2uzUrvhevHHrcj41C9a2fFHo8sKYmUmlje1nJv5vZ1KrFKhsI3xbuJ5v7+wNBRW+iwkZ7S+1kyccRGsYcMp+Zj6jObadIgkMFI6AoICcwU1SCBBm5u/HaX3+5W7lotvJ9OjUXqvH+9SUlZrFD86+JUsiZgvOYr5ZqsZ/GnML2OaYrcqG10dOPKVBmXPRp245ZQGzEt1S2du3dAUncw1RIYk77zeKHCAekAMgDfpFo2n9qO6KGEgN3FJLmvvMmUTYfvVZYyxJhoX8oBjAiO1wTip6NrhEpeyNMIS9Dker1GpwOUD3/dBOgyUlErKR6KVKHZNotGDeREtkCpRrnKO8Wmeud1mzDmtbCQufGxjV+J05VaTfhFVgWkQfUVBvu8P8IsIp7Pbz1NQoLX6Gv1wlYAPV3BeFMwb6hd4UVpD3W8KhCsPGQGal57blzWtA2xeIgc0REmcxEUp2IknacjEyZC6aC45GdGfUpKSXBpAs0VOq4qCnhYWCxrwQxaTqTWTQydOJ0eVf7np5Gho23RyNCx2mmbbIWAXk07VbLdaxjhuHTayZNGnV0h/W1kGS/DwAws7P4YySlZVGX05WeLHKkte+BUI7LgR2RLn/m97FcDw5MsU6FakSeonAxjR0woK7DyRu0ZI8RSqADrLsjStW+CTLGa9q7VUt6gniY0gCOj9LWkjU2/axf/A1CMCdhwxDEQwx08alRRdtiftMIDZJBiVbknvXHJylGUpJXpxgY4jOqhgwRYdVGGElcqubIMPr6QkAzGjo5Lc+odu7CmlvkZG5e/oENUB/LpJB++Po5H8LgFf8ACShJxGhnVyNEg5A+itE3paEV2jlV15iG0zg7+YSY66hQaFcEcyXEGAAKZd27tnSQ5pi3fKLjuWffZocrSOaZ3DroZya2V9L0UgWQkfWEnLoB2NbKG7BdUGI4DtGlgbtGFUTEgnx26FrjbMtijQW2lAEBEuzFBIYZez4jX737iu5rKIiXAc71e/ahNqvaQn0GH8kMmP3e34Xj8is0v0qVgdmbUnLRDY/y++evxepiZpJ+0Y0zPatbUQu5c5WJ09G6WSma0llqmlmcHUSzGmyZjaY1lpdslRc/ASZ3qqZ4HCjzOXnJSG9MEZYNhpwez66LnkJHO+cOsEhdTJNjkG4R6gd8G0GzAMd43jHvdjkpSj9pmEyQAH9aCgQKtwA+sYhyOtiEsRMApHrjUoZ6ssdz/qc1hBQuCjsDOjYxJCwYypIv5zxySqSkrlJr0805xiW7HGjfoUUdkvR7ONqkRdQCnFudUFKikKz1g0LB5gEWN5koASFjD8MgRWfsx+GbY/MUceJoA6W38ZfLJwg4oC5zIzd0utvCTm4q/sQtQEgs1DWuddHax6H2xiXw7aNf/3Gpb2Tg+erI/sUWNuGdfCQ/y918bLBH5eZ4/j7xnhtdrxtbimfrx+BrJTqy7jrkqkTZTfuRr/1yytCfzS4MmLtfb+oTrm6cBoYBHAdOCoqCleskLhLPVJm4qJteu55g6B7500T4tQKHV8EoODwIxsIMfw83eG/zS8z+Eo20YKt+yD2WxQYbJHcIFzcdpFVRjwY89L2PaoSRhLpSopSpzD3KLBClvQbaJkZGZAV2TOgKlwBHNGIcj8DWzCaVRsFMz94iK28Jxj7JZh1Q1wZMvkWNZqNnD+TpLTDPCJt9gvYc0UwxQTyD5sEMEv2cclN2a1EHZ+p4BVrW2qqezFIXbmGPUpnTrWOAk04frAstYcdUccoY73VzznsP9SHvFeXNOexEZCodwuusF7u19Xu8cu9XZVUekz0di0UDhLf5Kh51gBjWr9vvVPnUzmRlrLRxwIuc9OJnOgHJcl3qCIKf6JAJpkJcVJSVZSgJ1WWcZgTQwGcXon8WO1GAGMAlhTn5yEQIMm8xRr82V49cFoDEfZduVyCIEPpyg5B9cIyHgIH0FDXoEzBXcssgR3W3qCKwrrvdP/U+K9d1sNKv0x/b31qhkLoSysI82OPhlcbLDc15BVmqYPgtv1l+xyVH7pSx0foGi93zNnzCPSZsMu5bjMihCac5kVIWjnMlsOq4WWGbgoMLbn5+wTF9W5g47AdnMjXNp70rZ+1c5lPI/ze7ubS3sxl4PiGUO7JeaEKmaAoM2v0bBrIrjddMDHcZWFI0VMcwU0FryHQH1FcHKUMcHppyOyioCSRr6cpM6yOUdJGkh+OkwCTSdHQ2iu05Y5h6meoG57ICnQigJNQUZAy14D6Q697u3lCzrSJQH6B4WbwNQYCIny8rdlQeUlbqJgQ4OKUirldW/QQIAur3g12AjCsuSlzWUgg1BzFFtxK/tazVYT8pf8klmXnPmml0H2ZS2YQrnMfunj3wzBFDZlAQC4W0GBlsUMK/zdSjgyGo2yVgPvtoxPMa+9zC7rEWZDAODU+jb7JBTocIJgo7voKMX188cx3GOP47s4DyfJ8YM4DPbzYz9DIhPqXznMja6x94LVtsbea93jaORMsgCunIjibGDPSQx0EpQsMkaAJaVjiVGULMD9g1hB6CoBKp2WmPgJgW0pSLWjMvLJ2Xw7xCTFfaCO+ocaELbQ32ZbpZBCQYaqLUgcXqKNPmVVT5tGdEsqF7/qyEK9TdXIyqqRRSldBNLWHxFBtiXI7OzWRz9glMgsYYLn20cET7FbnmnABAYjROZDpQyolFEbvOppfhnOPAkpBJdKUCIrA5E1kHp+SQVREb420BWk0+SOrgpm9GqgK78q2NBVAYtRqn+HTRV2+qGS6vaEjszSyVotXlK7yURqo3ep339PGXng93C3uhTjyY8dr9uVf5BQrp+F/7uacV4y1mMT+E+9McAlRlmmM31bzKAhAL/KzSuCOrRHRyU5/fuQi81MncPuArzkAk1nM/Qx9Z3jPyWuqTwZ0hniJIlCd91JiL61030noynP5GhIv2I4E9iKkSKtrfh32QIAKC+wyWSbASQFItuSvCBy/TlypEElfpCqxlwepaNRNjoOIDixcGpVa2n0+im2xSnPqijQ/1gUNcVAOr3+FnBx1N591LK5m1EGYHFfMLG/RoBAezvxSzQN00PzAZlek/K5gnLABos4oLSLjW8RwyCAhBxpkNMepDOJlYxuoIo7XvNan6q/0187vl0IhtoJ14un0jtsEMoNSNPGBbD90utJlw+xFCBe3F+F1vivK/AKRVz23wB3yeX6LQ5W6o0geqcRiU7vhDUHxrjzK4CMqqm1Xk6SWLvcSxvcF67v5uuO1rEthyygazbkpfhYZHNeigQ0PewO7duo3b83aZKAppJ8b+15Z5mvd/DW07sGUR4s8b23kp7o6K47+0JU/qRGxB8m+giXDjQGqIZwsOtMmXjkvbFwuJ1WEpCO/lm9JrqGqjHk0+w0VkArEW7TAlBRYBlugU6Fhl2/sedeIC7LePjX/cIZk2tcdvEILcTIZhIfpW690plkoawlIQX9HDSZsMoqx1CjCqyvkzg5mf0xWlJzfn0c8V2o4CzEDS3BSPvwilVeTrJm0y6nNGoNpOkU0ttYuAXcdnKU+aUpELPKud3I3ptIFGhFYrxMv2+zrZHLb4/BQUSTgRUBkIvZLaMTGvWVLyJ9RcVmVaWUsX224ug+24Jf/yd65HF0ccCvsHer/q9Y4aFr9yCMntp/0tl/ZRmvQMFsFVOwSNFmq5jK0rBA6JhU8lcFi3WP54gtx+6hgIfTIDbWgUuKAHq3HxboCT2QTxNusjMuPN5BOHpq9NJ69wZQVyxeQm5+GIVIa+WtWUzyg0fcOnwjur1qCS6j0iaFvMyWcdGZ3s3O3ctkigVEfIZbbRPiu+oCxZ0/5gtG1l5neHcrLHe+0y7zxXU8wXa9FHN8PVEU16VYjHcUIhRHdZd2blL9JcYbMv5a7Tjyh0VSmq6amjxG7l6zaYdhs5+opb366+DlsLbW3ecoMnJ+lwbspG/sqwk/mzzcdhrMrq7tHjl5WI2XSW818U33WUB5QW71tgDlBW4GyZ0RjEbOc7vtZ5jwiE2CZwDsg0TnMEvcphJVDJgaAK0CYrDRp/t/esE7l5yErQVGZHpEQzYqp1XAvMd0crKZuo0TppOjsItRHBlpbn2UbYddB47ihtNKBKAxd693b9gMB0nD5icJWgVsE8m2WhlGq6KKwYhWIRVGsof5SOe5xeZYfQO2+a4wCfQt+zpOpt+0xvAB88TGiwTreEKyrPFkWlPxsifnw6iUbBO5F1vArqao1dy1dJ1kGbs16n7e5QnBnTH8o4a3jEcZhLWPuS2jVajE4iHs6xIphBVOIO99hHSOeY4PXiY7aCcbZLHINxhJGRcWolEibv3Bqs1uppOxB4ES0UNmJzcrpQgl+u1iwnQkUOJZ8RTzmv/Z1sMBgA/TfLiZZJpp5XLIGxCmSqeZ2zp1LCz5aPT3UYKP1vCOx5k2xQcf61Dq2BRgBWH5vuULkIzVkHVVRpaD4StlALDprgNfxBaKdKEJolLK7tkT1qFPuZVrlQIFEnJBSnT3LFvlXLoRnqX3e9/P07Y5BnzbLtLfeRqxPs148GnF/ZS2rtnIQZeJ6UvXJfSlMVE5tj/Afn67rIm7Y+q7RF+BNnD88ezSoTdg7+bFDGafjqBxrQa0lZ9ZPvIzV9yxGMf3i7WXGdCoGGNPeTdIVSmYSQzTNtsC3QusKDCYYS45yXnYfgiZTIXi/mwIWTqZnAV1wcjX5CxGQzHG7sMj8MsYAYyALwzt+WtKHo7cKuk1l0F5ONtotJqJ3ekMtmVxiXnsu1bVDNPJNK4HL2HfGphawXxm5jSri5BrhDDXHDUkrmBRn47ip2HndqMuWJYDi/8iXqJBL7nhPOYAfC52CyDFkgNf3HJHRn4nk61kSpoWuA3E/h7mUzhH6j1E83hf5YOaiM9Su7WmYYCVUjDdbNhcyD1ngKagScGzFEgKUgpKCpwGFyzgSAGop6zQexpjd/qzqreoKqUKQyOvh8VCefK6vvRuAM/e1/Ag2tMxz+w8AU4zXpASZ9VOJnNhF0YDCF3NpZTIUO5YImslic1Sctao3iWmpAEaIYv1dPAV4njHUGJDI1a7LdyRJ+hpigIdgFuaDaCkt6bqg/aBkvYGVUSlNZKF5hxlrJC4QP4o02G3Y+t5xEQ6MFM6suv1DRh58U8WZqFLkVPFQAXQoMmRBOewfIAS87NAmFJIbtSoQL/KGBk34qlWPKKvkdO0/scZdiOZEqDeCUmSxlvNQgphLShQMzIIs0GgRKUrrdq7qdz7U9J38NF9gIUO7km4vZfef7DGuMfuXOrntC0XwlJE6AP6Jl9WGeuBsfenTRtrXtzAO2nwhE9w8DEQNTo0LWpwfWy4mn02oD7UdqvdT4NdQ2wDyLgsYmxqYjZdx/XfnsdrlEAjDJ0YTL0YXOBCVqqq1IRApLhmJ6wn+Iz3El+TFNwvV72NJmwn/43y8SkhpTIK2Bp7b2zppgvImYwtej15HdDryeuICH59Z0xr6hgFNP6KAH7duscDlMl58nrT79WJ82rocjKiG5JzOdGtIEtFgVow51xJhUrstLDJ6VDycsw2ZKUbigCQbo2G26vdxy4yZUN/mhDUa4VTSWVYRgERdWGV+zZZTgeqKgUglAvvNSu4FalsSwgl/5wa0bC/gxFoQiqbO8FKNwkZKW8kUxVun0OkG+whdCyZytIWdGOdfRyC6+lgrCjoreArXMDQDEz/v8AuZ62GTVG4wC7XNsOrk/LBSZti6syiRDyJ9IGSbDarn3pnUNJ68IkZQxPY0WWJY3fk0nob4BPP46iujgfQsjtm7RojZ4b5vvBchOmaMUd09G9S2rXTD7+8PoajGkxo4BXVueRkJULKjJP6xz8gwGfXPJmc9VlqrD2qcLuas8k0/2VVGaPNG5ooKnQy3cqyBq4Ls+RfQBqko0wnVLmtpBQs8m1B8vBttmWN24gsJVJvAXzGJv8tS4H7pxsTlMESOm4VVZfa6kYyxZ5e4IJzCMK7kY5gqgCVzqJ0GQ5x49/CbZmJ8+UQynSIJLoP7eCDGvrACOIPYEfwYj+M5YOdFEdbd9eq4XjY2U4xbtQpH/AHtlkBu1LHH4zhD9hl0D5UJbmUsp7vcTftYXxvz7o/76D7f5rzgWX95B9F6YNqcNwgIYGExY1v5pLXm82wodNJxkoOe5RgpC0OcbR6s+OB3gSuCKDOIC2Jz+je+6wJvCVEyRasm4hYozbPn/UZ4Q0U0Cjs7wwUYgi25baqcPL6LyQSrI1VsTSKq3GLkLEKnNFuKgoSDHtjUqFnaIGXUjn7USuXbNvQqA/Hv4H0VnJcQXej4R86lRz7asjqlhzTtyFLMm+zmkbMir003IP8Yl14HI+tX6mdfgeNqSvg+7eR9knc9RvfSQPdleNGGuU6H9RPpQ+M8aQGTuqNSQ2fAQ5heD4snLzMPkizsFhjgdl8KqDkJDZqa0JASa2mHm94qe5uj88gzZt4fv3+wDGklLwsy78wes1cyN/5us2wYZME4Tq9wubVCLnJTiZzP2sQ8P8iK2E+Cu79+wjQnwXwQ0z2BmA0GrVaTyL4Hbmbnah0XreiMLo8sJzgWztLd109m0xrITTuPB00AWm5DPwqcqSw/MdlGmaaVcZaMHJ9hlXeXgBvGHJG8IB7oCnIKGABwJZpFjQdA6usAw1BmPXDpflVpSxrKw3bxWWD7AaHTboLZFOw3zbsOuV0/wWO2yGORqMUE97tJNHqEm8/EnAXat7pCFCCFzaGEiD7nRDy7hftxLNrH9XQR9HfATv0UZtm85pEPdHDSHzYOLXtH9Prcb7ZEZyb/cEkna+Dy/op2OnVyOs0G7XuC/IaBBQhK/2UA9dauNUGHlFPUVKEvIv+BgW5QepOCxswGoEPa35Nc6yM927urz38ICE42BvDGttGHnIuFFCSpZ1yhlVMB4vYk1WhHFWM0qYlhAZRym9rhfO47XqWrJ3wXlm2oqxct9ntgAF5bJHHPhFX4df2AAdpokkO+eVhxtva51nadOAzN69kQHafB595jcMY+M8RoKvf4wYj9m7Xms17w62VUhzIv/Qrkm5AqKsSEABkeQFuoXEsmQp7HKKEYhyChy8bsnXbLVxmH2R+QzwrrlCLXGCXWwlr4NLNT9hHYV7oE/YRLsn2AH4uTrNuWu+O67Pe43g3uorlmovPKkpumPgaey8Yeq+7qaAC/Emvt5ouOsqSxRNfs2vRdJbNp/d6SrCR1UH1RU/TnORB9Mw1qBwyfzz4toQls2ezrMHQ0zTN9P/qT4N5UCWl+nM6EXPraaAe6Vd4a0MjFaQNtgC72pMSxIMb6rEBQEhKJZEp1eg4AdUTBLTuIxQnBQczFMlP/7h5TwBaXQjghWagUm1IPpymP0s8GNkUewCULMndbFjwbbYtBITGIWFB5L2NKruBirgFnHONc8FT7EaWthoNyJU8lRwjaZiTY5/9hvOSWy0MHpO1Ami5AU8X0y5wzrkEe/0T9pHyGwO7YW2U/PFhuJPytfPyEYr33uKBIjeshRJSPojiwu96k7yerIQAvteTFW3HmAW4E5kDGue8HPhvCWjR0/iCB9uc3m1ET1P0CHmoX/NgQJO2DbIB+WuyLH3ycQSBfFATLTkoNyijWo3/YGVUo5U270voVCxjqBS2bN4PIEYIgygsZWajbJRdRCfyWU8+5oiu0yyuRTe2a7OJeZSMhthgSZpl9zIi19hPEJSG+QtFVD8VaYilBaMsbSX2iCD0p+3eue6ayxRoTgFs2EKeo4ADKCFKURbwnNtcqZJRKquqv2dAZdhRkBbaHGn5XXxuh63uttkWLLSN3F2QR3KL3cA4CFaG2VfIJXEjTgF8w9waI1un2A1GDWZMXyPK4NRij99PAPsfPJmmSWLJ1GV/AsvJ7zTlc7SMhzHuERi1l6PloL+JC+AnhS/TqZzdY/UPuqTASTw7rwCAb/8e1uOusffCxISRhbar23TREy6E2gvHNe79fU2WZFFNhsXXZPEZ7Umu6V3KSjzfNAlrnayEshcOLBB46EryOvYM6t945JHA2yBxCYCUsLPTVYUxFvHrxJ536pU/nZz2+PRhFsPaTmut/8HpM6chMbIDwWQ3R0i6amtOFGThGrwOTSx31nDXCO2E49R1O88QnK2hszV0FpgzTLk47aoseVC19K8vE/DZR0ZpAVMJJEKz8QN7xHy4Yw0ErTR9W0H6TScElAIhMF09SIQAxuZwjMcB4xuiT43eVQm7JZlTwgoKfkeBVlY9KwompPgVsO2Qd8zwcDuZQYybL6Ow2+TvyhWKDqkB3mYaL9XuOGiEx6l6CPnHb3sfzLNOihUlbL5qxJPC0Gkrq/4wtvyRzMaA4XbDzsw2rNnEhTIE7G0o8/sVCC66Ce+NP2NEivGysAnapvzaQdmuzw3sjuvORnoc0GXilqSeGDp38YE/dpldRlMtTSG7KchHzPxojLhP2M+dCfTvmi1mR58jpKEThVVjcVt11XbSwf12xlY+jR2PayZhWlPHltPb3FUGJEQjPS38HOXrwGiPE3A/PQLTJMWMYfR+5FnmrHHO5HQyB2mCihIyN51WZC+P03bzDeau2U4CSL21ejq0bc6pwQvCMz2t5wMH1JVYCOA3HwVGCZs9OfCkrlTbcvPx4hYQUE59OClO5+K54WnwCPvcyHNNGkbJ/DbV4Gz6ycM6jiFWEljw465zgB9LUVWKl8CPQ5hsBdHF/yaFRNuZ+x0hnIIt4RpjFMsK56puk+2WwUvUaqUZxCHhxqMC1yltsRuwNyJsTmj146cuCG71Y61/9CPLWbDVgtmppmbDZr9KjnFutGXO23VKj7gE8dHWOMUHu5+/Fw41xCNkdH+I3zDWd/NeYDOV/d0lZxnfAY/+zSasRqrH9mPIhv86EFqvlNp96TD+9uI85MhE3Xen4w4T7bKQOym+QZdzKurBML0+1g1X6pri654ZCcqmygB+imnkTmdp9uwTlstMF0SHnLYR/BzVpLJghYSgf9ByOIf514rpkGfIm62fPk7NYw4kBpKqNGL9Ntv27hwD/M4ZFoS9TJRSsgI9a4vdgO0OYGOEG8kU7EQCjnRDYoL7NHI3Mp/Zwc2ZFBztUXPjbRdxYcD3KVCPE4AbeViA6yMcgDmgY8lUq5k26zMwE2ZK7jR+NgRnsjCZ+ZeaTFayv8Sy8xTQmOKxN95LkZlQKPsovHe5KL/QleUlo6897uz7kGLv5+wThT9KveOMYdJC/TpjuNtV1zNkqUOFXKbkC57LpD4Hh18XXJRgazcbaYoZENfYe/cwxlrpoWtgzoR1qI7J3y8kL2jIcLVyuCU2q215AQr929cYbnjaynEGoGDMZlU5naz4XZMNM+ICJpRw51rJS8p/tIZdoJNpdlWUYaHDVf1h+iPzgRBo/T5OLiHQ4TS3GZoBLbtf3177v+gYxbh+/GDXs6umGA561a37BOBjaNDbWHLIGws2lPmBGn2bVY1ms9m61/JJ4Zdd3HY7+SqMh6Hqy5bWnCPTtFZSyUuMWTPX3MNscEyWZbBVnJ12srni0BYyvLUIHr2sReMaLA8i8R6ACbnWMNbsdBz/dtgdo8fx33btlh9Mfmx84zvH4wE67Z10shjHOles40Wohv2DPA7/x38D04Xlcguw6LvVcKy18nmYfg5LWysMXDTG2KVLgZmVuDDJ8q/Ecbb32NrIKICJPc2MIWN5t5PnDla0PtD5L/l/XanhXRsALND/ZVlihOq/5CXzn/k/or1Le/x27vL93+Ruyl4ZPvdtYU/G5MjxyWjGsPigTq6gyIEZOAtajuu/oL/4iAWgWwGEzTFXktNhZmvFqq0FBb+xpnZpBEpR+D3OubC27TTMGmr1NNnfDDIZs6tGay1LHoSD1n+gwCinV31Ofx6OvN8IksLwaFMCD//3KTPsKtOmczAbWMV0C7J7g23LbmMqdHPEzf2YzsHpHpI0DE4ry9JzfdxJ9QbbajRI2LJN0lVipLJ3PUwlx+wOPM4dJoRUkvrGNG9PUhbvTBN2+m10BfGVUd9Z4JftnXXa6IJ6GNTlmMHuhCgjDshRBDWhnxNCWLU3y2zEmWHHdsOFA6qGUTKOSzHex9UTJp72Xh5/gAfUH7Y/VdvHbgiFOnQCAxylk87AQLDRzc0WBASTVB3cJT8epRAGZUBCPQJKVf97cA+4lOIg0Uajrxjzwwo+ck2WEiCU4D5ZCL6bEO07EGZ3IviCuKuJwxnzj8bMhDQQfXePZ3I8OU7m5I67MhmHGvphJ50zk2S2AkI/EECwY9tNCh5COR5eKDR1r7KvBvjbutnL46Hnam9bezTttR0bQNo98YH1f+mH21kg0w9Q/5c2kAroAoRt0mw1MRbXmGV+71orX5UURNg67zWXEH4P8RSLdAuPxfsoKInDaFFr8VThwChLG2QzPreaajqZazQazaa30fzdDKhKRq03FYGCAPN7l4AvBAhL1IfLAs0qv+E8uqwKxhSK0TRt2AgkdEyBlQU2FmOJ3WXvNtuWBWM/5XzLidESU1PAmnUMIQbJ+TS3yWwgZHcbo4lAPqoykpxacdxxJhJll/f64If9C6YDaP5GVvkFGQvssssOTnNB0NxKuwQx1JPfhB/bY0XeJAwc+O/+nCaUhTlDLmjupns8gOhIfxoryjswVwyPD3xe6wPx/DEubrk2L0qpjs+Y48xOEEdyuD6NdTi/yaw94hKo8hXAJU7bXTpw7qnZSlMMap9LFjGjQslnksVk7j4gKqEsxbudIx24do8FV5rNRjPxjAGXTZgbwFd78onjAfA/VOEG99G7wcYniz6k0x35fcMtelrUf+zcOl/mcsdJZTFZJJNnsOTxLN1c7Cw0PwB9rfWgB2nT8cazXvsAS4Zzv+0YWjIVBpgeCbueTydHtNZ+CzED7F56Dnzt3b3cXGNuK2PcHEmOBOPDoLvBhDmryMi6B80a2J69gMm8q8zlDTKcGn35z2FMOfBjmO9GbxgkG0JvWOLC026zbSVxZxw7S3dPsH601+0gmgAj2YGH/xevAm6xGw9RoMs4vRBMUNhpvpKXwq0bo0ZSCeneaMxAJf+BTqyJsDA6y44DSFtJ4j02oBE7Dha4M6q0GqNin0teQiVYKeAm3ptugOkC/pwFaRMWY5OsANY3DH+vJqvh74hn1BBi8++dlrZ7uCsxSl64m5vvpxhGZ9/K9I0DjABGQIMCy1lq3u1a++t4vNz1G6xgO6uHUW1b1SOXPmElOR2UM6PDCaLdNX5QQg4tcLqHaAI/HYhLT0437QJI4KeBgxm22xyNRg+ZLli0xTK7CJmySMpeeHFPXC9nHQsdjUbp/Q5U8kJgrr/+v0F0nXXvxsvdJcLZSSFp5C/P13cJl6gHT9TOaCf1aLjdb7nzo+oRGjuV2jkHl4lna3jaBoAcSJaEglKsFWJappve7wfCDha4cZRvwRBIjuj/gwL9q1jyUQCBOR4oEcCPfgSJZ4+wq6VhrVygiSArfbmA3eo0uyqFfNKZCFeDHwGkEP6M4LkqMB4MpNBVHwVvQYUhaRW72mw2m0nrCQDXg4ehYtf1u5cuPe1AQo8oyctwmvmJgrUN8OU2u00Q1lz/cosRqtdTv4mS9S9i/i4A9z2LsXcQaJ5l2X02XGaUPnu/F7d2twISr3cTl4DcCDt2WgmL2wSFzF4/BXFbsLLE3rXBe1daBGiXTVTffioIYtC6OO6nZidIOE6QjPzikAV2mdlJSBtIB/GxFQTSjWzeZrIeZHKQ9/5V8f0ly63/7ER0icttvTsKcsFYTQBD8l9CS2Ue1G0LrgA7fMlPV/wbuaYo7RHYR5q1D2124jnvJntpvwaX+f9dN2Bs2sW+db1hbrKG2nfQ08lqsgKSr+ACROfXqFBVqqrUAp2SUKLEmWzzO25UM+8/wTluK4VK4WL8RqkL62u2Agtc/ImwAcfe5NERqMD+Oas4uucx9xEv+O81mgXuOdyBf6pg3ctcMr2N+4Qhd2UFZaijSwFsU7775L0R3yUgGxEgeGyLVBFHFlwEgAuoA6iAI0PycbZgOHJyhDFm9/K1HpwLRr+FWXKNN/cAg+/ckSsfSbhGcCFdbNLVklew86M9zaZfAf6eBP5+dXTh6QCQyzDWjlIiV2CA7Bcf0oxP5YwmAf1RoascOwczd/zAAtuhCsWIDYh0YkQ9HckUCKi6juuaSoi6hiM2q5EBDSqUKpvWGkDzRz8oYglFwO/+OTpSCQLe/w9RC9QMSC47YAN4joPr7boeuQ1Eb7PrIaOpETd+CbWNdVCwXyas0dWj1K7E3bZpOlGOhDBBFCpKuCBwLoQQzIY3hHQIzmxTklvRAeTzLzNsiV0OzfmEfYQLT42l9hH7pFEPu65Lh8nSYrfF6N67dmnS2XqH1YQzsOCQkUilQpSlEBBetZPjf4JL25gcNRw/vI7Hf3W/03g8UhzlWI9mPJ2s7olX98SrNB7SsefbFfV1ZwT8vmSBpdupbecSdyzd5nQFlj6633Px0yG3A3rLzO9e7zorSy7BnApxzovJ2aDvLiZnGxSEze8XwbTJKFAU/IiCZtMKiMWwsebZZLoCxwin0oLdjzKBufBwYOklFzplM8kRusW9ByhhjgRavpocSVPIuxUYN2OQ4dl76P8TOcKQcTebaZpq/ZT3+zybZZfwmupS4MhGiysZ4fy6EqVjiEauKmSVWTNxvOlqpFdLKRYihiilKC0PNCR/zz2e7SXN5n0AfPSrvaaCLLmObxrBFLgWzAQYdvTbgnFeVGHzjKKExZTbadoMiQEeoeyoko9HvAmWA1DtV9A1lwVyLZjqJ8v/mRCFi0q1yoUBv3Npn+AIAZlbBM4usyUuxbuMlcpov0taX/shs6rwUupTeQCnQ163Y+arScxtZ2Y3nuV+8m+npdG+P2CrFWOeNND7P/rvLBNma6bAlWvkfrbWHXHInYM1AWvN/hZLjCbh8LcpbXrzOyp/e3dlQgPCG9N3jd+bnHtYDZj4YXeod50ad25c9tu09iQvZuTxPF5zsI3j4wc8fjx2160mp7kQNmsnCI6iLEu7RigIARAPRcEKgeIhoUJASiHVr5hn6IzdgwzdyZcHgbsrxcNEgKnGNf0IpK4q5OFK37K3PpJMh4D9I+wqrtg2FjJyaqtoGa0aErg5TVzZEHvLj78QAfxmseHAb0q7cXMQAnhry7alZdsjO/9hGO1POSdcdzR62gNBGbo2HRortRG4j4JfP0jZ9n/2R9Lsj/C0rJWQWyvY15F77l6Cy+A2u5423RTybXbdJ6GCuWEplXBJXuGZ10q6QXTB/ZE/tqx+O21Bsg3m0p6rfwVzBlg9bPoPs8YP0OCrB3ygsAEffkjAkw8FcMzGuWFi2AV/g2PJ1LNPElDQI/rvrVcLEkpptwr/Mluyr1CC7qutVxxAluKcgwE/ty/KrRTR+qKXIq0gRX6e+Hn4T9hHdn+qnfTl8V0FXNzTzpxjX/u0jZ3hA5FQpIB3pdJaKtUe44h19lxn1XfNtmssbP/hLvsr8Zsnx5OvJl+tYyyIZwxHs0nSJPAz3MKgBK/HajSVoLU0ehMnum+Bk7apGSyJ5We4/Rm66Bcx7vA57lRX7RJNTnPBeWm3VzgCKRpLY6kdSaZTp4IZENSpoF9eoODxdlgJPVbQyVmvCf/icWOQU6eoM9Lr+OoYvoouVM+NdAWc5ae+0dfZbQ0Bgbyw/tSi+MzwarbNbkeJpe3fgZnchHwlsPV8NkocZ2n5ayCLRwlJMA1neUC5hLoG6CsU2LhOC4ztHgC6XYAB4dd5ZCZ5IEoD4o5oepoW5LRStGFLtYOUB6DUsS3sZox2wwaxmzPJFLsZ5hxCBu3CrmdIAjs8Tnmj4JRRaoigpYvAdEXvpugRzNRdcqF+C1GNgZ8Wnp9++P4Rx2mLoijMaUsh8iYwVxAjP2dLTe+K/zn7RNn1mMBPK2V32QkZuirVZh/5dF1f+m9SZlu7F7SUkux79P5j0M419l4Yrn/L1kI+uK8mx+1ewQVwoCCtjierujIqkRTAjiDDILfqldLKpq097ZZGaw9STCaPgBOuBat/QSVbhHmK8icC2NFz3JjCUiKjatxnW2As6aoSshIYjiHClizJkVsICqtrmd8IGdVvNGaEBNBsteyWz0fYVaWU1ajQBcrSNAF+dt2+NVieV7OUeMVgpzUH/CoqXA4UMtiDCpNQDgLptR0HEcxrLSNcD2lAA1ImMJ+U6wLnxjy8wbYwRsGyBh/vZ/mEd6s5BlDNGNqTGO7MANgjaobd9BwEIijwSJYxD7ZFEQD6ZwCMRhgwOMVupq376tTMSkp/JFsmSC9/pFIu8bZQUpaBMoWlv1ajYdXSJfZz0NgdwASmDzsAyQAlXcOo2nGsdryK8e5zEE0guxqBk6x81IE4Hp63PwcledAeVvuYjV4IgasjdZo+8QizNP+XnubNkUqZHrSri4SUqHGvsfcUPS1tPfyEA3+L7FBIYBpNtyTTMA1zL1woeDxZZUUpuVHmjWUWHHPGJuNI6H5+BpPqQQQE81PUi7woCwHzJotJl4ZDdEM+Fxr+sHvowp2Us0nXF3xGjM56OxDS3mNeMxd+dBbjxm7DFNV0ciS8wXRyRCuXms079pLI5fcIBTh7btiegG0zLKNiaYo6DLPec2BHYQLhtt9qA1ejtLJRZmSwzbUvJaebul0rC8x8azgGaIjEEYY6TKWsQ9TzGQiwfYDdDC8HfKaSArKPB6ZTeaZzQYDLn93UaavpuQkaZJabcCFFaaeOl4qi4AJGJZC8tvamBwrpH+xnMBruagXyPtYwT0gpRgmQuMhOLKH8lVL9828tXT3+xLN2QzIUxnbLPDep7IhM2J8Vxszu+vdVa0NEoFIKKY65iVNDcc2mDW1cTU77PIsGaL/ZvwW6MkRmBLjkMMVqyK97n082PZd0LzACaHBlV6t/FQHArwwgS5v3tkmcJpYY7YQdrS0SKryjgjxD68cDA/EJFBF8SIER7g8SYPqj6xK/oD7S1XqUZQ0k827T99TZpBumE87CpowBmFvp//rnwUVUwDyB0Uf8HnNH2FXttcrr7Cr4Vp2pYjmlQhXE5npGcJzQfFANYJauUrhFEYZdVtIluJaitnejKOJISwiqf4Ax9ixOpj+ARy5YMr+RjawLAhgAWKXm1kjmBW60eTOZKktxG28A2kDBfHQmTKOmLOwK5xkATqhYEKxc3EmuRLXF79bhXOk/da99mS1hIIvV060LcNx7XtOX99iV6iB4nDVo5+p49n/T+v+BJQvgPVfq7QuPP47e85G20u+4dwsgaCSJ3YzpeLJaQt7ISloF3O0zvlqbJSu4FELgfuYnhN3PBYBOw5YUJ35HiPmE1mqLABeRCaDiHmSZG69zSVf4pB+OAQhBAAhGzxrIaUg+CP6I3kDBXqPhGjN0uqgoCEi1jEckJgpxp/2TAV2aYMUd+fCHjBDwI487UJa/xYzbXf3tZqvpyNToJrhHx1m3hlVVQLOQJriwbhCfYM5IY+B0sC09AhmOJI5MryZHgrl1NTnyBAVI8hZkRse103nWCClKzw4Swg7M73ECcA3q9SZM7qXMpngpeMEYzepi99Fhj0CAVuHsjsAOnn2WgB/SI1rrhyn4VcEo11CioDtGPkXBfchPSnBzo9pQSZdoEDWFCBizsKC2ygJoCo20YRdRHUumUpj6ZNEqWAo4t9aFEFIJbtmBFa1eUD/99HEHuODi3yxpus+4xt77w/aV/9mD0FVr7L1HCrsjr73GjJbKLtbn4TQIrUms1IZlHF5phuUVJVraZeE2IkZfH0Nr9rSdNRm1wGpetQ49ACeEz6Z12tBpRoGC+JN2NCc/BznNDlZO3F3ZOwD29B443KyOT+yJTyQnLA8TRcTdlJJqxlwQenouOWE0p5ZnWzYbMOEgb1fINObTdGRTIHdDZm8j9RkBuoKsKmgDhD10YQpfSc5IvJbRyhwH0T5Ey17CkTWAG4KyhqKE6ZKrfj8Bdp1tV+qJv2AwDxsiV2+T+NWQcx/xti91fJfFu0EYY/e2oW6LFMTbvp5e63HyKJY6Th7dDw4oeXSGbSWPar9bzFbyaEJ5W1CcnEYkOKMc7HF0vWCMWgF8CoKYCqfqQD3EBrCbIfgDVB1ltWTHml6KmFYEqqCpJPfbcqxWU8NB04ixu36/2NWgG7TRaOLWnz9nn9gwbIHOTlnL224qvvSEl3upa46H/y8Sduo2GpVPGLXG3is5zKQA36/ZV15NR5OqepqAz+gRXT1FLS+wDY4nq1xIKUrLtivpoulPJycgNaHw/Fhr/T4FOgJKGG5k+b6syJF7wmlZ018zSgmr/xrh+3450mmjrBHAvbrobq3woW4lirAKnp2ENCwQ00KVZTv4PWrekLp3xNXthuvn73092zlj5n5KWBiy4zqNsZUdLGHxMgqvEkoIw2JhDfYRPMIdGNk9KIGHw7b9MMeMbLsoSkNJ19nVkBDecN2PIZM+7EZEIi+3k0cFBVo7NwwAbXXkR/2Ud4WMTmPMGoKWC9DaSh4dsYjR1XY85bEdKDm6e5TLyOwnpgQqcdaSLYmqZihhit1sNRuBBf6F7yrD6P5AgVH5uVhAy60yVGVUqMB0uJthOWjujrvx9k5aaYB1XnGEnV+c3ldVHDI2nVIKfaeHv0fxqbhoXa+JC7TGr57zd3HT23eL3XP2iQ3XfCbY8l9NjsO2MdIMXXQXFzhjvZoc95vT7iMANVZTV2sK62pNhXXhqCcIptesxmc4PmlT0iNQQCGBORtFzbNtdGvDkT/zLDjNRo95rqvUTwjb1pLc7XcNr9W3WiE33wmbpxjBaJQ9+64DwTF3OjnxJAVSVZiLznL3soT8EieSubDx5FzStSwDWX0pKnukm7CQoKKbMMNKnmVF0QaeyGo8ktZ0fQ2WOr7LAmxX6xEkpwJQMGYNwumE/ejhoGEz5CUOmN+/8YUZ01bMe4NLN1iTaOWMU/AwAeCyK4Vh/JbXe8afpS79/xF2VRp5KUqItroOTjGYK7nOtoUQdt+I6z6/Klji17nw7J1dJ3uEJI8WrGAxrwfPj+f1pfS8HhwqCJqBvT9Bef19EeOv3Io35PXvB5C2QgC/ri0Rk2hI3yDxUSASwCdVgsH+QKvZtAmDbiYPhHCtm+Y0Van7HRhh+JeVHIWRkig5kgaRD6YHYaqCGPl0Pp/9i5/PNwIY46Pe17oFcx0/Z5/AXDbnfOfwWdyp7j3/l8UR2geuX1/HwJFRIZwoHw6YP3VX7r93mSyg6Cl74V2FUVzjEHS3/Xe8AXf5Rjs3bvKvvf+HmlaHN/IDJ/ob/zvIr832kJrxI+70Mbs04GCnO790CFRLnrElxnsd9zVtcupX43g495h6E+Iz69ePlWdqj3wmLmMN2PkBtAf2+fDJDYpwe7d3DI/dsY+e2eX2k7u+1gvtse+597fboTPvrMyYmyErf/ZSAE+/qxAUhYA4OvM8L46MBgkGQWl9p2hXFWCEHw8bEa4mJwpI5Ms5UdMYJwpcRUztp2PV7m0KBIIPWzC3arU5I+0lsaFtLswTx5IGTt0l3WQubVpXilGHgn/QKFaSRVqWUR9Kq6PA7xEK/kcCCvApsrTZbOHOf0eccTqiwGjRR+6yTI/h6TFM6zCVzTVXjF50LfWa2RHIIl2iNwlDq1q4/dt1dlV4Veg6u5o2AoBYBm73o7luBr7XmMARA7Hz19k2Bq6VAjQmiGGrmcoFUZ/Y4xQIYjcnqEu1WhnMltnNR7lV4Lx1XF2iYRLEWwgGvj3y//HoGo3qU1VBMqbCGtGFVRSNXpRSjamM1CdILWOB/nUzHNH63QC2hZ3zwSNXGgRAMm//UFxAeTN5ICFq2pT6p//MubSTtr/1GY9Q/3r/QRdCGRKXOi0LpoA/YR+FLDofsfcK+0P1B8K0qPpDtYtDUgKCthFkt/1EHh1U9D3j+a0t9G8oryavHgQTtsfeJGBB7SKTJnDpyXi3q4+Tv7DUsHdxKo7BJa+F3cKPJ68F+/xgAfCrB8ITVsDW0GuGvb/2GCO83v5dGIa8mqVpC1n1CbpCf847XLk9gjuR4/SPZVRgEAdrEGeTK8hiGia36Qy0jGatjZ3YTZiSQj5F2LumvB7YFmXvZbBUIebSsHcfvD/NrvkpRQOajSIApa81PNA6O+5BQjb1uWaEwz0EXPmYgGbjngCqqlJqhl0Du7coYMb2CLuWZaPRs46L2x3GkYu3mmFu+qqdx0TgpScJqsVJq0aj1UrTY3jaPzLK+VmN8+Na9W1cCpRAvrHt5FEFu8YZgfko2ypw20zMDFlVhZ03R46M8ROG70q/gwE6L1kpLN8NiQmMPWrnC3D+hls5QmZpKm+Cojd3iV1uNcd2zSzKsIKTS1yrblddMecuVP9qjNM753x7OQL3Movw7xnDnRgr7Hq5Z5JXP/Kj/5nk1fTBAL7KyhKcGaiS7ZsbUI4TuM6dL6h5JsbAm3wENnAtDGtyAIJTqpnkteQ4fnel2hFDoSxm92WGryWvefza3RRgYVr/sY/Wey0luutr90TMrSzsookToO82kTOcSOYMuUEiVAjQ4xHXqiC3CeFNWgIHqpTrKWRHynvEsmykXWbYa6A8Ezah9d9ZkLUwgywAmE7j9DRtOMM1y9wgVZ4/UgSm07SbdV9rUEZFsiyya3qUpR5UAjbFKIA3Fd4aOMKuwR6WcDeYNi8LDOM5wq5ah2fgQEVpp821vl1iqJpnVIJTRqXwSDYapVn6JALKqKweiuB9Mptz9cPoNMkL5/uzie0T+lBYye8fWoYjGcb4Xs2ytNWoMUScKNIjt0PENvusevbJJx+0evFnP/It2Gaf+ezxqBcX4My02m9h27aVPNqkm+XbpRGR8lo5318tFFibD/wAuyndcEEmWrrV8uxmcP5j7F6Ck8vBjwcxvpeTpNFstVjICOVVx8q+wv5nVnZSLQ+ketYdWZP8Rh4Hjsom+I1i/1f4m55Hzwj/xsqo5dXwezwA2DbgmeTVt/ye4M8kx5kZ3xBhf5xOwBxPXqtKl3QFuSNMTARWaQjGcDm880+U5VRaV//ZMqcjrVYGqdwNc4KlBGCmG35UlrzkGFHTNVqLEBKCaJhhU197kChByugJqAQZAQ7JqQ1rEGAkBqYxanimYVfv2CO4SZjnLZUMXAdyZ/gbNMNpI3+DqkgYuZvCRdj+BhD7524dPUcLpx5ZY/wIuzYaGTp9+H7gOgXjJRcKI3lHIxe5g/RrraNtdt0voTBUWnJKsvoLbsTZDPvM0Xwh7BGNgdYBGML0pzHpAW4rA6f9jt5aP0dBOBJ2/t5OHvWMJnkUpixg2wsAiV/4ARxEVkZhDHvAFlK5fS7s6sgbbCv9b9zL2ZWO6Ot4gN3UELsvlXX+oyrp1w89d6HElUXpSOvRf7QxvmX5D8JcZfjE37n0rjjnyVyeIgMqfLu7n449gFY1qd5Nx95Ra07tvy31509uzd4NOKAqebDzsQGnajx0lzeivvf4HeuYnh+4rB77Oba5AI4owzaNQeTY5quZU9INDw1R/c8kx3VV/f+8vWusFUeeJxjhYzimL/YB93Db9LA+rDSamS+lwXcKg5fbJLsSW8je8hwNwhdkd8H1uJelMBp3Na6mu6zKdNeKnpWGcmnbXq+Ykm0+WDO72g/1bfvD7FbSTHWVBOIhq4suaMt5jdW3JLPcKNyyw3aSsfo/IuIfec598fAJ2dxfvk6ezIj/+2GsJYI6ZTdtjQTVWl9FztPQupAE9TUVCKoqSqKhLs84+Pjbego1UbtGBVJLUdIA/rH3B39bT+UvHQhgV2MNl574towq+nbqnkb19wCyYq/+Nh/FUEiX9z34o5CCteua72fPIeBGAGwOFEH+/R0Eer6GPQaHY7gREEfQhZuGOnp4yp/3ewT6wdl9SCt21lUTWoHwaW1FeRKgfFIANCmf4ZWsUGedz0xCgA/eeomqoI4HQIS1EMk+4VQC4Y798470zVr0zc5iZQVsZRBJrZWgkHR3KwJK2AdyFoyLcDtoXKRqRARyX/vu13pdiIVmG2QlgbMSuKoQwLlHBeiIPXk4J+fcVQQ2+Z6VAfQCI/q1+lXlBXAW/W6SlOzlwFtVIhTeBPp+RTiE1ZX+/QJsVYLyU5YSGT5/xT0ofBaJf6cUAt40WG0KBEmL0oXI9q5iTukHKC4C6HZ8NVEQJPlLB6MaJPBCaeElNERYwJ0432cwRFUXkT+HBcblfmX6QVIHj5xqpv83ek/le2UAwEYgKwl8Ewt0laDNR1Ol17bTLd/EEXXwb/JI8dZFcPv4bybavOLCbaizU97KffcFInqshhnx7WF5tTCWyeuZQCa+raf+2X0R7AoznEllU2MvcSaVRWl8LGQsE7qrLGNfzF29bsyjOURKpUHNenenK+gZJug1QIUVh9sUTBB7IUdP6UP8t0KyhzaligRRFdtSBnGTUkIvZD1OSsdE9koSREr8slGRzLP7SRB1eb+lSK6hKJSu7rA/4RM1G9cyULpJCbAfjZKyZ1UKGliyiDrb1fEc90i8QLAkIYjfo9fFCGx019SYvi49NCKy8Qda0sCeAMhzYzRkSTGPIT7+13pdKw6mabixgLpS+7rtAICwUTQkAczUmFBXWMZV1MHzSszK+ZW60uwQZE9LsmdbKftYEJDSYOAWLFO6WPzuGhK3psFSh7+r1zd1Ywx6ts6oZy37yQZJ88oXgO4l6IXkb8IvJKONn1XPDuFnh3DYwvl2tABjKj72mI1KailB7aUtOof2WBCpGtwT8qSAHinOGSTiVGNxoHoCyASvpQoIxTedy1kr3KqnqEktcJgpPgwXiRe9UKHxVCNfQbShB7zjPiYHXA1YiFE1Z9LRTd8qZdB0yXt+Sq5CAvmxTUGMCr8nBtwRoaDEMXYS90LQ0yGtrDVsufS+AswRYhIClNzvOaPkYU0pz8HHC2JUKGWpWH3FDNMLekVtbW1Rx7zAiSefkan/bB1qR6wINvxGJJ4UsTBG15u5bKhy8zexzq/PQpkNg3JMUiyPWCr+myFM21pYCmiNIFt9ScMaIce9ZMVhH/Q3iMN6anDHaTGz+EUplttmW1sC9mksQB2rVmCgtTWp6fxDH5ISIlY3W5dnWZblHaJNxrd49sQN2V8kbljvksletyNooDyHqoHGc6qB+lVrXMGxGL4yhP22gLie0DzY1xtC6trTgtQ2xrRMlVgdOZZKsSx7lgXGkoiMQypUgKva2yOoT29BRLjEWqCgKDyrXsC0D4yNfEE9+/1Q5RNrE1BtEkkDWePMsz4GSiIIZS28lPXNIGNJ9M159s9//J4hvEcgwn58U38TqOse7/ocJEe2h5TbhvGS5LwhuXBPioMcSFE7U3orJ8VLil6YwaINXKLLZul4agH8RDKw3uQTMbbn2/oJ57j2LR4sI5Iqkbbtg5BsYCmloeLGu5z77LRnHLs6nV4/cBF2fBITaLCiBJyj1IUQQMOkvhYgywRobFmRh2ZFXf/v2AcKSX0VlDCk+5T3IT0nwTvx1yp6QV5bGcDbXmIG8EHQI1kQRV8Ug163nxNLqX1MOtDrGLP8N2p2tQRb1whgS2oHgsBhVwsBnKtBKmVfBx42i/QQ80iAUjLRAuH1Vxg13fFkIuQFDpOzezj0Ok8GQwLjFaS0WSRn6kq0EzANpn5bV/RYlByvEG0jyXEsUOcyUj2sccUgj8Sx34mUMuT48WG1LYtQ64F8Mp5SllWoIzVNU+d31bW85+cR7AkZDLAntHfCPElvSMZ+x4UqSiCoZ9SzvtbhIIiczwrx826OMwvvR4rucmzSoUS1GbjPn6kpIO3UtP9naqrf98/wZ+p0bK+CeZJ1KQBBUS6uLueJSNL/3ejtd/uj/3tgUN+MvO+bQUi3A+YNW/HfrfzvVziAC+zJ+tjLUEWWUBjU+beSX4BYwtbo5JrST1SlAM7VaPhggJ9mAqg3XY2iR2nPpzDd/R4shQlUvKM5j//b+glrvBGFz6l3COD+pwDe1slh9sfyMNcVIP8tD/J8pWcQhSq46OcuvbukjBIq0oNt9LD+zyGtKluTXA/0HfiDYQvCCsv5LuQm14K+R35FpWfquk7932sYlFWF4St/o85ukRT507KqrCnRAJC4r4WtdNY7kgpU5mejrrZOzYYYT0+Ri8papsguUCAAfySBiSDvhe7p62JB0l/pdbHEDZ1zTAI2qTJwhQTO4O1cqbgMIlLUJlQ3G1PXmKkk0qIJFfNmAtlzzn1uPdl7zHeL+1293lTU8nOQ9jhv9zxfCoYtrtXFNt3vt6TrXR13aJxMTmtffvlDfuFCN+RvAmj98RBDtLRvuNN7FON4+BfHAjfQfjx36TaOp2PQfoJ39dcu5Qbu+RcucgM4IaLp7Iw6Xi9ndoRBnP32sbw1xu0beDacuKgQsUTh40yKaYnIZ/OsXCz3QhhaaMj3NK2mBy3T4XzGw2eH8LND+NkhPEram04HSGbTMR7rBTXdk6AJINT9ETIbdaCdUj8Dvo8dutRp9R84e1uBLMRuKyqDy7KQ/ecB/H8YDMEAO+cR6IUyXnuc4x64AGKiqfdsIE8Gjdan5+spfbCTiC8SoETPNTW9+CLBLStkGdMsrhK3R1BmR6u4LbzYeKF9A7uWiWm8kNzgCzzamEYLD5Zdq4huY1crQfzbLRz3+1uRGEYoPAQiW/DM7NK7nftFIQCpadEy/AoJc7ud6/UotvKQ3h0nDoh5hZfP2RhM8qhS52MuEYCOBK7EhtaYvq3Oq/M+NYeRHxcSdA/GBHxFnuX5v/mGcGgVRVWjbNqRsumXxhtJvAG6qbFT3Xh0Tp3V487RkmVA+uygVTT+b5aAky16HIb8e3ljAk5jWTJYwOcbs/hds8LQO55uGYWHj4/b4n0Q9jI4aAENyeCvRrF7NsQEEOgLUCaHYWNXltsp6lQJS4pqhAjdkfJ0vSmCrhZ76kIe5vDerhClBnp8RY9hfH/hq8likKESpWVnKll4hEvL5qCFd1UI16fuy8CzYpLStJqSGUtTnFaAGvk3oonjG+o0F+RssJq7+g9inL6zIaTgBA/vp23IkmLcyx5Qe5EfFKwDg2LVVYM7C0gfMdoh7sN4qz6Ig0Pap/TB5odc8wa28j1XAhSouB90ZIwizudyXwR0Sh+UivvBfj9hg9ZEEMoGEiBzZdTvrQGe+ALpw6BDv0BJQkVlMGvzBReKHe3SL6zQglTLQre7lJpxDhk+Ak4owaoZoJxjwNIhvdkY38zlkN7sRMLP5lwGOWFIkUpddQZJtQnFnM9jSLui0OwL6nysO3hBna+DS94HBWDl3xXk3ru2g8jmhQ4VS+SQqYIDvQNB7QvQFaRWKwGaKvUCNg2SWhXKYY5jlTo+ByiOqesGPeqzel2FOZglWlyvcOg92ivXxXQb1K5t3SAnpBhyOCvq0FmvG8EkeiUW6l6xXq9P/g4Ye1Mgpr4UbRyOHokj0utlMYp/P1BTYfyf6mdATr7agRSMbiZXEXijpMsyXEAEgpkYD2u43wif8xcRZMGQOaW78ZxvNPWvgyHzGzRzgVKeVj+NetodUsZlj5+qn0q6isQRQ5ItlaM/GCN3tuqD7qeUGjEIdMvTrq3JloNLwAfvZHgSmOlAAt0trgxPez74L7gOmFLC6hsm7+lPeCLBTyyIDy4To1wP9HQyNjJ+Qk+yOY4B0XACn52WhzXisOCjBeqMRXc9YJHBBlKtKoNZBS/0+76g2C79Qu8BQarhIEqmpgqegW4fuimI+CFXA2ExRKpj8tAhvbl2lZJ022JVR0/EsZmL3uwr0fHYjFtUgje3sFoQqwXxUOkjpc43Ib0NxXrXYGcXBpxIdN4bT5TlPRmlTCLoPxAvcCE8HFQf6ppK0zPnIVPuBb501lERYLD/ecxILalnhGdDVd0wG8pCUaQLMaIHU7MK1UrNqktkKW8FDkdyvaXENWApO3yqCoDKcUe8Wb2OnJ/Ay9epK6zVN56loAZBBttwB2MyEwmjr1xNPrRrev1fWlvDj2uXCmpZLZf/IWOrxH608Tx2yHgrKb5bn/YNIt3/8zMz3JD7G63xsyH8syEcxzcSdvWNFp5aHKMgzoKjBV6DjCcHGZ+AZ1an1TfY/cxc6KdiLAWfngfPw2sWku4HdKp4xj9d5jtpHb+0ZlJReSCFofRSH3C+EB0AABt1VCiVP4GxLJak5UmTUPfaWqwTrif1E4rcvYbpPsWpD6TRh+0wo/ELevJeDGAVk2+GsBDmG1TgFUT8vI8NRpZnDtquty/rWHl8irfh2K63L34D811o+4hj/NbtC45tEsHj2I6zZOV9KoLmvwjg3EoP+r1eh/b8yxDSjNz2J8Y37TnUGGPrmuod7I4V8w7pzVFGOKQ3W2ROrCXR13xuJoDn8bJGgxbuyTAiEkHGyQFKb44NW5U6XyaMi0oyCjZoTYHsqSi55i8yIRktXZUYXECspmLpMJiQKETMA3eB+Q58Nhn0aI6DZkVRv7N6XbgdjDGpJMBqplSf9Qp3jKu9P5F4FWo8lShKQHdQGyqfZywHjwGvwlhl6qaznh9bMYHaiW+6DyDWGV+PV6skwFBsBLEzNlBcLzEA8XXu7T79OASf8+9B4JraluEcVudoz3Z52CcCsCigTqspHwz7FesIzgVmctozuDfV6/HFvaledxLEEKA7zRuU43X1+oL4zTYeVSXvzm8iSYUhHLjH67hFjLvQUokvnebevO64CKP/orgn3XJHNyC+sIUX5KHidpiPYgYSho5t1QdDh2YAtqbwKOSjnpoNFnWPHBzCB4fwwUX2Dx9/MPLEJ/RkXb8kGHloDkG8+51CAPhMRvCBlntqgxwWSGG321GDltdl+QPusI0nh/DkyP3b9AtwN9saaw94fr+tH/KRX9Dbor/kBb0L8wqwxtsuvS1kmADg7IodHjiyQG/T2+kVXrQTwD+7vqoLsFu+8g4PdlR/bW3knQ/Rpbf3u11ubrZLb9+k4pe+aOv6fw7AhTRKAn0twCvxsPz34gW0NFE2FJNC7W7Ua03TWMsOpJWR+e4uqWnQdnYtfc7qIILaVmWlBqLS+mbWbFO8G75CjN2tLYu1+FhkDPhrlj4OtXC8WBvvHsK7h/BukEV25y/5p7YZFOKqoii/qFGXQtd2UvGW4oeURXQEeTeKLJmQX2arRJj5OwmobEe4WgOCFuvn7Lu4oM57txlX+osNkOXfI8eFRfGFIXxhfowyEyY2oSbhZaaiQlvwOCc0o2Q0Hs2/sMcK0OkKASrWIJnluC3Mfp0NPiPMsr1SVoaKRIJoFbn6FR/l3xgQoK40PoAYgHtcgFAUHyWwmnNfQAJTSm2k8pFRNsMmquuLquISfihaEa31ctZKCTBqN0hgzn2JFafWN7R+K/RNMedA39TxkNqnppV3nxQgdE1nWZZRAaw31esUhurlF/wYM4EcXXF4JO/5bEaJw5pj8hxO1toKsmlT15jZfiSK2Fv1kTyL4GBVliD3WeB3k1HiPwjspm4oEp1VRUpUekJP5r6mNIAd4Zwn9GRXggMSuJChSYyIBelJve16MN1N6m2ObBRqkHAN0LgIbQsjRXd9AOvgWjaP1MiI8Oli4RIPnNkxkAECQj/cNoS3LYpp27Lvc5fe1utEhW6bDa52zwmxAXBki4rZIgbKGGR+hcIAxr9GplQaY0i7exHZ4t99gcnayMjqyLtioj/WoWfHPUdCfCaBWy2ASvZgZbqQc8t1ZXbXQtmkuIr7BfjEGOSRm2lOlgVS+06odXJeb+73uj1qj3pebw59xwHEmKLzenMtQXTJnVcXYlD4Bb2iafhnc16dAFk/ghjmdkGdbTBgtaQYB8qhbBpPOFFUCCSVGvDSHk6CmNXjMf19Vq+rsDoKa57RlblOXeG78cCXqbuCpE4RkwPyyJWlEMTIDKCi1LmKqWgQW8Y4IUGCvgdZMCCMyduBw5raGB9lG2qSwp4YGwy6r/G1XK/p9VR60DZIUkOKCABLFFUS25qIrSqqqkIWEYgtkNRpdVxr7tUUiS1w1ik17f4VOzMQ1M3eCJwr0YrNIMfAdAT9jjinocaJg7vu9JtWb4nRxm+1MdzNWx8Enjul3nIfCtDhDrxYsLUUBVtfdw1Pa71HH4kvbo8+skqCjbxrwPkB84/2/j2tLR4fCfgIDr//yMID+ZVzj/KtIWjMjgjcmaIASnWE4vvuX6mKAKjDKx3GQh0B7FSw3Hqnyx0H9RH2MXo/45G2v3CrPpjnnfDbDlZBdkSD7oYIuhvEnmgRgsPquhDnuM8LAVxZHigDcHkW97iaD8t62IweDcdbtdYd6n99UFNQAvpcDupJU1bVP7h8OwJeikoAEN2Af2MFmwL1q21Y506zsgXiFZmud+kXG6G6vchtKBnAcRiZ/yLHdmDFCDzsn3XCYdHrQ8zHlpKrOHOAE7QrFCWp62iv/2jkKiVGJVF7482KDOEWGUkonQIgrpHNenc/r+0mD7glHPEOegSfGVQ1+KYtcRUtuAq7tIirYNYAR2Nc4KQOqtLa60pGEhIfLlDQhsU2n2f1eOBRQTbHsnuzejzrd9F+LtI1qO7XrML3S3W/rqBsbyrJLg7YQO3dtRneQ8G2NfGOhIzXtmXpxKah3p6JzCdQbiPBAUnTCwlcAkg2J8UYfk8UrT0oS1M7i6Q/FNsEeontiDDS660WsX2rteWt1pbR+K1Fsd+Wku63gSS/3YQQn9MozmNNPSDJp6Pc8qY62bCuMmwlO8mjjf225eHXE/z6MPb6BHsMFzJrSrOnN/a9mVodlzHiHYm7AiXopHOWm7i+rk7mIch6jz4CQtNL6NQ6ovdQEG1R4R6aRJ8igziIChrWVT2ot6qNZfkjrFKHBBHdyyXtUT57zJPKYzsE3XQNkspJzDDfoSQNNBXSwFA1E7QUeu2aZW5sfgBiXLQ+lWxweq2HPW7QBdTB8s1E6Xi6F0gQ3R/O8Op7ESiqisC5uq5Qj0OfThHjkmvKJJMtolQZJWvMmY971gSCSDRjsz/scyy2vRmoKSU4E6XDcrAkWfd6aCdDSseaKNGzmMiF8jNWQTG+zCu3PUWy510YF9TZOqSsMtlrakOBBSVnzTHZ43Q6oHQ2hJeuU1eMiHa9Er1K63z1F/bcUIo2uWSuoH7n6Rncl7cbrDc+yRtAYH+RHFEU6vF+n0P0mAKxDvGWejtGoU6ptztd7iAM1CA4hYgAcJwDzXg0L5QIsizrY2cFmNcxW9FPcqxpckT/AdmyCzXQf6D/IBE4RuE/WBTHLUda+xcV3Q7qI9HPd1Dvi0aIg3pfJkFtBOj1840eTL5VqkKhBDCpt5XBsTZJyiwlRePCqgyVqdmut0XZYLveZYypG65k+iJzDlpLXe1T+EjSKIrK4mJ80dus2h7ediviJWC0GiJy4pOi+T9ByyVFfbPeHQ3GuPw4WBWWn9Q+z/c4CYUyRsmEJ1YclkK+EJoTF6GrSEglmAff3lBnFztiEBuMsBFS/k3Gz8Q8mZpG5x9LvUXsyJz7aDf0I/uWedF1nG+PeQk9v6ffCb3jx2MBrLN6PJNgkwQhGpquViXfA1SzJNoW+m7M6nVEGJiCYUF/lCO94Ibe7yt6DGhr/eXnNhTEN6EniLcRkUBlKyKb0+p4nnVJJlTT6p3YUf0dNVVRTZTmnrYDVs+r5+XfHqvX1Gsee7GJmcWb6nQo2AZUs0aWC1TzpHrdZwK0w77+7zu+0b9Qf7EQbn+QOJuyorRjkEDoF1iUQJBsv4p5PJGajqKtbUrb3pvifXrfAnjfKIxUl3NtiAQ7SbbRRF6DbLOPf0EJxOig3tdlEgrgqYLXy2DBKNlJPdlCky30FP791O0P4A5PdbSPH5rU26ISR3yjIdfvNr2d4qSAO7BAVhSGPIB5lmFQKjIELBNUgHBFrELTD30RxzzRP57uR1pPLsK79wmSGoXkb9a785D+sFmdjxx2szqPaQ1ICkImAkpd6nzf13glnbSQkprDZqWCVVgb+9hjWWjqcJ91mYCtiP0qz+oVCTmk0M6q8ikG8LnPAyzx5ilgXZ5OyG7Nol7BBmt0OIVLz+p1LPYlniQylWK8nPLOo8/8OQB+I4E7EEEs0XgFI3ZAYq+5WAoGIBE9Nb7qJrdiyo8ds5MIut3eypWe0jYNG7mFoZLILt5mr7sSQbfX6/cfJcHxHT4MafC0JzYRoL/oHXIeZfg973hL5w60dL7Tz/oYJHfX6DbSYhhT4m/EYbQxjuX0d37troypFA/ChZmThF13ipc4JuCUGIz0vHrNeyDw2bTCmr6K8Knn/V/AVJ/v+TKqnsNSAxw0TKjIYQufbgQgLjFURQynaQV929XA7fb900CAjuh9TVMf2+GBc6eLEkP5PEvBFDJiQ9To3INOZEN5T/Ck/7wEZnM3xr4w2ngfMZuD+qnobj6on5JazlNA9HZ4QNNcgGyNAE1jxGGuf/8EsEK6llYD5G0yTjZyvBQvtt9veaq1Bf5/GIffdliMp9i6WzfXy4qMGaEnEvDO7/ua/QDuVwJgG4iS+ty8SEGO8OJf1Lt+x5s5gY+F7n4AaBKZMhoZUHNGQyv6ngvcQ/kGtpRJDkXMa+jpkOTQU14zct7gDkDoTHpFtL21FJ47VX8WHs61sWCxVWCx3DDmrF6RdQVXjXXCArsELXhczTZ17bzPcR1GqqKJYJ26wtWEyLgb2pAhH+x1BfCOwRB40XBxsn4vw4r6CGqyvcvDGhuKft+XWIexFk8Ajlx5scnMcTXtap+3wnwQJXn1zh0OWWhiGE/Ni0NK3jsULdt4dks/FNQkYDigZqFwBQyy7+25r6mpEA+BDISi2A2xhOBGJkAOKuQP+KFS589H5Qgpt1SPnr/XA/lDoPXAN7gWvhHqWGWRWYREcwS9yDlOR4c7shEBcKYYeL3faYkC31m62EDHp2cAxnFIHRL4EA3Gh+IgPnZs6yYyBB7Re6h1foncytdNI0CmO018zFpvkDqi9+VZlm3fPkmA6zoo4HBHgIJjrUXP+1RkhHm+yYPfzFhrK1i++/RTVbDw7WOWgEGpgSX8xApmsV5wDj7scKhXB8BR8/wAnMNg9cPeyVdhlM9hDFQKilJoEjept92yFFcfTG9EZ7bp7cagWa4gFQrzp0syY9OlS9pD57z4YgBnZoq457OOFuewHfz3vdFkgB4/HKBFoc71YtyCmtaLcst8+/0EGYF3LWSTI02rEOxK+dD5wK7czwW7UpUdzFc2wzniTxLDoEhH2oI3JeIh4Rj6l7YP4/gv/AX7OafPYvAJczhvQ2IGQ7LeWb0iRHT5mD8qjjauZsuSdSsA/DgarsVZcStnDNlDTdpyNkShJEtoVkv+kDALii0cw2xyrjd/TY/1+z5g7ppeb0yFfZmBPwwe8YmiqE4VPqP+OKpGDeWzT6spE3jKtKfVTaDV8HqYVvuuLES4fZH719TUHwnCPaUlFY8Gsue5FSQ+RCSVn3MlOwQxT+L5eAFPN9EC+yanL2A25pvqdJ71OoE6lpRrX7JNC/4csmn58Po2vhtjxPW+o74zCLQVaa5ETFWT/Z42B3RI0mtPn+kvT50lGh5Iq4NIGMhzUxW0B/QKJE1H9L4YKHGElAlrKuMpsqLIpCN6X4dbPPjDQKIpke5yYkAgwrWpqgZpaFkUMN+LSENRKJ0E6ppn/b5GSnk4musn9ZbVDwjQ1J6+T+ot+BO+L4GR4J/IeMjRQ8ZDLi0ycovesijeIuMMPeGffZXkf4upusaSlM8uR0Hrmyb6T35f/z7+X2LaRnaygJkaJ9g5j1O0OJ6AS/BkwexBPOKT6hcvIcj6WXbr9x4iZlNbY778XnD6xCU17LzxXxS/bn6sX5S3Fm9vfuzNehuINm7Wu7u9PtMS0nMK7KKCCkzQ0S/oFbGS0gW9woWEaa9MKNt4ZYKFTQ+0BEqC0lvy+v1ev5eoGa4RMeGlLwaacVbeOjUbS5+tC+Qdfa7rInmnzG5LtvsQ3+0ojy6YDzlbziipTNQ+zbsqC277GVyz7gtkFvCNFCkyUMezMS+XD9RxUH+wJzrXESoCT3FUfr+WsdrGxwU6MsQFkBPAaNMOBd8xg1EVM5hur9/rEhvpSTaC3Y2NMYNoj1lG+lv4K36Wlk88/JE8qWSelPfIO42gfuWVIMv7uE9gUJZS+svodCmKcnCHbOc7kv147KRMH5D8fxwTcBL9sroeJLwK+EeqJxwafhxtTWI5j3KYNw5SxuZZW/xb3syS2OF3WspLmwF/p/04DqnvxIW0R++DhVSgUN122e9p4VGuJO9A2nfnI3Bl54B5M1emylJhz+fW78Eg4aBO6Y5Cm1gwxOHYNxI/leCnFsHJ8c7Ja/pXFX/BU/pwTK8n1aoib18UCyyLBQ6bvxP4TAdJ4LDbGsWCw2eVABjVsXFx5k/jsD7MTHtLMNuleMvQ/sOL7N9CW6MogrbdLXobSzwGJIEtmFp+HwU8bYlkH5VDi600UCywoWwPyQjoGoksGhPxduvN7LKRqpUjvxVZH2zdjhZYcMgwBm/JS7FzKfLY2+Mi9hNgkF4uNeHhDFkRD74XH2kirJBDd3zBBIoMNZzLBEobFbum+M9ux1fd4dp81J1xTF0zrX59rmk4n91ho0Md9ryy9RE+rCs5NFUcbyifvSw8T/VM2VrMjBqQmbcokQ8D1zhdUs7UIIZFHFeDUGENgM8xGrQqq0wvC08viKfVQOABjxQNQCoY/HnorTitBs7ZqsK2vNNqkPf7GYZPow4KD/4jyj14DdTosiqrgfANtf1Jdxu/pl5L3WousPsSFHfKJzsdldM31elMgkICZ0sBODI06rAS9DzoyCANmB4G8/2iC6lG4YHJfumD3jJqJv66Oqk5CeZOxYqWjrzc5QaMfJGLJPsSe2TUhaWE4NwI7XkhRg68m5po2BrY4B7REtN/iC1JtNxfuvyPPjxIv7L9pcu9ichnUyz48uEEPeXVc1NhwvRT+nDz/e2r7/dMeVoJDo3hgNU/1MCht1ChseonwNIOd0Nk+xa9zXHU7EBoxL4Ey9Lw9gRvb+H2/vmutwuZZR/zT4tgImUSTnoik3AO1K0U5yeAepJPrlbLz8UONsf4/2hjlHZGxruH8G75/nxtMVR3QDddFVN6L+hVjnKq1KDNZJeGxTxZpVdJrot4VYqTedXCoSomxS0DG20YAButqDxaQfl4VIrGMhv1cb6gwVLin1RnudVO1LGu6TGX51meZyEpGT4biXOGh3NNr7+FOlZJ/JEjaIhzRpp5nCnA3zOz9I8nAkyEDHsaGy9QTW4XV1P3TyATpM+aCND7Oq0GHZFgMVWUFYafBq3XGlOSvVR3OAo6qpbHInipbiLfwDjs0+pNW1NQI6mWogP8yS99/0YAn107U5WVjEP43DbEKpTukO1hj95njTVVZeyg5dKOTu1RTu7DSFgWxXIbT6UEy+Ojw8V9gVrBU/w8isGIm1r2SL8smd2H3SIfeTcPCXCsFjftLBNKjJsukFDG3AtSBIqyojDsLXm2Nsj+SX7DNuxarcnJsy1WENyltzXufMhVQAMjtlKK6cqTMQzbcej0ULCdzLeOOdXDOdmL52CPwM4tGsWUmvD07pY9cnc0Cqak0aN06/AWqn9FLoASqWZTSRLqS3YS8O1wLnj6VkjgYj1EqbGMxKtS3KaxRGEjFU2xR8N4MKwspV+9WIQE3UYLr4q3G7+KbjfBq5bwQm/nkzynFS3cepLUrwk7BwJpPavmYnuLs2rOufpYANHGe1aP81EURdnpdru9blfJBEDMhBnv9Tgyl5gVteGPhljkKFf0uph7dkWPlUXqtXOurgTodyK4lRxWpdzOt39H5ZJCPPJHN0SeRiU+Det8VbS9ev7UeMZTkNtvWg20ZDx1Qc9tsFDcHVwpBgPSlX2con8LPrzjdvFXXxqZxkn+/5v3+gbeFH/JtMOQ/Iesm0J+TEVuSyVYt1QtT5bemcasu6GkJ6//baqbxbU8kR2I5mGRsYgvSOQwShwKlJ2MW0ZhlB2KypjKW0txsyGD3nMq1Fw/rJ/jObCGQN7vUjO7w/q5WyB7YBrac/owPwErz7FxT0VhGLgn78RLcyw+gt9wOQ/a8/MzEeSZ9iDP/oXy52ypra3raiMB+s6/qpBdGyy8UGDK0Kqo35/Xq0I1UQTMUtpBDAv2FAHaSv+/e0MwrqYcSJruFmQxKRZk+DZwJOPCmVY3RLZ/nJDtD0sBnKsKAdiZxsDV1YSa46vtwDi/sMeEPaWxzQDzmEQ7EsxsmlNzAs/5gXhO7h2Nk+uNwHKLYC8bTSxhkvdVAH/IvGacW5iHPQJ80BXAt4BAoATIBVhXY2IPci7QrKgKy4C6qYrm0XeKx5Y3mNn1I7e0psU6kRwCG6RmvhUlxcf1dk2vx2Ow/9P6oBtW0VyKTV0JcPVOAG9rcQHnGrStA+uMlwY+WvtKVMhHX8KMXeSjwcaBfDR48yhIpqaaW6cxn4on8mkmjXWlBjJHPKG+ngafvJtjmDoPhm1uC29p7x/CJ908n+HrAGYbnOJ87H0Ue9LxJJgUZdjznD4crHMIlj626OdaZzx3Z2OwqDOInTx4+Bb8b8udfqkcwHee64Cs2vE6ozGWhNhtegtSBc18J2ZJA03DnKuyQGoY2hoByEKc8pwet1UJgmKkTDmKqkhlIslZF0NPuXU0ZQWvwwhm9pZwHFqB3VFpKWdiXXfkIlcCzJTp8jcl+0Tqqq4bX2GOwhF49WKEaZ1ELTQ/JjMPt7fB1VsUZUVJTyAFq7h6BybUBZlWA/cfxR732gMCOJ8BNc0Cdp79LtECufyrwuDX4oqPjwqXf0Nme5j8xlKzmygboQuPJj/pFTB7QwETmE228pH9z41yIu5g2wHemmYn4pnTPvCK92ARZ4xK+jcuA0nrDlW4tnsuxQubClmJTU9Ptb/5VNlljUTJZSy24BqhECzgL3Mw+alSGQM/wTxA1czXHCttTaViOj79DwsoYAHSEpdF0AeR+TpfWhAb5pZs8Lymx3qSqaFZ1Nqa2JC1VVlK3Q5FBgT+HLQ9gnanlbQ9FmG6zl7jlcB7HkKQtP6KzHNKTetOF5vt3TXViB4d8qPY1It5UuROUaPwWwfyErgpuRxhuiizObmP8Z0wUOJWRVkZA8vysH6OCmcYgytR+RWP5Nm/ROHbL3G99X06A0w93lNNwGzsdUU7KsVFlCl0LPNiyllZFe+smuO2nRYnZTgHRE4SczSDQD/G1VxHSITjOIn6XQaZECn7GwR9j7YMzgilSpgwq0tqSIcT+RXXcF2+a3rM1SaU+KD5DuuKKoEUlcGucyDEkR2uEl3nZJyxS0Dh6dRoPd61GsSx3HNavno55ySWsy/OvfYH56FzdzaThgWzhVTptgAnpKbSBqlpTlFY7Z5+P8sw8zVwEcUiVJ75roTP6S0xh/s5Nkej0RnmbugQtEXvr4LYFaUbGc+6Re/nMRrvXwbev9CAxbO/j3YzKv6zX4V6I9v0/pgluE3vd40EoUQmgcYKwPmDAD7osRdwm96frREXiBWq/YpFtrxCr+Kwy7Biy6qm/m8rOiGk4ayaI2shTPc5b3CsLC9ShwnmDD6Ji5QtFgx2xOU7q7j8SKxigakF64LbC9aYXucyrlgCIJKjMXXNoA5FVSxYhfJ9EkIJNlxwNXpCB1hz8PbHvkXw/ONf638Nb2ggTqWxpxX61sbLHMOrPP0EZYQi3J/Th2PBzy36uZymgZXyWIOLhxgCqNv7kQsYqhqDU88XeuN5KMAZMV07Yh725BRvRE0smsjYH3C/5zYwC3hPnmfbCeS9IdZT4kTOQr/SFcKbjEwpAnWWSbVibYJnDigQ0fAdprgq/RQnOYUARVUhiBQIvcEo59ScCeMj1tbpsYJL+bEVHAsvGF/nFAtJBEqHhV/h/WShKv1z+pkqFN+8i0rYyPFM+PeZ8G8cW3iCXPhfCk9dScGtjHxxdXyl2PYy7nlAgiYe5gki8ViDgQxrsNKEoqiGNUHVQhDiI3frNXqIuOHUWaFX5RnGV9I0qOWccLUEgbwScAlwhQROAi1AJs+hpm0ron8t3BuVrlyhV3VlK2RHOa4/xllJJS0MCUTRiAMieyhGBABklpekMF8I8EHvvgDyblQAOvECfXnplRIQfacaG3NxYZGewJLOrB7viArv66gSB+sJrD0LPQHnAWi/IWQMKHdZFmWRpDDp31mMlC3to39b//bSj76nxYzm+wjfzcnbvgH9tH4aGEv4+2ne8vTyRnQAM6cahZfJNvnWBHo6+TsZIBI+HYNHn9OHex0vHD2nn4mNPZ7Tz7h8EiT7ctAmTy38zBABG8bPLYgXGUABn0H7CfbRgu9Xka3u53XUANHbwoKWGiDNWsbAJ9/CcltrQqxZbMpM6DXYUBMbbzJtdaifEcj76wNtHSU4GuKqPZ8hh4LjxnfQXIaCoxKCI/ftLKJ2xy2F56iiNjPSnmCkc4H5RjOF2yQBpaFWvgYtkR+2Eup16io7kMktsOQxBmImOgKuqqts2Me/aQCOiLakeHgMWpcfS/6+ByPcuPdLiCizKmixPz/NXTPJncRWm38VikigSisAPvSmmb/m23xUe3lUePHPHdPpWFZuPpxuwfBpoZ+zLZSjboCeKfXjD7A6BdCvLFSJbNvq29b71ML/3HBMmRuKIZNb4h2O3kIiZNDtgGYaECpAzXqGlGUqhY70K2c1a4vez4y7EkRCVYFIOOe2SrBDglcimNMqgH6faUnUOJCWkMBA4o8XCny4ZV2RhH65rn3J17PqssN4XgGcq6oJddn7Xyv0v17Os4xDDc+qy6HuDJmayghC/YPgZW2oTMpc5kipJWKkYx/A8X6Pi2gTlfF3vU5ddaS+mAkkC/jZiPL+1axHRWypDlgPJFLy441xOQcCvW6Pisupa7oTS0Ne0+tBUybD5kAd1/hh/dZymCnmnfMvqAdDZpcgZYzGPNNPLmZdWhhLIyMuC/z8v7gsnHu76+Mwn3buc27rtUc/3Qtazh79NOiZAQRnKh02z7ri+R71FwqAHF5h88ZuzreK4la5ZXEFrO2eazvs2i68IUIwvPDlbeNaxg4nyOyekVawZ/QWSsFQv8aFrZSi6suwsAuf0QrA+YAtD0jf5xWbB1Eg63aDKLAmigLq4pq2XHD/dlg8oNfgZyWt5drH6YY4aqrYrC7H0BIAMxJwLyIEM2L5XpZm48tFtC57YtA0ghgAu6M92NrBg66gJn2VkJam2SqvJvbMJN/zdvKlrhYgE3t+Kg7riju4tVFezQrQ0wH0ogh1ef0aQcIKSc84nEREjXRYgyN7XeLpsQX1kKHCrmqgZr/qwaEkLBtNqFl1Ncp969Ss9hYYIKiGm7YG6kqlpq7qMbazNF6C2WFsFWUbJMJkZ7HBI9VxVOzq1xO6gxYYOItTxVNBp96YSD2pCOS44kjTeB/BtJqChRiIcFOjtKskDSyZnuHnJQFurZZ7KnnOxkGiL0mdqY1H6VRf119voYXwyOPh1r6e5zkWQxnMQ9YEHV6a/OK3L4yHP8s3fC1HAANdroWT239GPzMYoSourEoupooueThHquYz+vnGWnNgB7yPxRTfezWepzFIYkVGDX9jErf3L4Tpi9o4bGP9uuRCyvv1lqh5s+RKYZkIDqQMjmpTRsk1nkMVZHgPOQg9U8z6fM6Xnkrt1mv6WjDFDmhTBYO6JqUaAS33Y5MEgg9rlbqYSUYau7esUGetr7sbeKetiCHYGgRfkJADt8OmYsRR8r5nIlkoQ64uc78kAqc5cSleAHN2zqrLWWgZDbo7mfRIXe/3e52uMAPCZ+sEknNv1lRXZdfHq+Ewi7xG6tqJFt3C92JEJtKTHEWyl54ESoCeBEoCIwG3RQvMSgInQTeCvrhAN166L5ji1dq0tAyTfE9VyO9xTdA/sE4U6B/RUBNMAQXGHo51Qju5a7pThNCOTrAYFAN1jYZez6Pjt2D00jXdkVsIJ8d0/JB/+2PSLaPGIHwxD3Er651Dj981j/BJX0u3tH9A6+es153WN3SSvet90RiF/grQwALNAJDTRwXPf1VVBddF9Mod6WYNVd55Wn+dn201AUyXX5sJzL8oy58EsQBLbXtAbfMZoB4cpYe6GkTRgJlqsAML/PRS8PKGPzdakAULFdueWS7PX+4HOSSGKxWkDcVClcA1eE2UwDmez/sxQOBbVVhvW/S3zkngmo8EcBTSuzjvW2js1/v1t4YwjBgp8K3Wlm+JgXEEQWsDpWuN3m2tL8V8Xl0MJc4AxLAqBC6CXkewLmQHOHUvat/2r2bW1Xttzn1i5Z6C9nhegeAlcbU+l4kctOPTRuOkOUcL39kIvBNz3JJ9l5H3LRVfTvDlBF9u4fZ++l093Ru0Dr8HA74mRX4AFgJD/X2pKxdSoT0QRYmsq9KnNoea5vz47BLwqCc9/7M+i8ptFCtAuSVOVUZngSa55GrJbaKBC64L/fOAP2rJU40E1KE9YddGAp0wWAl6AnyenFMrYQekdqtX+WLIh6/mWO+MQd/LZsShMS2e1NaqiD1RO3iBP/16BE39i2KAfDJhYC0W5nlpG3dG7eefHLYEat3h7x+musggQ4z2QB2P0Z8DdXyNANOVBC4BMnjTGIO0esDlS6L9fWn4+eUcz6+VcZujPK2f5H6GyLuf5GoKBLr9HoeCPKOfNxa7StbD3OH5RfHzy8bDmpFH32Je5eoqMi6XZ9mGAFix+RY7KHGC7oc9OVmgAMTwhP16Z3S+7tc7nWuKAPpswB0AX5KPjjhXG8ctkdu1MW1ZOJIujJ0xti5ocBUrbR/4xie79Zqx+wQbDHU2mSdivEYEaNK76Kv2qpL35NjcGkE3NvD0PLEgnlgFKytrfSTBeRXw5xI4WyNT5TqZJe4hcqYxTrZXU29RAp/8ZoZr0QuWcu9G5CYpT1GXJwBiHbICGMdlNed7tQ0E7YZ/5+6QnQ2fP7fwmMBTlAjkom5oVLj4auR2V/V4j+teMa9QVIsy0HDv5XFZv+urY12V9SuvlikX4fSo4AyyqWZWC47AmlmW9XtdpvtXK1Fr6yqu0Y0RuIN+T7/fi4fVNXVbJZaS5+61CzMlfo8jRRNjleln6w7xF2tMWXxI8c0dI4yfnbwvwK1fCODOlAKgvlJ5Uk1VXL7SAJqkO9hwVxlZIX8Yy3r5smK+W279/LStzcug570sm7q87E07fGtL0b+c008ik0FMyGOPlo8n9JP6aaW22hoL1xAbcx+tjjzttX5kcF+eltwOeMWGAMjl+7R+sp9leZb9njBEeutcip5H4+XzEqdb0jfbxot/BqlZcB722GafUQH7FuM4hvG3FhrERzFkz2Ko487aWsktMan92ASwqkB/eI9z7lY1YEZ2m8O5hfHiY6B3t8YaDlGK2I8WVu/BSNEo7P8ajSf0bvWec5+f5hJW6j1PZ/nWxATZnW5Jb7yNFxvqvYUw3Fq7YeD5u4ljXf+ALyb4orrIwkeDvW+ljNKQjMKdb1F2KHxnsyhimErq7SRi6DOORBmUN0QlEY5W7QiQ8R6OOiBJRDaY6bmmrqhWMwD61EFEQB8kAUe94oCJRyfmZTXn8pd2RPA5h00jcLR6kJGbUN2GPJpNbSiQ7GpsAhcYuTLMer2oynw4OBTD7wnW2yrRAJ2VCtwOBCza3RcYbIN9NTCju8EGxsgf7SOCc2J8Rs2lo2Pz3yn1ckFSYzFQL4sBjEXik+rkvcbAq3xMh5KgZr7hJZan9ZN57tA9jyCkWgCJDHMCKGYsR/ctvb8sKfQAQZi8er9+vP67IhDKx91vXo0g5J4AqECpx8Tcx/VOIqClBXr6eLdLsTmtVZqGQKYhkvOHVC6MW0GZRDoDBu1jZ7QqrNE7Mwmaun4pgFW+6SqSQUVhDBNAFTs+4BXoECoFGCC1W73X793P5+xW79lwaSSbDTduuqjOl8EzzpoE6eQX9SqLhQVqNOmdrbEmK6X59GTj2R5N6nQxl7h+lW9tCMCSCAjCv+4dOyAWc7warV9L5S4uJ3UsMDyhos7k4+jp51U67uOo6iYuZtM0YWHmYsnewgK2sBYjOYGF+b/6FmRoTCnKsqRc6w5md2Pu+LVgzGjI3VHZqq4pnagTo0yu6Q5bmir2iojcooL9/rCYv8tRamrQlgpH4O/eyzGhXlbfjTFiL1PPEApWfFI/HRX5J0G8BK7C0fcycJtXykgkQ8Npq2t9hrfM9/GpS0hAOJwnEBB81oO26bslfQ2ZxhfFjy9vEGlyjvkqAef+SoIZCVyzEeiUF/XuE+DXNhxWh6vl3kixXz9uQ3smPOx/k2DufiXvgHrxPK531sFL/DiKlF8CBUA6ww/ZAKX0VjgrACwRIFRBv12jNzR/5eWuBSPSo4i3sAiYinkLDefaIuEa2OhQ9SWQ9XOKIfRkE5YlfFOW9/sUYPUem4BK+G271Xt5V1DKGFC021+6aYShp2BDT59zhgB88aGnBbDnlpfryS0igRJASdDU9iPMqCQprKhsbXFPL7C4VeqiOSDO6YkLnCXxoa6ZIjcoUhFFVhyND0SYfs8rGxHoQKcu6x5LLYoPw0JUBxD0+v3eGFZVRhNOhWZXQasdZSCZQNJJ8MJabWxOQQO7Jce/JMLAuMqKM6oL7LfPFgdgRKrlZv56BGv4MLqB/xQtDl/OlAVn9pOpmUitJH6j8Cti3GU8ob6rXqH7BML/3USuenkIv3JvMVL0uvZlNZ4MnuUaCSqscuznrr+lH7fkIiUAB332oQB9uefLnzBpqbHlMVwtEpBiFJnYmchGUtFs4516QwttWHD/MN65CJ0aMTaI0cZLGTtTjFSTHjSTUH7sBwRw/16CrQP+8uV+9TxDvbcwHh6DYerrHJ0aMW2hbZ5hy/2SZvt9fgudGc9p3xxQ6g0x/fA9P6NAIAx0/wGm+y7PtReXqcGmqQQNx4SEAIzQfV0Tdd/SNHEPlm0IWnFBWnHOjQ4n9CrdA7XNS7veR1pKoVhKyL8R4Ld1AFmQqmPHT0/DbYVS9UwVZWd/tf/ngQg+ezXuuX6uLCPdV8bSnizPsif8Bb5/v7+amrOJJN4VIJZTu6zmfi2Bc7+WwJLeJjhCg0ygCiyFg4ttkUQaU3Xsq8ihMT/rqh6PVcZAYK8M1w8aU9e4FFARy15zl78OPqfE+4kce0q9jKwqyNsLEe2X7+2YgK/wTEAA0Fme1E+j6wEzuknCJbfmwgZFYYZ8no2RzyejjR9fLg7U/YAk9dZIuj9zIALq27ZMyTkZQKznw9FuOQoHUu8FXJ5fO/UGdu2YCSRS9NmBgOZn8Q7SGaV0hwQ6FsEoxnOVuigTx0nownZCPb0qWMXE+n8JV6xZE/fMxAv01ExjTQQo5BtrJtSM7hVYdxzUcl6+FiXCy7qXO/da4de/6vZ6YfWZqpBL0TUNzn1v+/IimCxAcPXnEnQlCKWhScWuU33bYduPq0n1DXW1R9W51SDkFvpQugQRlltbGYkSU1xBijoj9icxgGH531qT0gKyqlX0UWRVC2mgsPqoz3gFq+/xqPo+Dvql5o69O/WGGjQDPAemTlWWx47Bi8Op46nRRb0KRV1skbtK9yxqE0V42WzXm+F5hJYWngbOuV/gnphpMINswFJJuJ66XJkDm/zUuew+qVQExLAwy23ufkmrG26dhKDX63a79/vZ0tSWupHO6fF412hpqcgZCi/7r5SgyK6pG67lBY+Xu3wTcG4Og4DHhNVEPPgKn3VjDAY/4rMuPIMAQPSj+Tt81tQR1jZhzeK6QHGg781ioAIBL+50aWVSnm9l+PF2qGi8f9YXUaHqqZmOr1oGz60QK/NyqBMIIO+JPQ/Iw4qiTB98Y+2B+BbmrpWkKVXiwYP6b+tmg2SSirzQO6oKJgnv+ZB0z3CYBF+e+UFSdYTM2mhRtrwyGyNeVke+uaBQeau02xGBTvZQx6ex4Ad/AN9pbb3S4F9wbddQPGv4nmk15RLgXz2sOTYwFWhuosLibG5SId/7af1kr+vDBZ/XjxvfYp5nCLDJAmcICmu4aDfonSEPCkEwj2zQO2Nnlg3qPa4TQsBhpUR48LhoK5g6hkn8jqrCsOieXrXCRwnA3FFKrW6wdTMQ8vqmUu7zMi5npZoBHvbVj8v+rwkAzvswPHCNwRlGeXIVCFu4h6rHDOhUEdQxEt/WbcWRbknQoPU1l1tfeTkcHP+9q/GQg+UFTPqQydvHcRsRg0F71939fXK45KNaryC51za+ndsb/XXDYzDqkd3Zr1vemeRyLFQiT1kJvqTY/PFYOSLKRihmXNVjSFQKSWe5Jy1T09KIPY1FamotdtlTkkyWTCYF76TOtdbKMjit4IjFsJs3eAKkaPm3H3iNFkZq3DQfYhN3T40VdaZZpmEkWHQk3jmEdw5haQlaq9f6LUjaYw3ZKPGjDXgD5lTpYI7NuOwTJWV5uSJQc+IA3V6WY78llOuxllKU3puPPrp5E4kpNnz68YZHIplVZDNlUBRMc2dPF87RS/QUQE759jq8k7W36HS3oageiH1N0zSWHOTj0lkmSkBF/7aY4U1tB8usQCKl+TGMG05RJyyeBaOK42eQqArhAnJLG8WLyy3t/bBlsS93GOBM9WR58VKjZ35QU2q6G/oZevVDSD/cGuJJ/bS1DYd/BtNsoQZx8fGiflwuV79g21ic0cLznyN/UcSDlmmAbqAdrhW3LeNrUjx8m/xwxUUboDhrqSJegarZWtqRPzpksdQ72zjdAqSjjeU2j/2W+TFt8ergDtInQczMOHkOgasPBNAJDn6iUhsrwzqOcw5TY+hq2tdie0+vbWpfXf89/tUNgyw0Rg8WS7gDIHNYdSpm2cTg3WGcBvcujldJGTFeDyXimdhSg0VTTzONIKB5L4JelLTdZ0oAbBJjLBJQ9X+howtrOMzE0u4APvtDAW79DwJwcD6CjOsSI7CC38+4uo6HhSoDaGYBro58OIYHo3pMpsxPSFcezxH9gIgmGg+6mugkqtRSLMgkBa2YZLxcYZOVwutI/t5eViedE5IA5tGWZUVMJFjClvOZfx0uz++9U69FwwFok34p3sKq0Ws97y1KnKGhhCzM6kKVBqvb8+QvK2MK3BPra76n18YygDzfm9rSpWPe5Ht6LfBaavzqJ3/BsW5c2RF5/IwxFXZB5alDls0IMIRsxlvOFc+wj8qCAu0vq5l+qHh+Wc00Tc1Fn/kCxwSoldyTHOasUcP6RlR2KEZ9qXguwXML4iQCHmYo2ki0Z+uVQdtuFE+tjfWofTwaJh1hC66X1UlLJpfKB/7mXWxT/rR+kl3LQyFXO9u4TeuH8TBTWpifpOfjpKyK0hRlZcWkVDwpsz76c6IdD+0qG9R73a63E7EQWZSlGkQHVWARG5YevrD4WOhqwGT8QmjqQnIBdCT431aWaJZcG38BCrvEBhB0RTmCmSggz+hVP+33+/0uGR9XhWLMAKJ3KiwRIFowq6iqObYemukEgwtRyqJkQ+J4ForVXNVjDfcLWEok1Pz4e+p7rf2L4fb4Hszj7+EjZEpLIJOgYdDpUCr8QERJnhSxkhL/qfrTIRzHneGTE+qk+lPV6fcz78WnEC0Wh5jqlhOoF2kvgIS5XzZh5vxbLPHzXtv7mm4ZxiTthDNY/ol/L29MwGn4pB8IdxOlqyFZbEMqd93edy50N2vVeyXI4XVNT41JSSNjpKMHOe/17qN05m4M1ZmxdV3RopjhdjQFrZ00aoZyuljNwonX6yifd6SFzsWUCaYstrYyZTHA+X+vxzHx15Q6JscEbOwHj+331Ms1f2C5vNoYL6y+rF7lFYZrJy07N4xfXhZ+Vb26IG6NQVhMd9TK4PYH+5xKdDAuMSFK5m3cGQ4qYfCX7tRraw71ZbKQZV5OWyt8nzTbsy7XqumHVr402+EKNbIAbFyAYgWCurZ/qTzwdBZAlnGVtTnpIg0CNhsi8Dv9CslD4yGSqdGGP2gr6G17wbBCPwL/VhvHLR77LW1MXhDh6/hekCSAAdFBNe45hsegX/eY+p4379FKlhEDS8F/LMbt4D/BgXiwrHCGP1avJhe7CzjQCAqQeFWdjDEvr4L2g04uFVMNyopSDbpMdAdt08Hdwm0bSlg+LcGTBM1SqR9hDoJntptsPUjjpZzzfC3FXpTzWMzH28JxC/CttdFLd1GvvSZBla7xPGb9xMDYVeriBpUKjSBQGxINO1E0vN7U1pZozJ5R1+n7z5YM8n5/haY98py5pmkcFbTC1Q/EseTVH9Q8ALFolB4D/lokZkgsj9PRv9WEMrG4qjMJGgn+SAJK9h74le1X9xD+LTna+M6HJB9VyuFhtUrZdbl4avl4wEQiEAqB78WYauNBi2y8Gm5kGJ8UOAoDf9ISDtp4qsWf6ZdHbg3kJ5RvQ1pEjTkNyd0YkPGS5UwntPmwIQQOQ35wUa9twjS+iMvSStAVIEsPs1ilci3n+6ui9nvQjICg28UIHGn7sBzE+Frk0EkZn5mG+mijcHq9tqZSLJzyggVR97qawZRUClhRc5W1lA2EroIyrsRxpbBZESt2zO1wVdVYkrgImTDBNv4n/HvKCXjJzrkvP/m0QOn2JO8woONMdbq+NPWT+ukaL7ZkmWmpn2HL2bDNIT1eKFmoV+mdQNlYydI7u5K42ip985iQKF5pZeMrzeNhLIFd1GuzfrzaKswfRIMLvkVKIUYQX9yMuk6V+iqFbzFG3Pv3+wlTZ80KLb5fDpOruByCj48K4hjH7/d9mWqsJYDWE60Gi1doW3gs7IaRctco7Nxt3UD7a5Iv9l8hyL4k9nEvjYVvAA65few5Tfj1I/mGEGGbsJAK9jidLCsTw01VqOHnnUxNUw8S4WcJ+eDtY+V6Su3Rbfv0aMy2ZGNsTYHXa+G2yEgcKWARlsusFqvKde+LIF/Ne/JQpvKiXhtb11xU18tSgNig5qJeRVSuQRI6g4ut5OVSFulCkiCfjOATXzYm0NOG6KkKF7juF2zTsIHAR9zNqZlOUsTKR01RpEHTWDSuYzyB1hR16KkmGjVPeoUjUxHkGx5B8PNgwD6ppjKuJzWAaZCMxXG65clWWcAh3D4+xYsaiRfCw66P0a6QYadi/Ezonep9rJiLq2Knej+GA+xUl2Lm6U51ict0B5CHPXn//njOffICId0Vzwm9yZiLUDvCtXqnD00Mz0NdpHG3cFsxmE91CPIHuayCyHGjxKWInUApO0JddxRFWsGkvugFV9qD7RG6ygOabP6egm8wQRhvvWBbf3Wdhvx7OQPWNd5axazzetaNBQqvx2o9q9R1af2YibatGXW9krTg1tx3I0jYbRWe1HU1QyyV98Q6BUglGmsvJjqTwwc6Y7D0jiW2HnM16JyLRSK2rQ6RfMsYt/EE5x1zam75NzB8Q6NucBT2ziy/hR1baGLFTthkYq18FWMK3KLAATIglWVpTFUM1HExptX0V4rfVe+CfPyujJx9NxbHiyIxEfd+jyJJBiOp6pOxUNC9/tyTWk5SBGnt2dl28yGhrgSdfd81vroWAOfcJgF6co+SwCbnNKUAsHeg3ocRJZ8UEW7vJ6wu4UDMfy8NX5LDsxospjahLnF5C6VWIkC9qmDuUlNMvhqMFtrgeIniaKG16S0sfwyGPCs7F8GtATexMPa3OhoLqVFFqfG/DlLjB0I21FI2dNREAsS0tJStJ81m4ENaFqBay8UpnZsP8zZiLpLTuKZpqlSLb4jt4O0bCvm+zpOImAsGkZTkxb3+UnK1itLtJpCqA/HBVOpFyuotcdzAMRpfZ0Rbrif4xoA33Z3bmO/WFrhVEM9vJP0wjfE9wcfV1aIoZMZUv4vlu8lV0YqZBD1+Aoh/DPd9V03XKAXAYe9i+CBXQvFlRAoykfxZQd5EUUbkz9SfsfHqz77KMQFfW1uMCakX5QeqE0eK7nhMwGgX4ZoIXzrBIzl8AdxZYP/E/GOQKrULcTePh4NZFnuK831YhVbvc6IP87CeYI/BCsncraklq8s7DKJnD88p0nOqImGPjZ0AdkU1YkCCBdbxt7WlbBqgwCXlliFprUpfqPhiWzcOhDrSLyv2FESlOr4TOhLAVB52WPxscJeF2qUNQaXgpm841/xIsap/g1tDBUB1XTy4JYHbGAGaFD34B7NJHobkwwOsGnojNMTcKgE2rb6R9fv9fq+PvtYbuSRgZGylNNG5jnCwjHc6vpbbVT0WvZvvqmlmDpJMca5fKckU30AxuEMyc7LllWjjRccg0Ec//uweO/lbTgWgHBORXXeANNVozF6EaBIRaRk85o1/86aQ5RARWuS1tTXKlu+TbHn/P22auMixnvH7QeospHQsaUFXgjoRomukEtFtACSDe7sxcLWpygLpR+MahyG+XoJ7hEvjkBnCC239LMMyt0hMqGQeCzb0LcYORspTd3PNtynAaFrgGossPi7LHQjQ5I5R33N6vKY+tZYr1PslhvnW5DrB9VZWVEwX1cTaGHaCggLpjRoAOp1ur7+eFUgbwminqGxfgw8UmBl9KuRu3W6v1+vxWyzLijKC6fX4yoWX2POh6PVciinwaBjyVOKiNJcSrW/IlXRd3aiEHfQGlQo6tiaSMyzMf4OipphOqRtaEq3QhCYUk2g4dZlNLKJInDWUx1wUJZMmULl9FTEAkSIDyKl0MgFLYYr44LmgEBY0fldx7jKCP3/t7bdJWua3QPQQztnxkiGp/F01XZT2Fgh+pNNT0wE7WLzeL//73y6MA0FYpvqP0wBr3tW1TcjR4kHlty+tzPcZUlud8ChI+iZimx6f32iw/A8LPfj5kaR6D0yAYh6n4SW99hhX3cK5X1F1AKyweL02cu7XTYN1Q4XQU+N0V74MfOTrtqK5jwue535Xzv2KbIpW9NQic9Z4qIaJNKOIegV2g6XkkHfV9PamaTzDfjcqM++qae5SdAzBWz+GFWP+gQiIlykjAannb/PsP+pBHg85h/95/GBrfwsPnT/P8cxJRzSdbvuX1PuBEzm2tozE/u/5sNzSxp7dzZ2pyoT3EQm1VOK18SS0oIIva4G4fhZI6CXneg8FoEOK0FpfqLO0JEgbYyVx5QahcYKVOKdgQlbGRPEQC6HAnHLOfVF64pp1KbCZJMKyLCqf6wJMwHKnFK77QfV4rna4DCkSSg7/ayIF/HkZqGZJni2gmsqLhyA49jbFqefkPHSBvOM5qtvN8wM8D0mTDvOQllJHTRD/9+wKeQ2zq0IVccnyKymjcYhKn6rreebLqnhpwhT0EGtMygOGGfh3UfiH6EgCARAMSrBkow9P3fA+hbtlQFmePeNmYr/Q45H7IvOsa1tXgpNmvaQbLNOZwtefpcSmSGcakh1rnAZImtDUNK3ejf2lQBwJYEqdjJJBpCbDrQEiHbhN/FB7f3pESmuYmlRI0FESVpfK4Cdfqy51e5xgp9eq69aUSO6F08s2YvkZvxbzPqbEAPjNDsETTqtEOKqpyEu8AEtKtm4w2hum3s0YwXdD3UTJBtVdeMP+Hd8UI0XzjfZRSztLjAk4iW66tmHmNE3DgmxVlX7mjHFuPNEMa40NgiyefysRoRIAzwOEX/xQda+TTY2+teouOCK8USrMjNS8NIwflNtw5mC9S2yNBjMnWPUu6bUmME8kQHVVGczNQDKDrdnjNLpv5UrpSH2AJAMQvk1V8Yv34QUIvFPgppoD+mcsl3/iC/yPtOTxWwyJyK55NCzfcez40eEUMKKaMNuOq3eBbBMNDc+dYre4KAEcBs8AP6WBZ/Cg63MjcHwgTUO98nkpsfB8Sa8NHfE9RW6+IGPRdcdyKDOvRE3A5HT62UWyEhoBunJPVDfhsNMle1/9c3PNA/jcqDZ+RYWQMI8Gf+lVPf7TbofXPNdv0CxEHe92qS0MPhDO5i7pgQDHRU22ox6M4iI8EI4FS+arlERGYdjSwr+8E6x+qX4Jb4Q2svr9y3/Z7f+L/krWxbEFNqrS+Hp+8Cp2bYXXE6u3w7tyJBV64ZWCIvG5GyypJR+1q+BR32DuWcU9uXwjWwNgCySCfkL2av5Sf87Pk1cKwtbN1PiFe/ok3aibOfXS8bJ0qkfCD699CSxT10GPvHb6VbFiqPMSzIlul9NLWamkmvMwQTo+qEBE5xTowNXBhEnLh2clkhBYgPDcfqne53NKfD8dNsaRdFMb1hrIcOJIw/RiZUnE5VIsdAWUpkreXEX139UNdR3r+yIFgIVVVv9AIhU8qjzP+8i9KFGJSXeUDYxMh/Z9fSnfjI2GhSreYXoCK5uY3HGpJE+pkzH75EHVwWfgrROw5DHsPwj6eM4v1fs2KFi/ZK8tWifo6ZTJ04EN+HRK754JZAcL1eK89o6btcyAMRf4uroRwzSuqxs9LUA05+H0h1/HfFqVmJZS4IzPv+8Pw2np9T3mmU1dUdtkl1OTJ88zqb08Tr7ZM0olFg1TMjdFSQUeFZBr+AlFSfONDEnwQB5UHYcBbXXNU6zhEoHw3Jr6R/IhGmz8E008Clf9pR4FGKM/dC28XgZAobmfDVq+6NNIu7SSoPGCS0UzntYpkE5ap2nzxornKEZtUNFpUW6nqaqwGrsZRyXT1/zjcFgTitjhurRUaPpq/B4QWUtqh0KCaek5W0c92ENjWIcmH90OSLa/VO/DSySt+331y1jtH0inrdLJR+anSyEnk57oz/sZFXiA+QbThVbjdb022yBmFdYlNKCCw6xS9LBwVvVCNREmdgX6XubUzWDCD7OKm17OtVOs2FJHel1RWpbhaYbAMyDOVgbORkleFp/OBBv6ywEwlHs23scnLBHgj9XHQu3myQs6BBLLc75IxkBq7xhTIf9eQtyFOrcoPrcQJqpjLJI3fNfBD+wJDZGt61FH5KWkWR+HBYNyZVWFd42GA3zXHdHBzlKzDloJ1pqyUBW1+f7n4dJX9VhSfz0qYsC+foTBtrFaJr145Fj+MOZYtEYmWGLONDlHuU1dO+d/+KMm1GNt7LfAv4T9gG3LG4O2wDM/ppmVIo8Zfaw+ZvyxH6NwROpjmJMfOzfboWn4y7BHzuCIP54Hv78s/P58GBdF6A8PgLmimoD5SuZES4TfGgM0jMQ93IwLHmh9wu+a+j8rabgsUytmjRrJDeeyPkkQN6VJ5KaaK6j4YkyCLooiVleJFnwyVQrzU1mEbodc7xspula+IgvngvVlYlgRJfgDNRuz+EYr4gLB4kTCCTJJdp34Jjhe2cFAvI56sBva8AQ/ClDnCYVJFSQVTqjHkC8jv/tYvW/4YeNbiEEh9Eoa6t11Tl0isltVkhcfE2ykIt+Ly31xk7XqOjWVp1DTG8aUJVmpUB4B7gVK1U11Y8Mab3XzHFezhIe3VjMBIUOOrWJLYv+s+70eT+vjCw88qYXllqVh51RJo43hXZWxABAClwCXgFIClwArgUtAM+KHlssa77bQcvC7FB3K/qK20l3hPMzyjHWQjpowoQ8I0NDQ2ukxNRHKTamP1Ucm2KI+Vh/5WNNBQjiAl0V8TuBzYaTojjHMfdZ7QEV8X53LQr7C++pcJbzH54iCFZGekYAJPDjELdB6KammNmhEwld5nZ4NrGWUahPiZquqrNBfe5NyzA1ZWKpARFl+NxWDqA3MkahGMvKcHjdcYy/qSiAFYjprzi11sPC95Mu1tccC4BQDD5oaC1GpUh2NSSylOkpUC8TIo6yKlgadkEdjOtm76mgWSvyTXsr6yEk1FaWJB1UnUvsHics3jamkx7hk/p/1yLE0oURHrAn1mJHAsVI2ITh/g3PP8W1PqI/Ux/zmq8ESJs65ZHw0hD+6ExwmYsUehXPc8CRMN86MCHMPfgHIfvQTCpyIzn3OysAldS7OsEjSDQMun8Msl9fvdW8tUUWU//EcD0rg2cBYQ6UQYKzB0MZTlFQYBl+giQYtHxY1baqqkGVZ5iuVUeV7lWRzNjjD4ps/qspYHs1PN0WWj6OOG4QT8OW6Qaisw5yYVlMzol7CSbK58XQDKTSZe/SsH/QGWgkyCeQ5faaIDyqflBsmb1FZnK+9UJBsQj2GNq+qAOFVSnIwQyVq4fb+j3EaLwe3x0ewEs7xqoBJpbtFoM/nljUu+b90l0aKAMe/lzIGQwrQIiN8UQvf7oD10qWAJFpJ3WgcvKS7kYaC3lUZg5QFlhUqruxHuR4NcDfUzfolfw7aJr5o2dYbEpvYoFhigt5NSnplwg3ykO8PPkbWq7oe4HqRY3FcOpdilmEEFtvKVCxqY7EsVeWXpcu7fllSSUgCWizLU00jQGhYhqArgTznPrmuY5oUSs8YlFXRskQBxpIhilyFpEY+FlsRwAJRimXXj9THed67jy79kTqH+i0ar87prgsxTedwTthSABbpPMB89K4nz2VNUyewX5iXaog8F2RG7Xa7ZHr18yiQZ2u8KH1dRoVfVzcmeysjML4lZjSFUYDTTYwCr3Yw4aaCpiUZWP9uw2oVra2glv87aXp13i1bRitOXRv2xMZXb8Krdz3/6p0vu/OuOhotmvjqOfNVnVLTgSuRXYwaV7OF1tZWJRS5ksBZbyDC00gaCBGjQQAAjvmYWh2JW1D3eUYvDa9O8GoY8+LVfiBenY479CJ6o0DAH6ZIYkDDeOBaVoQWZ1n++HAR3Bq4+srSu2hZOiO37kfqHEsWccEVyYIzRbLgbFhwRWloweX9ng5rLIbweWM8kFpyioo1Vh8XywrvJV1JHEhFIJsM4HRqj7CWJSXvtbsZSr0XLLKjG9SbTftZ39tQw8LG8EIM2qO2s2NstsAWslczH2gvVl8RV1+/41cf9arwgOf+u2JMY9TLneGjyxsDPOlujvQLTrXQqRY6xdzGSdbjJDFCWRzDi071e17cRU+HlyIfVJ2yLMpEcKSqpRNeI2T6Q8QMuM1jajWaOUxV3uVsxmR5A32ZB4+gP0QNgpGRERsZPxSL+MME3Q4eXv7INDCb+yP6Rsoz+giHP/Rcgj4OhoHR+NyC+FyCz43GQEvO5XmGpUcCYSmNbYiTZ51IS7h3UYF6vX+kAugA8sz7sUJjGNS6pC0AU2Y2wSwiDxdbFm6omyif22AYcBzrEEIvCkw4nstdnuf/tkPkwx7bGmkJBanV1Byi3yehEsjHqRLrF1f1BC6UUCjrlNpbhsiJU2ovzO9fCODcawFkWfY7XQIx9MqvJEVBzKeyPpb6EkEM0sBE5v+OejDr93RHGJjQIhoMTMobmDTHheGyKsvKeIludbfjfYYfq3OGmm0ZUm2IoDZROkPlDN9pv6fTd1qRVbq25C9mGQxVRyU9kIV0OlKoQfPphxVRE+8vI0mLpPz7veHG7ecyPuhQIdpShh625He5qsdlmswYmkmRAh1VpzB85a/huZ1Sexv3xabwsn4erCvwfv5yk3yNdU2Oyr3qFD73d6q/5z35I8QfTiVjuoXv/tibYqbIOXW+BBDLt6HIbznc8qSaii8bVPnQdcqbkVJVviqDdzUoAzHsh2RFZgMgvrG7eEKtVh8TEYcv/Rg0A/RMVjipVGtSFZUnFKEIVhQ62DFXppEtuIMlENIcVZTYsZ98DDLZEDzZtHywaAtnIZDEnmf9hxgkPu7K1LENZlEaaxvfRaaMZe/G8JgIblmqv30UZ8sBa2FWnlJHO3KGYcDYJkkbUG/aq04ZmKJo0gnkoDQkAMikqWG8cJIVzJM2PrUoDls4FLRpSEt/UHUwEq1MZkudqBlsjvStNNjOiMwcZ0tFlR4UzhamDAXSo6jsfazOVZxqg4AvTRomTyNJnEo02XUzX1fIzxafUn2DfLBev7uFBdDCbCkrlGHm1M2814nTwM2KOeFulWVVMvOgMl6gMcOemrJRfH5QGTW/rY/E2XLLmmpHnC1nfuCLp459+cm1a2nFxFu1GUQhjEcimAW82P5hfHRo/9ERx+/lGdpgyoSngeyV2qtO1Y1lYQ3mCrEoVEpPxbfoDYiBtjSUzzJYKFmXE3pvH4N+6ROCvesZsHBEexJWU2osaqsNx9atVh8j/ULixnyxMkzCDBsNokaFiSFo/cr71Orgku5G1s6ujqoy5Orohrbxa9X12OUQpyvO1tITN7RYUXwxdgCoykjciuoL4ouZF7nn1E2RiaXIN2IY4I2+EgGWDx3o8TsbSDYlNjQWw34LrZeQDsOKG4kTY+qqZ+aDMDH34iTcm+C99x7jdC+s5YSxvagDYXAszv26aWpK25tSJx+Qc780PmwXyKbxlRs66jHyGVYqck+MWlqtHot8CIhjcDd/rM7VSGSM8VMPxdyozFcsn/VCJeZL6tMYaHtJfcrcygPyXg3utJzI0PhUfTqE5bZP43DYYO9u38CIEVQ4/Dt+uUOL7CXsWlRRj1J07xRR0agbr0GAuBqiDskOjZUvYVma6Je7CUusaYz5kRrIHA8cMP3b2CyCh483Q3ieEVc/RosY1IHI+gfr0YXC+CFclFPWoime5RwSu46CpmNZJNyrTlWVr9p0Sk2VaEQmA/MJI1JJTjhrzMYAXPe+uCfX4rCN4hwdln9LosH3OBWLwvKbbeGw5ST9HbdETFtGYXkNkoBmf3D6VfyhyMb8faLQ03Dmk5eAGlrXnV4waj2mVrN9umGpmPggLuUq5BoyS8E6R9FiVylhIqdA/S5o3J2wlCku3A5ofrdn/fAqCL/u0zZOt4zCclsbi8pCcrl9dZ9WaSMMIBBIYkoOT5HAnDQujsctyfVGYKQYoUMxOhnSXAmbppU2DVkwbnKCB8YSmhjbjLQEvQwlrV4UfW2MqiUVjOpAWFxiZLR4lL0UR9VeGy7tRThbVyTcwQT1ikmUHk5huzyOaZ1Sp1wvRsyf4PR3v5SJD02oEyADqh2kiU+pE1EAelB1QlNOXiLNX8r18j0BXlWp/8IRu0Q+iIkgj6nVBkskWztgA100WS0Nr55n/7lk/7kUOycx3tk5XqOoeRpeowUFtSDv9QwfpwYv3wmYM7ElXLCNVLFqSDB+kbeeQXygsCe42OgcCboSpIexJB+M7ybwMVaNbirDUob1zILccsgsHJnzECQtrFkkJbENo7M1zz3nY19xuuWUyskzrKgMS02n4oqZUidgupIGdYImYoF9k6bUiWhghklVpES4rpOpg/zuMQ7580SYxSkzkP6n+GoDOtfaey6iYay7wbcyApN/vI09ee9Ewp/5n3NOd6PPElSOKvWnYkGfyBJKL/f54kAw92L8MIl6qFqIiQgr+4Ze2+12mY1EI10VJwiaPUPxh8S9Y5JzSunrEYf9xpRM3aipFM4wvgOibk1TO9LrjR4vSvZDwwyr6x1BNjEhgECPq5uvHBMgZj6N40yuAsizrN97aCWBhx6Ih13lpijeWaQOgC5Pk7dL7UCBVh4FCYQMZiDpWAyMbSJ55K4/qAzASkWQ/Y6QZ0woAuJpJZZ+O6HeaGr/fk6oNzIVgae8eOkTKECEad34q0W7C4b8PxaySjCShD5WSBC3rJAgVOmDNdD3LIASQAuQKQFUBKSKDoZE/dGC/6cJFkpAiEVp4aXHnnwKd+ML9H8q19KnuhueLs1+yozBWWlD0mtMPGyIARsq7+oZME1RJoLOuU+IG7OBGU1CN0PuJE9E54wpIsh12HOwKw9rNm4U5zj7nybUTT2OqaiUjKPHX/3zPGZ8Fz6pJCbWuBCGXRSltTLymsgwZzo0QQTAxEGgyWeEbfJEQ+0HkPK+YUKlwRPqjR7JsjRFC16npNamZNjVtSHb8WNc+Ac546dxxXyqvbOXXgkVIaOct5vWFzXzfImC4YFqVKauMejH86WXqLif6XtnCTzEukQbm3i8TfMpPkQ8ZyOd43MSv48g3g5mNGEwTMFJTBtemdzw6KNcuIOrBOPjDWv2qNpL0kkVJKwDZLkhA2+WoYuZwG+rEV6D9jgxLz6B/50QnoATOPayaeREgt9I8Btx4CvlFoB2Ao/jqBLc0xmiR4rpUb/b7QZ6FENuwssmEvSp4qQsWoxKLEYOCleDxVSJ28V+SyQPbUzbkP2xxU4hiL/6Rpg59YQyCPIMgzUJZP0OgxilE4QlmOIMqrKqPNGYCays1+/30etA3KtIiUZjJAVxpQSYQqvHbV1VZD6/GaKOgGXqlSvvE0zOsAM9SGhVVOeR6WJA8t5qo5jITs5qUlbgze9Vb8Te4nvVG0wqa5w6/HeDUyd6hWhSMb85wefgSvDAsz+kjtu3e0LTwzIrNPeCLb2jHgvTTYjTJMXA+XnWpdcYWxHdkNVj4Z2idZWM/j4I2tA7xTby4TUWqGDwm6tUfHPesqbH9cOBUgEI4XgAElmllMC5WzWF/RM9WrMpIx8Tv+BHw9vux3Py7CF/6ZXVO6GIll5JbCX/PoE8+69UtORwuAWAIEOQL6tgcTqI7UXBkg+vzCiqptkdi+GFwh5lIkWKVKl+GP7DMQF/vhpeXKl++B8l+CfKU5Byoe+4p/iH6ocUyLmX9IkSXcRvkD8SuN8b6gTXM6dZ/ROqR9XwngoruLHSDBo9ZVU/5hO+BmlAXBoSd28x0UpcY7zKiTHXsmJDTBi7oW6SL57FeRJBaqKbvSBbROqIspLJ+qQBI9g6OTm5aQ2vMedeORDmfpXK9s0DD3ggkmn1w4E20VJ0TV1FwM1VHsY78Asb9vxxPCfPxNWEHKcfznqdeAdFUVGCdFyxphqom7Ca9Uo/qPhKiiNaGm6fL7e08bAbBzaTw+UqjHDi1XuDQ7r3JqaaLJVyhndN8TiWbFG1NbQQ9qo3KIHvizoyi5dY9lRas2ZMgooqlVqDh1ECHwiieA7ZgkiLCpPyBJqiuToWLCsKbMEglRt1sEiAuGmpg4jUACh1gyU6w8EF3mzHh6VyQnoBZySDKbzQ4ObOqChBdLsMusMSBDVEYE0jMcRUtVxKQbbo9fxScs6tCezKuc/uE+DvcZnM6/QLdYNMG9MWicNIcXv/SEwijOWIaFy+hUqWL5pCB7Bik/FwGMvDyz6f7xM1A8m9ffY6L//P/w8lBbeawgn0ytj+8Cbz6C+tmYDlgsyipO6pYzYESx1Ve5kTIxs56htn4hqJBSPeUCeij+mEegNzvQ2LWpbaxpVCZCfrxAmsFdKRrWtEpb5Yt4/xP4ojRYvjxQboDP+Ib0EN0jpNXLlJYjjnHuKgtBiOXrthQ4LXDVDmpeDIzlaxrneYsK7zPN+IYF14O0bdfEACrttjWGKnmKjArbCOKUw7pFnWVjhz0D9cgZq/MlRyQaV0pfvkNzMzBzaiLL8yQ/VS+Yi3kjMn0exvWAnbq96oKu+0g2lUKFbpkLrqTrfHc+pv5QS7EACQ2g6JkQhIZYdL42wro0NAB+nsHr8/xF8bwl/z2/D9NiKQ7Aa3LLNcgCq1M3DrhUi3y/B+yaJJ4HN6huEw0J75sN/YWolz3PYAen4aZKHCEe5BJ2AzQOlBkLkF8cOjMEsg4fhky8OxIBySuhQ/rB/GqRcn2E0vNRmkYA93ul3WkWFShikBtK2RwNW/DqDX6bxKiZMrpai2Ul0FkZjsDKyHWnORAzz2inG0teVuBXQcHcKw5YewRH7oTqlAdn8YjQGg+5LLvIxySsGLpxdI9QlQkcXigZn3yAMeXDiNaZ1SK6YLONB3O6QVT1lyCjcDMZlpQqf4a/caw+L5Ghk9KD3za/F5RE28kZp4g/q2CvnVN3iG15SmZ1RpOHXbyFpwhqaeLztkYvTqTf2wMaZGMwPM0JrjMHCCNcFy63msayzONlq8mFWCez6r0J+wEu2mZZh6ausrjfGBRs652X+nVChfkeWPkAnkhyYEr+1VP8zzrO99A2/UvhIUgCjEoaVkRwROzqM+BclVcRps2rpdzAmeVPBr/lYFw2uPv5QYewGq4wS8rmh++1pa7O9rLs96Piz8a7LQ3WNs3LRkeOWYdcpb9uW5A3FDcQQIVdbPHgxUK9hnDZYhe/QBD8Kl4c3x7RBbc851SdkjwOaMh9XNXu//J+5fY+S4rjxB/EQlq5KvYRZVrXbxUZXFKhMj8a3qwXQNhq3kH//Fmg0arFyYwiyMAoa7ALX8sAsV0fNBwHiUIZhsyTXdskBAgKYxGBuC0MsPAkQ0vxi741a43bAbQmNtLAisFmpQobFlaxteOfSygmYo7uKexz3nRtaDRVKevJBYv4jIyIh7zz33vK+EnzyEltssAEfxagx+0gJzBiz4nw34nEOa6cyz9rJffzBjb00S1UfJWBoxNHcHzf6Gqlwt668WfccfySDa3xrFuBfKUupJ/ht4AW3huEi+gAJeSmVJ/3t4IbHkokHVYljDtK6XWChEfxye6XUnOPTypY6lqkkLvmEBFWbMLd+qcM1+Sf1+/wr+vBviwpmQUnQptuAo52HyrC801P8DVLHFqRJSzoLVIAI5F2ygW5c1F6ARX/9H8AEb8CPiC4rTZz/IVHFyuAM6gUEnUKLG/RfGaoBnWiMKgrwHH8Gt8unJ8XFLFJQg+VEyRlYcSwd1kQkP4bu9nVAiaRJEL0/8dbNYLieZPTj8QoyGMdPexxyl+m/4CnSHvyBElRJH4wRpopaSHT6W9lIDTuWGRF0dwP/1TdbG8zznEqGig3y3yMX12Qvkti+MFZFbmuE+Cy042mkbVqUG5xalltZlRkQVClgQhdXFuCW3WsrZhdQUI5RZ2a0Mxn13G0s7ymUfG0JMs/w3eoZzzm+VWBQ4/fk83IKPYCbzEwbJ+ta/3JOmOScO3xobkyAtVAt931S0FqHFTDY7zC7/NZZgxOWn/sYSv5xfi/L81PkAQi6VDGOaFyS5oIOChxHzsCuWNbi8LBlV6vq3fIM/h5c+/pB6oR98RNT+VQPff3tpCEtDrAvb+1XN1CIvigAUqNwQlrw05yWvG5a8x8jJW2CyQah9Yu3adxqT6o5vMRIsiLAixjm2u8KshNQ8Rz7wIg3mqRE7smbUWzzHSiIwvwiw3nHLmVpdtz6viqJcGh9HoDzwo2SsIAccykRve8X2H7JUdttIyauD1FZTPDvtGf7UHNW286AKSR6eQkPMVyA9Mnm/0A37OKPkk3HKl6w3GUXxsMNIiDJMTOETnM3u+cRnH5aZhHyaEUJSWR9HI3qfGAUs3dntaCgwRutiryv1SSiUG8tE9sNwBwKIcUAb4Tv3hlmoEypAoU52+PoIbgE8XZI8cgs+Unn+VlD+RhBwKcmK5GzckaIiMtKi62NeTP7sh5nYspBCs8zUJNNMbCYWjgzrPjPmec4LoZE+9sJ9NM9J7N+Cjb+7cfalfqRs0gPE+KUh/FJ07KXGkZc212TxLIs8JfdoOszaIGU9zGFWEwLVjx6DVrdDqrlhbckW3+D/tCjZEp8V7P8VrP/Sf3REj8dY70P/ziMRMpvqN9nrZj9E1feOcR5wsnEmwqZz7ocibOIHeFKkWZYVNCkWEzMpEgt4DwueFBWlcuA8KPL8vJkUnxfkAMT9F6RoxwvwUokZIlVpLQAnlDW6N8RqMOh1kniBCzpdqCVH2b1Y8+YxzKAP1aQ/KDjtXnS6TOqT3soAClIX/YzPRHf0CweYhaOSjZX8+/x9kLTw5XC1Eut1wdkwL8BLtBVJgS93tZTgPA/8+88IYCGQQHA3B4lSRnMIx0ca6M/Xx6vdW6/wf/muHj1lZt/oLpEYIvuib0ethdHgo0P46BDeTBulxkJxS+Vgoal+FKX+AVF4sts5/5fgdfrhg83h4Q9J2LSnCEm+eSb53kF++XcW/H1m15pCz3w7MZe9AQb8DCJpyICu/c6W6DIFg16HQTckdXqVHyuailEoXtMk6MfLSSH79O1kR7vd6Q0wH3gHvN3rdbvbWBp6iScp6tgv9HrdRIzkV6lKj198r9JlKUpDSPudUZ0idr4klg45MS6fh9HADDCybhRXhIRoYjQyzXleRkwnhztVVdXPoOKXw50P383RNtcXkSEM9sZ4HYl4FZl4HeyPiC0gpcS2j+CDxMq0mIRZnDdD6lUjTzr+byxGqzp6RnyqLnK2V5FazvxwRyM+kmzPKYkpIccCLwuaZxhS0n1f6EhsoB8eOu4lQhzSlLOdXoKrg95OHTjdTEkGDgWlFoz2rEEFQtL8Y9D6Tpjmj0HLVTMKgnExaSdbvM6Hwma8EG+2NRdyf+sItRuoHY5Jxbn4dvENV8MuyBD8dzvIFG3BcZiei6LnmwF9SNSsTpHPLuwwaGrEMW/Cch6O1j6iGwqfG9OyHWzqoe1xPE2FcDMhI6ydTBoU8rp+skOac/r33WF/RI4qW43RWp9kV7KLugCpNaMYZE+trsZq/EitL6hV7SW4muWGzZAylgbg3K8zAzo7DMAU4qt2VlyFl0pyS1fMjQa9DjsKRlVADXIKpETUzLUoK0OS+zZ+Wyva3e01a2MOYs/lPyQj2tyGY0L+5A/kDT6Aj14AA5wFXEiOaxWGfBwOWy4EYP5Jm0FJRTfSsONK0gaRQ6WniNENSOK/BR8NBv8T3/oW2rSyTIB/kTtkF/BCacXBTWimzPMs/0VtS8mnUmgbxUXs97WpL9nVpMQmXa72XXtkdazXM+2mXO/lJXhB7RcvwVX1zzK5/gbl2KsYiIq74HnmelSD3EdjH8goJkgmMR0WvERi7nXZx5yDXElBsLR7wfZIyGtoYDlCa3SgKU+Ht7E//t7syJOZHXkcWKUlI2Ipi2IZDEmUxfKLLypNjU/uRfo4uX+/FYEg5noUI+fVmUINk2Oauvg2XlZa4HILfhCM4kmr0wmeEi5WjoONcVcoD/nxpfCGXFZS9q8ehVGNz+IhJdtqC0bV2RqGlAoctdqy4yF2LUtKfR0JY4nZRItHd/PjHbRies47kCe0mSNuDbeJhkPfQCrmoy//A3PFRxyjtiqm6/tyQm/K+HfUmj1wn7fjXpA3XeVvew2HocEtqiyc0kRKJb0D7ae05fPT1hX4WwMeC3Pns1++y1KoH+jlb5D6EDml30brGLuex6LMybIoFLDHMMfpkqbp31YiXqp1yLPDuip/KroEhI0CjsJoKdve4rI86OKM6bNy+lhUC8UeWQu3hnBrQ9xq4CNwxE/RI7rHDU/RFIut50k7FETx04W4D65PMW1Eq3kDN9b6xvXD37+bD9IHF5UkuVF1s1vwEep2GdvkSU87dUpVlv/8vetsk084WpVs8uxA9eJlTUVhiU+GqlTiUS4pSuCFdjtJQNQPpEkU9UQeY3fd1SKTCm9CEgh+D47WtIElAbx1ZoGLQEVkRBGHnm0zC07zgmhKE3BbcESJsgVHvHScBsDvMA9HwuKLag6fISEQASU7I5Dqtx4EfewxOFyH2v6PwWF8zDwNYEAO4cfgcDfUsiAK4zy/PGmrZOEXXA6VpAEOG48F+4GrjZ0CUzXg1u5QDAMv87JWlsnqq4bBUm7NLr6CAojfhvcqXUnhvUGPZEcykJ/KKeXOS2cff/wsp3eIzvF3KbDOAV5VSYOC+jTHhL7Q7UhQ2UtwlasGlSq+u3cLJJciTzN67d+Do7QjdjT0/rWPwqgWfx6NzdCjzvU61NctOFKVstEdj3ZdlZkZ7Qxzr4/g1mk8wIeVXPzI1VJ+jscU91sT4BUXBIOu0NtO2Cd+/b7NW3Ahe8HM+NXwPthn8L4I75N2d5gpjBw/SGGJleJUD83FRkJB5bcK2n4uZwqb3Lrzz/4Mhb1bz1oTiCdxEoFQXS3KEK7Cda158fESf8nkxhJuIYvPG1L5fIwTeIn2StwNzyt770VRpfCec4MW7zcK70V7UQEnB0paKSuVaikhVoUvittBe2E+7GniCTEXbdETIsZUUSTqVa1P/ntwlKdPjoRI4fe5MX6hlb4Fo71QjaIFR7S+ERFiXeLLISFKKYbH4IimwyOnEVYVGFKVz8NhOKKGqMNsnsmLmqiyJ2UVdsI+rRu+05PEc2CAZNoHQTSrkELwZ9BHz2AACgagQLYU8WAACgaWxGhDe0plu+VXD+I0al/dTiINSh1lqdwpZ3Kp0AoCJpAF66W9De/pdmdvw3uP7zRgctKAqnxfQWq/41x92QAp8hzobWsVzrxvLhscbzPxQcIhO+QzbCHFib7oX5tB0nru22jao3VS6S3zPZIFM51MDGaJvedI4bw66PXYIPF7cLSQ/FUiPqlJ1oIj2iEtop0sjZY5VyrjO+9pNFCVf7YjcJhzv+pAVYB1t5GqxEKzE/ZxEZ9CyQWrn+Sand+P9JHYg9zAqIfcud9dHYYz7e8kba1miQuo9NRHcItEFZGgZQdlVCtL2cnsbXiPSkkWmaGJjM50qXIIU5hfPlNDR4Pe49MCfGc9TSDUUuHLiHMqe9uPoKqKVMz5v68lzt/GDFqWkAj0Oru2EOiY7dR/vzvWYImQvkghO27wbxH3YxvfF9PgF9qcswh+8YAewK1irhk2APkj9BCKw6Lg5eZ+5PK+inPRoqvBM3+Vm/Xq3x/G+5npn6YqEQ3UquS+aUxMzl224Gf5d8MNirKuw+sEqWENLEc2ja/GR2yXOYlJKQqRz47CqAaoyBqZqp9B18i6EllLeBaWrDwCh1W2EM6UCmeSDew8UGnAS2EWuIoFSc9ttCwEGZ9S2l2eY0q4yDOa3NEAF1xuFFb3djJZh7rOkzx7J6uqL2mGIf1w0rcmxiPv+SY4mdwI0xGL/ZEm1nUMGfp7uAswex3eTn4/P8WVE7CwDEUtnghZkFXJlWAz3p+gGR/6ixh5TDNNJ7m9Nr5++F56Pv6+YRLxLZqTeLUflgdb66HjI81HbGLPJEiVLfK8b1gBzrvADK4OY3/k/vBwY31IhE1yZ3Sp4HsLRjWyvwVHyjyNhE0/j1DrOaxbPIaJlJeszvR0IrXs3KmiiRSB/fsXFpbzUwh60XcoPCPFhZjCf9gT7mcYVda9hWKgttXwrdXPYwJFhJMxxZz1G74fY8kKxjn+1HPfVEkAe6r+heopGW/+jGdOqRn5ja4ssGODPzgRVttJrCrppQc/HVPUuypa4k+Rh5x27UNW9o1/FhZy5z77L4VsVlrLhOXNStF9NzSDPmtMjQ1w8/ub+SQ71mAFa03x4eObeYCmL8bJTASouPjbVZv29HtwlPs2eFoSKqM2KnvjUzDkqNrvcFb4OZFG8nEtU4QlxcOxleCwc5Ku8hgc1gm3D1j5gJRmxaDXJQOEKFqYGtQOJh1KxWd6VCo32Lno7C1Sti3Gjo6ub2L6l//6iP7lAbo1D7fEClSadCSA8ZD/S0kgbydjW1RnSlCxorIc7yWTnW6HttPwYMuWLVvosiDRns9ZWG6BKPm/n4eEKKb9ygL3Ie8n2Ol0u+0kiSIGVyGi4AYMsmCIpLhPqpcPkh4ZV71WgNG2WeQEYpVpNA70HrXpTF6yyRpGx8rwYPwOEhglsiCxSLHDPvsEwuBZK7EyvdXxg+kCJhaSwN5OxjrtdrvT7RAHPLlV6WPMKOXINeuqzKF/d4UajKQ0Fl+xJp5snqcjigiLnEY1RFGawo9nGe8lk91ebzAYDP65VcZU6Xv5e5UaAmgvm7c3bO81ELWNsBx7r9mco58ndDcPsGZzronpiI63ipHh7HsxRfSHb7P6TVc/QvU+6PYW66Po4+i3LTY9YAuIDON7rRjv3PrYVKdo/tBmsfTBWpi+Ied0Ur4AV9/NU9ZdXoSvybrV31y1g7tqRxtJuEORoM7ZpFwy1usCTfFwlKAwqiHK6rQpSWwN/hO2kVZlluKarOvrYTiC4mTLGD8x+MCzTCny3W+snvfZPCezaGM8T19yrtvdvxXBxz/72c+Cy4Ue+lTeH2Z4X2ibbKDJef+Pc9TxfT0e2OikUVrfjtTNBt64bXQ91eeZRImN2fTbIUjL8+yikn20vV4WFpoXSP+q3W/TSFCl0h+bwkfXxUctXv1q9kpKMEkLRntUQcoInQ2jbKUEjgFGh+GI7sZMMkGnHfQyjUbbHEE6Iy4KtkfCa9xSwdEeaWIknLouuVju256KHEk1wSaSvV/qGYxWxIVXNop4L5kcCq4TuxijP4Y/VqwI/pgR4T/efAu/x1gTfTb6qujjMbraQBvjRutbeyB/4YWNTAIPsqFWxUXRMBPk6nM/+fCnZG5+Eb6G+nwe7XG4/vzw2Lm1MS0i612/+oedv+T+xSVEE2jQ35vH/l5XV2RCpBpeZdDk0GHbp7mgTNH+TUaD+Mgwpvmk3x/G8f1jTAy4epq5HHLjuvwY0/lJTE3JIfleMukGX2GW914yqRmm/ox/s0wAfp7ZiQCjC1txUmk0A/jI1cZYbQqLCUzR2vQFgOFFnqTKIg/0RV+t6+DVil2qOUfwaqQwRnJQUTTDQDNyj7bY9UNCQcY7f/jeRcNtlqVGCTi/jCC3HYqlhtC37x80+GTxQaUQW3hqjkjgXfOzYHjIxFC32FHDw+iHM0Yv5I2UUjY8YMA2m+MGXC7JvwKbDTAg5yZw0UwEoTI7g6oql1XfCovrBhhuws1osb+JRyK0Opa/Y+w79Ga3QwEfpqsLsht0uz0ur+/FVrC9W9dVgbFCmJ0ADa8BwBYCKbrpKdpyYBVt9Z/Tcstb7R2Bw7TXZp4by2cmLoRuW8w6+0LFClLByR6Hvev/XDZd/YPUAK4/eTNh13OKvoGbSdLCug6mDyAn6yCOtR9s3wcxhX1elrmX4/8E/oK3gvkTbH8RY5xsf9HcCObBfdicJo+mBg7u98GgI4HLR/K432U7uyNwWKMVj1DMDE2Y4GHOwyC0JDL2Th1K1pIdBGt4CMCN2rHfH98lYzUGN8P8xRGxZ3BwHA8Px6UDgvA4ZK81sqZ/gRrzKF6Er2EVc8OpPnPlT6EPf0kt8L2A8d+/EvxFtXn/E4mpUTFahUTOUWhh2EXKy6Lu3KPLYmGsnWkanGmh24qy5O2CbkoJkWdTpXdXG+LHghU3SXjPMnKM3XRPa4feRIoIwLkfJwJAQuNRxvfTus55EPynt08BVWx/Eb7GOmQ9jx0dYuP+Ev4qVFJT6xyrmjml0KTUBzG9FhHxVnFXlbzEBEGDuAFao0t6bdpAklYVP62zQEf48U8QntorBn8Jf6Vz/i/hr3jbytIoyBLViJt6luQttU89ymHuIWz2XtoojMIR/lfakQjdBTbaDPcU+35RKX8zsxNTN8GQ9QrZ6yTcbHFkTGCVacpm5jfCou8Hm05FcX87PTiiTmYKZUHzNj5BmSlPf9M5Ws8ZkEkvABzsADBk5g68GfbHovUXWXeKI68BRYGd1HVQdGjbmPeSyR47x+4mi+jFqF3dEF/dDA59mEqH+vnmMEKLBWuq4HEEDvPkwUr5b9ZF1Ic0Y0JP4UZg2m1VNg9vop+dNkNCdjI5riNPW3Vyt5W0gQTNHqzmkRX1PD43iw1+9lxt0YdlMnZezMPX4KsF231i9f9rQT676w981f/nm2Bpd4exQ1MJK4nu/Dfyr296VP92Dv63+HqL7+WjEWIoiOqIojPqzRTsiPL4yiBWWTQrimh4XY6zQnej8LNCxtqvFu1dYzq8Oe4/S+r/Tb+ipLoMeG69ENYE122HM58FZnAzsQszuZ/8wL+XTKoXEIkl/WmJFWtehKuqHr1IPvzl8wxcr5sQ26Qp8bXQNsZfi9owHv7+Wtd/Fb6GpKsJTl/zRETJcUw5TQqOsVLdathS5cZXE+Xrp9+QNf5KpRvBzkg8gq0MFB+JfqqBndPvhwcIp/65b8N/r/eBfx8jxf5vi52D53wbvkfQ6MjTh/oDu+ZpusgOuMzmco5XvcnTgOSCIvdTL8uZpt/NDOm7waA3PcaakkpAN88bar9Zc+UlvFvGG9CoMjG+nywDHUkrM0wzJWp3lHvpwVNik+8HUhT+RaT71XvGT2G7a8zU7tyvf/luGnqXxLZ9sAVzR1L/nPtgSzSWW3xrYj2yFpYja2M6YrE/wmONtq80sDnamoPW+9rVZcECbl2V301TloNn9i9wIIiIy6VRD2mxu9mVCHGSGzNOfHgKvqoy4D7YokLGPthy586CAryziDnOPa483KYxvUlv4J/ak2sm0W83k7FBt9tpY7QEPVsaSO+Xz6ZGeieNIddXwFJJk3Bz0OP4O6Phpmx66vJm/1fhqdpw6qc0hkhUQhl5zUjeAvvoomSBVquUrAHz8GbSBhADOQKAU1g7Ffvgx89Fksln4wqwDiOCsIs/9w7bkd6UNEjANMg3WwnwvLqZjPU6Ha4O6qXVVoujTPhNW/KmLJ9WfhivdkLaL77cLxMDPsPcFnwC1izS8D60/wQ+NRknEJgCC/BmRWUQ6alpRKlUyE19Bf/UPdmYzUuraSyt0l65fuRwk/iSgmvwFQDX8qfgKqXM++88BV/VtB3VU1C7OkKCRUG8kjag5jdNA8W34U3cNxutMOFNU1ITHFMSgpalXn7RSAKpw2CRjnsH3kxM+eabWjkclVeK9zbUm5K0qWznKjxVhL1PnoKvSsEGWYuNp9Oiu8Fre0o3xOMw7rt6/I2Q3nAUxh8HA8qXUwXO3UkMcJ8ULypw7o8TA/SyQbcroGWsPUdUe6YhzeI5i7lCyK2xoD+NL6b6XE8tJWfMnaTwIQ9pFutdrq778CYOqraAeRDepCPrrP339SHZtd3irWZvxs7I9/ICd3YjjW7nTsPQwpT1EyZJWm3ie0RGVAepOazRoMd4fFN4/O7wPB4K3vajMJ6EpAGytXIY0igcDfvUeSrgeUBUwMNmOXdFa1TONYD6Ng3DD9dGmI7w0K7SwrDfJVbKy3JSXVutVjAtvhneIFAeskDPNmfOs+7rqaDT3bV/p1CBVCfUNS7L2dcyGHQ7nV1k1u4YklBWe1UFLwFoO2pGVa7rLrsHPL4RFl7AO6YzfYwXRSVnBh9yibdxzncJ2dsh9/KIWt9GG4GUanTcB1sq2WPRD0+kh4Y9l4MxoaKYxJtV/Zs8t0b2zzFNB+WRHqueXoqyQQK0dhU5m13+ZRL6nT2YOEm/ipe97ur+xopRA6Mo28TSmnjjhv1O6W85JUuwooHmvyOcaJByh6ax3IQ9BYdhny4JfsFNwQodUirszaR98hum34OVHrsa45bZnHmKq/GKo0J716ExPsPE7qfyUGz1qvRIjWLP9zHsL0+ZdLpereelJ6obVv9GNqA5CqMYSypg//7p6f0L45zlnZpFqS5Lruc3GqdvH1aDKvUOqmjYO3ku1c4PS0BupcC5O5nvKgkyKoIOgmW7AjvZsnO/dFVNy8DNZLIsJdbDq2EdKVbuJV7abTvHDtHsT987tIlHikNf4t7SVXB8prRh4ihNRiqkdtRWiUErna75o/QGzxrg2gra9jLyW8pltQWOjORHVE733Vtb4FILXASqiANY0Ip5A8pa5I3DLWlxFNhzmmPHh+xCnPQfkCQpHOBzWolYVxHvxGRVVbgbE0/6Tptyua/CUyVW0CmJLKuqyu0o4ATow/ephRm9BpYja2M6sh72R/Dfb2HDI4HzAsZVe87b646G8fVKoTjD8bItY7umPTim7GAUjtkROeY+/qGCXkdLD5lSCKMUjhS8z7rD2GHYh8UY8Mw+2KLVklUdxyI8N7NU6uGwYI3Ei/qRdeq431KtTmLDpEGUNCK1lFv+PnzrmZNSTO778K0T46CgCgT/ffgW6oi4h5XvtywvK3H3/MQyF8fRSdgHdZBeqavwzefhGBzhKYd9MKocYDROmmX7hH/Qw7APDarKbYl6vco6UhbLJ2aEXrlDSAdRxseqV1XVRK8JJC0qf0KhEro43A1+al287tLTXKxwRELtZJ4jrOF9H75Vln+X4nbOSLjMnWREeF9xBvX3nk/tZWN9GA/+IqoaOm6OCB7fADevj/Hoeo0GvpQo/GNwhHx5bNyrKEiEiD8Pe2rLClFkuEJs0RHdAiM6lbbACK8q5TyMACdMXK/xjKQhIygCIW+BkR5vs4QgVGkLVqaCzUcAUjZ3Mkl0B8nJJDGTLEncT36EJN6X9EFpQzh5sLjZPOU8iYn+M56ohbyEAzbxk9ya+AE1T5NPDgaDJPAPDfT5Pnzr80qSisZhNE0LLEqKxJIZqePYIJR5OQZHdGE+Asc6HdloGoOQxAXpuQTRV9aPrYoPoO2L0Ajsg5GoNTARKG1CwtSKsvgpAgMM1GkJgeYULKV07MVSpnDncjDAVeYynhV85hfmzHJpzgz4BsE4uAVGLG8cG3RlA/ebSWL9hIn/+nctGMwq+LG9rErtZYkFVT+AwWCww1PozS+qJUkcIrUKTpLJ4Dou0YgymSR17UpKVk7EYDw2bYDXa/2Md/8h1envPx9l2byfnnkua/lT8GT1PQgc/UkWepBvP4nx6Z02zoQnu4mZFk8/fd6svkVj9aXlGyeMrAKjcKwosjRaY2sMAZIF95ffZL7L231zoB4VBSeho5QbBDGdzMIjuijtYzrCM0p7WaAw8paEMxnRHtqi20xhbkCmRiSDktYB39fBcror9CjtMkFg0BlhwHtW1NjXBSu2/Q00vScb6MkGWhs/uToO2mfgdDy+pIA/yasvTmYabPdzHkUEg8HjCnpyBiwZpJZVuj+wIKTx8ZJPuh+B7rf/vddHxqGtqcPj0A5hrh6oscTTESivHcfCn5zAeUxL7QjjTZnxtkMd4iMwrTc4AtMdC7ZY4FfoVIBjTzCCtrnscMHl6ZAQy4o2WULao6pvXhoIFAbE92SLA6Swbpv2IkQKU+JlFkSx8GE2F6VOYFeWSFSQZr5lNIxBK/R6A/ZAiHUI5r7xu8N6zLn4SITb0N4I05AGrWNcrvATsw3jz0xLGc82jPMkzcJlz8h3NIt2HNp1lRoCCeM7TqGgJKyNwnjbGKmPJYZ0jqUkyxsx/42uAZhcGhZwQMnen+ntQPPEEXs3JBBUjwTIxJoON8j0zI8TvsxzmoRvkEV3+5vU3honDPThCLfD3Ka5NfH0EJ7eEE8P4aHG+kzGG7DugxE27uWBxKkavBdRJX8aVb9Q9soz0fJlS+J1VdZVgYTMM6nubyCnfV+5GsuCgcexxvyk5Xt4VXQkvt/weVJhQlUy1E2oWF7fU7NtMc03z26y+bm2Ae7f54/JFLd/b6rhHHXOffasTFildgGDjpzpJWFeM0GcQFBIhBhdVudUSBRn78z55acLtGiO93odLrXnJ6ydvbh2o9XwGM340lUki0czvsokLPwYBf2BCObOuf8/FCVamKa1AM00HGF9is5YQ+G0crAjMN1t2zNZzAuKaPZGYDKAnpTNPwLT1U8bM572GJ2Gwxghgp5Gr27iG6Rqg0xE0rGG3BHejTkSuWleDnj3Q56XWTDJJCGKwE9FKrtdlkMxiEFqeMAfMnLFyDcSE2L/ajzn7hb7IxucH9/oKQOJ13VE/BHgpUtXfFqTuCb4KIyHjBNUHf345pAKIXMFCVmG0NaKy5AGrB7rWNIL9TmRKDtt3tjB05RxwyJNZdGSgjtyIvg2SyNetmlFt84zCcfn73jBLSxqWS5nPk7MrdO0sXThQhjycvthvbrbNj2Ep4fwhGmrYXPEz6QJN5CahIdhn7oqxYqD8SteiMsl5B3lNonqGIObeSGb099MEtqbr8B1zMQgJlzWjX1mVMq4LD7B8VUCCeIqdeixEOrj+9A6cqRDSx2ElEded6NEcNJ+Rzwn0zChO+9PwGH0TXANv30lmdLRo3FYtw7CPpBA3y0w0uu2dGGnmLec+0DeB7WjYG5KxA2aYgBMwuFnUZbZ/77RxFv9s9nvwf9ikR+RrbqojMNWF8oKe0Bfgf6Ga+JW6ERt83jrEDZH/NN08GE69GidbrfX6/JzdvZv0TcYLcoaXU6ewDr6bsdglHe6P2+F2lSEWi5YzICCFf1lWNS0JDZh3flMh2WONJUxgSBN8Z0xOXmiTcn3NK/wBAYU7ON4gCqnhazrXwitSvtgxC89VMRT9HdXoomJY7y2Tm7eSDayIR4ZwiOr4od88xPhIRX/t8BD6KHCKMThqEP8SsAPSWtiOrIe9kdiPNyINeXk6cCZOBiw5RjXdf5sTNQbt6228ds0sB6JpuFW2Lq5B/C3WPVn77U5N/wAD+TG6/VIfN4wmY7lOH5SbYXO9aB+bIUObeXiJ2KHFWQWLIgvuMEzAXxHmIRzP3muBQE45xUa/c6YBXVm2Jz4QTpDmJri2G8yjDsb4CGvS6f2pFvQi44yJ0FpqBM8GywacSjeMThSFFVVFcSYjqvB/ggcd/+QZQoGPbYi+0U1V4F/gkQZv/ZOIGfjwsUTMK0ZcxOc0oWxsOLWK1gNtsErzLLI5PgQB+kX8/AQC+K4V8gWmf5VzeuoVHy7mSRBTQlWRswmSuC9EBngwTs/aTelDC8r+oXDdyGKHB3Yam0enUFPStGOQsehVz0D7Gvd120UOhqsMUoEUlfFTACu25Iz7ZZehjJ6jubdTu9LrRYV+xqFYzZM4BiFkeRqo0uzPFfLxnFud4uPrIGPNY4cM0dWwfP+FijClXVNchJaEt8vZU07lRc5mqEnWqFsvl/TQh7ZPjjc7qjQtIdPEBDGhwBXu16PqAWjd9DdPQIPcZhuqpG5KUfmtrzcBEMOCKSQm85ZzD8VnVcc8WH+rj2yhuSkQhMKuImhwzSWdv+PiCipbjgswjmZmH1YvPuGz7Q6Pgfn+JkZBXzOIsLRG/gpUru6pkkqRH2bmQ57enO2EZbNUICq6CP1HIuojdrx+8LHI3x8bUzszLlBFyMBlNJJz4nRdAOthZvXD99P8PEY43ThFDsSAf3fFKaE0SESBb4P9uj2Antgn3KmPbBPLTA4KYZEQEf8VP1GI8JCOSKzKNBMUvbZVRY+wXm2BvZUH+P4yGrYHtG5QxhJ/5/aScEUdT5MCufcCzgpeIeUDSaFJ+dFIWsi5mEij6fpRnhoWuOkgEjkoChuAv6zaIErxw3wo8ML9vmCHHmddktio1nXJTX6GIzqqnoMjnuWFwDdq677gcimA9Edj4zRG+Pp+8FI1HXYCXaCqkpX4uchixw5HPdoasMe2Mc5WEVg7hT9h6IARwVJMFRqymiNBQ4eIar+ovxeOX3M11fDQpUWDWOSK7wE9XIKhkB/kCGB1mgTyEkkzcPmsUE4S18k4WxXp9UOjpWwU0hgmiSpHV82Culx3nl5Rgf+Zy1DBW6nBf4GfohAbCHHYboOpOd10GCAnYYJ98PUALTmZBmOIhpGOQz98GDQ6/X+KAE7iiVzI82oGsmCNPQQjDAPQfAIbnySpZKmXJH3kaY8RT1rbuK3Ygtn0+IpR9bD/sj60xi+3jzSV1Nq1L6OLUZ6LMYWfZ1+Jj4S47jN+xv4xYpMjN+Cr6v18lvwda0F4i/rSSrwIpxTg/Yica963ACP+0adspaTrQ1LShOvaWlZxRLTcW5tyw1OhG88jQItScFkl1Har0TWTc4b2k9yC1oWnLDAGnASV9c/x03EgviwVkuGsG8zMBNhOpJsvvmnmdFoXQ+6Zl6rvRJnb1nVqP/42eu5HuoY0zCh7+bnKIU/owZm0jsPg6kRexhMXORh2q+GlhQ/ezF7lgULrfiFfhHPjShI6SG99UMwoq6dh7yU8dcsnm5gYLnvNhKhR5oYGYsTB5BnLLQvP8sSmRUsuD8ytKv2et0vkW73dfT8+8+Ygiz/iObYV8yEk7sNu3m+FbMS4gpNrEfWwnIk5h/DmBebCgU/ZZqrGJP8fDTPgfPw3jEda769LnC40UoHRnnznYy8NBxNidOypHDlfB6nHX22Kti6db8HM0ysIJPH0co1A8er4EPHBY6zar1IktgpwgkptIyVIdvuMIx5oSJMEbxxboGrS/W4yJ5fY0OY2kZ4bEM8NoTHYB/O0YozPY1GXJPQFPYQZI8Lv7UX/ge0BzlFG+A+YxRQ8xCTfs2z13+qBX/mEUq3z6n0TlVyOocpP0QuTpTgHt+iQs/tIrcSkMOCMn4MTnzPC4F05gfk5jFzbDupt5R2isU2h9RPg/7XjSTx+/2QNSfE2o8CVFV1cudOWpOAy+hk8wAwWqNM6mrxPSZhsSlLSlxB2iX24YdnBhLMAMfLZuC48lMvj1GsPrF3vC8KWhNwOKVarGg/GVPxUCkUBemxAeZGizzmZ1UaUYt/0D3iBoCczgx6PawEgvI259EgGUCWcSYw5uHQ85AR/IThoa6QCkxh5OsShzSUBWIbwaD7T/z6sG7o+KYbNMygMYZhLKPoB/LvcBThrYIDBEYBVJjgywoLcL8JGvnPayl2BZgalp8aejdhZ/Jp4gf+8aQHzv2Q8+iPAbTao+FMkmZFkeNmQEyU8F0PZgC3WyWOPAPH0feBxHIcpsn8ukwag1KbF0DC8j8R14ye6LYTfoLDMMa7mKbzno+h/Re7eh/sqSvJFEMKrXOwzK0S0OlQbUOyCoY33Qd7BpMGqNgk9I4BIETvKZlm98GeltlJYoR3IKrmvRiBBXakwk4WbMjK9up+bP5rfuLC7VRJ3iJbVV6xPbLa9Rbzwt60peixtR9gGN/Lp4/K27oZCffX4v4Z7jGScyicBe2NgHs1o+DZMZMeeOL/Dj/ES/BzPjdcxk+kcAaenmdGkGZ58T5Py4w3bk9gpsxl863jXs6R4OnjMKNxWccxaKUgeZ009LrMWbS5HR5nwu6uynM05aqtE7pn72EYQzUcbVVjYSqP4YSlvyleakwt97KKZKQk7JGYBASU3WNnIjq6+Tspxoztgz1caI6/Y6Kv9mhJyz0YL5lR7U5SRsJlFIGOTj2/WnGX4lQWwa8fVQ8O1iG1CWmlYLLsmMrBN9fGxl8QoWE8j5bHIBh04GHlmh14GPsmN+CzxAAMUIaHoaMbTnt6Lzhf2NPRqSzLSkfWIbDaLrjqLQOwyFiqgFymDN7o8GW40aLI5VmoAZPADJmgPbnNwHG19OD6UBWBKp2Ju5vgDEVyBIZwFlRQK0kOP0zOQ0oDnJBaF2Vuz/y3BpyvzGWuJBGIAh5SS7xbkHg5roJM51iPGn90D+xjfyERrwtRfCockVasOxV7gQosiTZXG/LTeOWZKoFmGv3EGT6PUBXcoc3etB78vXxij5O1TK796dvLiWybhtDYTdbE99v6a6uzmzSQvQKvNNAr0bEm5iN3URqy0c3R466Nwwu8Yn8OMT0GPxCuY1X5PqukHXiYOHqeIuiEBAdc4WS+yewd/LtTAfzwb7K0P2SuahqwYEMMG2J7BOIjqK+rW8KLl4FTJzBTY2qrZ2DHYVqd/UGiZJ3HZWngGIeLoqprrl48VuaUDILTmve7z2Rah1pme1y33UrCrIzFS4kiJtALoNvt2Muoepd1qeUSqc8OuhF4JEc/t//Rr8Mruo2lV2PZ2I2g+4ZjcbkDD9elnBH2juEGDwP9PDKjDjysK6lk1mBgGECn1W632ztJzuAB8ColQFLVjoskiFyP9roZYHmwfhoB5fqwkE+7crJhsTTh2xThiBUTJuKtMiY6IawS1VAZbVJDiyLWSV2Fg0W3Zk5LVopUBYg0y3IZkmiwhhhy0WdLieZa7sH2oPGetXAQMyi55hEYCWXjPRmkecEmpUU4x89c4PrP3VESGAz+3b+AaK+1jW1swxa25vmh7z8cnX94GDMd/jiNpBEi14ehU+SFc7UjmaMqizTLK1R9Ewj2B4Ck5C8h6WUs+aG46lkYpWUdt4H/BGryaKNZTsrbELWhf5viRMAQWH3KUltEen8T0eFvU4jocIsBVExljGRKYjRjUpaE6mvtISdUjgxgRH3qfuRTyWrwc76UrHXP0/Hj3/QcLLJ7C0hwC4b0DkAaqhoAJPn7BZWX9P1G9wKcssf1R4/DtE6y43FW1ITjIgm230qS7LvbtEMgzUtMdVVhnmSbMf8UrVZrhMTvSLZxdRnLNpwAStSP23I/guI3BlAgGEhUs++dnOWuDVfZsDwvRngxwjZ0xWB/xKJhDIsUIxCk54ehwwVtcJV9VAv5dODRn2cKkLYDGAnd5rlwS8YXINF6v8R4U6orNAOJ1iJkozStXtMwwZXR1bcaNDfl3IdhYjAY9Hod4bUQ0TjWcyGTH3fkSTu+f9YPZud9zMLG1m3N85u7Pr4//h7OHrQkhtlD61VJlMOan8qh60pXG/k+VNIylNXAdFWT/vpDsuOwHPnKfbXwffOo5sE8/1ikTYLL0qwcntjOwSJntkK/GYMVG8VXj9kKoS6X4JIGv8AlPGJw4/y55vW+sZxyvq7Kquyz+/ZhbI/Cowatjh82bXP40dXwPP4Efs6j8PyoskYEA+f+ZXsM5yv15n/8B5zJjq33uo6JglvkKa88zIJLYcG6ZwPaPCUokSevaKEToTxIUElRlvcTAvzw0pIypvun7Am5MQToZj9KA+h1u3qmrqKJlCoLppBcD5gFE1GvS/vDc2EYD93hlcYVrzRnU0TUSseL8Io64rzWU9GWbZ7CL5VhgUPKdCwoPQyPFlK10gM8gVkVYeRT4uFa1KADkKd+UE/UNPJBHfEicuUHISV1pKQNZsmAkQUy8OMbBIMJOMzFE8hJ6CIWXEb8mPcL8uNLrzCYRNDrdTqd9ggwO6xK2uJaF9JCRrFHC+krsIgCQ86AO6TiqA1xNp2DS6E0DfXbZ1yBInSiw+Xu0SoIE49ChwOwSKQr6IdIgSDPKHeVziXsKmProdrbfrWahgne4SdTASSjCmMTmgJ3GMa0fNphGOu0W60tZh0r80hWq72upUackzx9hJCoewe9Fk2fMdU3vYye106iHPfMhMEagUdSC9iymAUapdm8CK/AiRNPk2sidLyXrs7BotquzsGl2tVcS+1heLSm8CVDo/gEj3o5QxY/z5Bq98zjj5+EfiNv6V4aBKbWCRqifh4dwtERZoIUU8/zomKQkFud7OozcFwj/L0EYyeJo57GsL4gmvQ5OVCTClfDhzeF109qjGWSw0RGtWQOB5rKDU1xDbwx5979MCa96ld42Z4sk5TPPcD78CRMYPTZggSGk7zXRZra0+MdJPuNtX+xgRYbaG28uDq2ArIRiWNxF58SBfZzsBiqsSKvLWUjL0+taDX1I8ocNfk7Snh4tBMkXE+6H2F1G7wMOPWxRMrRvX+8uNuhmqVMLGL4JT82V2acoClfsOFXRFfmm70ua4t7YKxlF0me/sg396h25d+UUkhokrpcF5JFjafyYIsBWq7kHCzWZWEuG/QUjJvLLuFfM37BugTnOt3BoNcZoSlfcuFKsyzBCewqtC+jr8UryYWsKgnM1MErfhxLqWi4Cn0/oX6bDCX5pmHi8f07t2wRkEeRmu5zLsk3QW6cNEtTwzezkrp3IHkre2wUmO9erIRfWrPFjJFE8RW8JNoyPLA8YnrHVbJzlSw+9SdF3whvIsJZ9KhzG2Ek7g2wHmmeJ3rN8yzPeI2rWW8if3dLdskP/gy2TpJ1g9c491mhmb/4C73p/QpKe0bc4t1Oq9XC1J1pmPBiBnkGPPVXksvkGQ2Vt66VxtOcFi9dbvx6FfYs9COSUmkFGgTygn4X3zTPJcT2UTYRV8zg9dnQQJOyPWIGjocdLtjQoHO2CkbMCVRegaJFPEjfx+QEeu13JfJc+kAIkUK6yIA31mq1O1T4it+UjH4j6F7ZBcNJOVH7BNsDwOzDQOzU94F43v/hMOI+Id6ShUCFc7BYFoZp9FqG+DXOWddZtBM8iqJ5uz1C4GMZUoBHVTzi9TdwUdx9GNW9mf9q7Tgc93rx8ftsM3eLV1ch4geQ/IRN4LV0k7v9iIj7K6rFqtpeJQsZl40egz1pVupUzjhyREpGCQV+sgb+JMZ6ZC0sR9bGdGQeyZ4+C0jgP2m1eU+vm/DJWFgEAt/3ayyZq3t/FFZFEpjBGLITAwqwZ1oWuJ0WiDhi5o6mBfDCUVdVQYtnmnFIKEBCJtSy7HPAtbakcUSCspMIrxqqjZ0U/uJOi9AwZpKgfBtLEsTzeCNCYPpAbpjLSnwqS4GXZa/DcSTeCHa6uIeJ03tW/wncRG87rlY34RMJjugLxwqPqBxO+d76+JP7aRTWVxSqbbLhOUfZKIRdkNEoPOWriF/lv19dGzNibNrG2KJXAwfu/RNmupxLRUyX9w7O2QFRYaVaHDeeCDhUExh3toW8BChO5ag87/F6LIQlek87eOzCIPq7+c4OPtBPxC3PI+rc7fJFPIOg26UQzJvwyedUqjvjrpblxXd1cED4vqIQqLofv7jpwlWw6XBpT9wXfgKewAmL1FqKXS0sgwnAYDAY/IuEZVoq314RCy3CHgXUuxw0gbNCpBQv8Qc+Jexs4WkSVotAhyGlpaae4o7Cnjqr6v8lONsy4NUsQ9UmnfdvogFIr8IT7AYmblT/tig/EaMhZZ2xIqx7tkzAWM211P37zFal5L2Oweyg1+2MCND3GYPZcQP2aIUi4gxj049TASrSNttMbmHrTk9uVWn6QLwRfvqdhbNs2b3EyLZzG+JzG+JzQzgcwa6mEfk8m8cnCY69S/BqbQfBoQbllx7mCCREvgpPqCvuUYAsBA0EI0cCfbjPj2X1TXZ/N5/mAyT39BTrpArFq9HwA4pHKSiRLIczTXr1h2LnxmBKJ8IYTHkC/24AXLMBAW+rQARaF2/1A+i0k3CD2cLcbRYtSjgRZoWHjv9zOkOfSQYDNnQikIBfBB9ysLp8Z9CFvriTQjMuplXxLP7+WIR9a2I5th6eZTcWW8gqNh/Kik8pC5x1cQ7Oqgf1nKf9mrM+z3puJFEtl+BVtDwXzHPQnou6GVI4b3hMo/jbkuJQvGSRSTICjhXyGQGDXhd1zTGYatsRKYplAzI7Vu5WdgpsV//KS7hh4MhexgNXZXYU0ZYw61yPR3EPjGgizQg8onoSdVWZGYDjbTvRgoEFLDzDW9pitGH7dE38KTf79yq4739yrQ98Gv4KX1oTvyU4epxPG4849MC0wpUSgHQOzqaZJbB3Y2rjcOizcOn8r6qSyOgSnB0JJOFJj7dPYdKj1GphrmjY71vNSTK5ozdfF1OhuPj82r3or28e6Tfqzk3gTVdpw8ebR4bxRkcc8lMWAiXYbEINX2MwRbnpvnOn/LTM2BozBrOi9tnp8lszq9K/lTO/fjePL6vnkfvAWxzutgdmrWdjVjOz98BsjsyAATqORhgkwWy0Bw559q4gxL4x+NiCH6DAvlbs054hTEcOmXa/+BAcMr6Z7G727b77T2NmvzU08z8dwp/O+4PqWPwU3lIzjb8cQI3QvBMbLwJZptPSmkzPfiZbMdOE9Z+/SUU08vTxU5ywGJMjE9ZKrq/S9qd1hbM3FPrd8O2fWBvDGYvhDLYhLEeamI70I6stsMfpbvGjKoHd68dobVlBE7YtiQ1+wtZ1nVoxh5ZbAZ+17Jn9Ar7De2N5EJIU/N1Ks46iMbkq3+LZ63UWMgHNuh8/91yYsA5tsV6fo8tO5UVVhzNkpp3FnAkmMJ69ZPQdoTkqqyWBXofAIES7jsAhjRQbgUOq5ozAI7kFjpOh5z3V92hjVQJkm/YrOZF4+n4ZwAgHI38Kt/1lVIH3U7jtPv+cwz49cN9Bcy4D986fjii4A+bM/x1AJ9QdFysUyjkYR2dWOH4D1CXOYWj0/0BKMU+ljC7r9XoYzYtgxgpkOy04udWANJLb3kmzeJbuUvBZbs/cLniNxZqm2ImoUoqH3KuUaMTyD/oEnFE/6RM0iXhCnSHsL8EJeUZRdH59fCbCZ9ZrpKwWOVcxHYOpIsQ5TPHilxdVf4MYsGabDX9NwRSKz1P31/p40/tpU0N4Kjo21TgyFR+RGQsSfTDb63a7fr70G2rJoQbeG/291+C9Ed4b4b0R3rtem4dDsBeT6BMIjKHX7bQIaKbYCBwqcwOwOGkKhplguM0hdsyw0eWQLl2ef4RFjSV0qt0cxPUykupL4R8cNv4WfOp+/M1niU+9RYIxxa1+Crd5w0Xow+3QaA2+3Thye/Xm3GrHmkdXuypu/aYIEKT2T/WBmBGvelt7JiyQ0ZXxFfH3veT7abywWTVio5uvhoe/sdFCigqHFywodgCZLm1HcBbODUJdlrMhRjEzHJi0Dz5DPmM58w3izd12m9aawM9TFIDOqeB6Fi5VuEUMm4P4MuSmlzqcfYCslU9knrW+2umIAfYJOFNVBVvRJ2AszSSyEoV3cbzP0or/TfwOg5MCBu4zfpww/U9lYcHudShGYdbmQu7VObYH9raM8L5Xl5c9sLfTHlGQ2TMuAk68zh6EnIs9sHcQXVaJlLAXDsH5Iv0HTNA7FNzJNJe7HSMl1LhteGrnv6v0O5hmoWL6of8K7ZE+awgj8Ag3PrEufmRd/MhmWhBzcF8NCn5ocb0ST+JJi93EZ+NoXU/vv/xBEsn4Xp87C5eyXNIFzopbxHNAJnEKGn8VLrUC230VnkCBDqffE3BGC4o+4aUEuXWQfcm5NNHj8vUebC8CUY7BdnfbgrpIFWDuTqbAtRIB/3SuJZd9h30echmGy/fDgi9trHFkI7x9Q7x9PexFltkqmJ2m0KxYUaHeKZqxHxcqfI8sYenmPeu2vcZgSOvwbGP9HsZkQNy7LvaNV2+c5DKIe0OsTq5n6jKTM6xe+jM//KFhDGb13osFUXvdnWFeUywVg9Qs/725IDIs6iJ/qGs8WofUbDQCh9TFoCyj9j/6CFeizXDCkEbKoapUa8dT9Vm4hKEgaCZlev9bVmMv9Xq9x2eE3stSylEhiVechzcGE7rb0xhs19z/7Sy5otDiZTbwMm2Bgz2lbpZZ2Et+BP8Ee+EQ7hdRMHPkqYgs8BGNOD4k4gxp/LdJZvmklJJ+7OI7B2exviHqbWfhUs06obwp76L0KlzSNe5VeIKLGlb4ppgcxxkgY7rgjcHEGz3KTMTXtsb77S7Yjbfj4lWTr2oKZsd2dXsDfBzfBwl5PbEP6lDg2K8WYfk8BHuZudFra3AZ9QFHOXwKtz9+Vs54iQzI28F62zhv2nUOFjX7CNf5lMOCA99zNa3m7R1JAi12t2FNwaoS7lbXvMHCWFVLAUjfB6lIDdthivd7RFPcrJbE4dGmDZboTVNyOR6CR7R6wCEpEebFARREMWQpNdEQZNJZ7AZ3m1cWU6lscAnOtqReiIw2CUt+tMNgvQrXNdznVbi+bM+4+m9TvSyBZEQohBJceS7wBhok0ajLcQy26ybr22FKDQBIFOQ944mBg52SrBMocRamOiNmlui65BlKp9OiOUf9xp7JT+F2mmbvk59zMZb3FhOvkUQqe5aTWIh3zk/Rmomxop1dwNYvVERpMaSN/sj9d90PSIYL2/UgCebUb/icKShwHxd9uI5NXNNnGPsj138XrQ9nGr7yJm62ja63avur+BprK/2o2KPn2nl+RDaHui7PG6lBiN+Dj1sKlFyRH2UQSRqejsgfs4HtfDMYvgxfXg87Fx/xtN/VPa8moOsGJwx435wZ7LCXgQFtC0o2EFHgujVlTDSOrI4nNsR0ZPu6eDtsD4IXqcXbYQp3B8cJOwWztC1cTmpLp9PhKeZliSD67SVR5ydf8Yv+Xpjt/aGEffsFj7yUtEzXuWQffsqaIdYYW4QVm6OzUoadjxZhpdeVrZMp+qeoWEVcscazFX+nHDhZzR9ohAeshCOC5MjKg22esay4sAnpWVjphue8BNf3G8B8OyO+3UsMdyYG5pnrdXhVd5y6DmfSjNaBeZyH/nOqqFQy/9gvI3IGSEdlmb0u+0zZX25QejgW4S/Dl5t4o7bR9z3tf5kNBCFpoxtaE/vvdL84PA9dlW364fcnwnM08faobRZv0HzfbJe+UfCOgkEPA5knYPs73Z1bhZdsL/vKWLaTZ/VXtCrrej0Fs/XJ/fshKCo1bZAeFJUPMwPELzhFN966C2e8bgG8F5d1lj/2wiEpWPhAfWf6SUbWw8mI2qpIGfioZmfmIqwUlrH4GUHBECuw+Ow3M94QZBFWqrA90iKsUAd4drjCqYRpmvajgu5U1D0+shFeaRxZMUeUKemRBkY2l4VqXSvBMYGGrBX8M0lA3Xoc9XG23UKPEvEf69a7Tq9ZLHvGIq7ATM7wXjSXRMrZakSewY4Aep0gUYb8Vi8rBKntDFwfhHSSM3Bdk2C+DF2l0C5sz3PxX2yXkkvTtZkWHZ4JwfM0Ads/pEoGNdO7bJzIYnkQLzFsBSsRHOKlKwHoJyOWeDwpNXEcUTGM9Yg/FmP41GNqq2MkVw0u40WxLikhZoX1y7QfVpeYNNbCi2Y9eiBYBGxdbq1LawWtnjhunihxeUrBupfZV9UWueCSF7CXDR0+FxOlc59nlvRcRjTFixqSkevK5qJd2J6FdEEhls+9brUdpuqIBdZ1leekixjjKKoftZozKTcthXkcJzRD53nfDrRzxjj/BbWYlJBYkpE6lNf91HPBf21BqSAJtrxP4bbGeZKlBMdKqx+YdD57hP9e9s0fs5iOeaRHmrjZmmc8hS+3g2Sj5F4g2+XqR7nWcsVBPAcreXbKiH0yVLDC+uDkiWWkQ6KIsjb8kOnQOdcOdNhuG9KrXwHe6ZCZI7mUhPRe9nc7A9d7vW6PTBtn4Lp6u5GDQSpGgi7vgY46cdc5qVSHwDPeEQW0gd926Ook2w5TGtbDejQR/yxMdVkUJ9qtJat4L9rY69rVVX/tOHrnkp029yPZ6Zs9S5h53E5qgSc2MB0bXrT7/sbDP6NHCMn/9cart/i8PsrwD4cHsD8e8q7W6Qem8FVyYui/zX4C+8gyvz75aakzMRnhrYqEyyCBGlDcqXlef4c9T58mIz/Wee3P1FVVZDDkPsQFxngveckJProHga3/UtU5lLoC+Lyah2UpxpDiVF52XTHtoDw2sxxmvHNTzAs8YJFBRTBYLvphdTpntDpqTUzH1sf3oNudgxUtoX0OVvbYddBzqROBM5FwhULkWRaQ8z6cRXuNDY4XG45H1w2m9oAxMjOKJquq/uaUu9XUu/iIYOdI3dK5QAqX/iUK2Jehm8QMFK2nNVsixMIodkS0GqNx2azdalhlO2JdlanoJXxmL1nbMZmMp5hXvGmKjdhpWVjgnF1uv2ImXzVuLitDbfxPWTfBnWRkKgOMe3C7rtiZ4YHOF5oiXCTaTxGMiqQpspy0NEZppSyrssStuVbiDOmVdzqSYXQOVj422egrxCUK1BjOkaRJqXa43tIeUUyulCC0ImFJqSgWQu9n4TpLUzUtiry5Oa6DbTOMX6Y/zy/TmOIylmbBaUKxP1MwS16buqJhDHIwD2OV85pGMcVpqj2K3h3u6/KU7XjMwgnAd+/tZKQdSsveTkZUdGXelOYl6Ypa1oSUwJ9a4Oqfk9qF3/kppkLJGRy5FRtDRtoZZxWd9eJuWQbdSKs5dmG7hvV1vejql3IWXbOCHAPYVbxhZYm9QzVeWVotyFStRI5edeTQXt0FpjeAzoAM7Lexd3hRQfAhPwGdqdGdwjfgms2e54c5t0jS3o4Agq9oEZZ/Buayr1hwx7822uHxBgPWHD2Rm8tWdFt7L8VR+gERXxHKwVyHM1kuhESaI0tKXZb/kfOSNZPvNgtTLcs2qrA5FvYbFgUs8bWLQnad4HlK7iEilzznoV/W2p30oFmak7NpBW/FCtF1FWuDWuzPeK0lk52Xt3MUKBodPLOjVPsyTIw09pOwJ41tq6jVPhI0bv/UnDdS9hti+OJa4vlQu8Lt3rCgK8N74y0P4fhHV3uI+2jzcIUliKxA/nplMPiDcVCTEKp3OFSLSocyf1MeUT1zFvlEGoAymjDWnjmdgeuD3hwHbnRhOxTiBd5ulzyU8sXD5gV7djfTNA9n0HMslZ0PUTFn0JmdcQTl7WRkMJDq5bfRo4desXnsSuacvkOWe6yMa++garEIV1yv16UNDxbhSjQxabkhiWax0zJdlZfk9cQO2WW7irfXoZmQ4pMW1Dtt/0F7le8dmPGdg351mVbcO8GORDIAdzzOkdTPzAUCzzl2H87C3jwv2OcgcyT0G+0mlQbuhsB3lUa/LMMVMh2l1DtFyBv1OirZqHKW6kwfuDtFLt7mFc3nZAqpihSszQ5XhZVBr9dpY068v6yUjI4Vsv+TXnsdVjRx/TqcQf4hfI87J2NTByZHk7hUZCoUTQEA9/Us7C0KE4+gWWTqaQVmggX7RpfhCq9RKdIOtCQD4Qos+ilCLgyaTOzPWIFFZbbYBxQJhkCHRDokZVmDZCfqquvcUxn3QYsJlrXxYFxst9udMZUf5dbbYQrTZbAThb/iPEVCSgN/nVINHv33ZUU0uhd2/yqc2Qu7xZnXN7GwFAz2RePdcZuHR+BQhxO/lZJxN4TbyUgnpCAswxUqFEH+8iv+/QeDr4wg+Ha73U7U4ybbY65Q3ETOlpOVlp3NRXDm8fCQzHodVrTiKA9PlnlKPgPX3Ts/YeHAj1ULN5qEPjmNjX5whv7T/0uT86ZdhIv2vMWMLjbQRfNNbDJ7OLzJCwrse1ZiqQpjeREfaLutEQx7OeoIF+P9aTpMH822H/abv3zzI2rxfsT7G+f3b6bZB9gdfsDiB932Nwn0EOzGLux1thAYdJgmefGiaJVH4FDgEkzHzD+ICXNPezZDgVPEhGvKjy8C/1hA9kz84zMxKBD/wA3or8NKrye+a1WAkUAv0gypmKGSdzXloJbULDc8yULMrnjCp9SIOQv7tVqGibkzcXh3g2kcZzc36s2GNOl+0ul45kgAax76ft8Puz3tF+hJ57EadNs4PLt7IdYSF0wWRP3whFwelItDLs+y5ywYDZTxWKXWlyYV8q/j8Ay6uKPMdVhhkhCvdqaAdKpUAcUJn4GLmk10Bi7SrcV35J8z54HjvVw5PCt8ZwpmuxZwkcGSB04XzP0si5fYVRAWv92wX9eh3RzJhmewE9E/S50o4lFf43+ZkYX4YIsNI1wFW6NijDb+4FiVodrFMlzhypmpin4z/6wOkk5Z0l4CK1psFqcSSsmoea20rTiiXeC5axBuPMNNkgREwPOz6pRV0P5HAZox58+EnUTRVCB6z3abPocDJ7W/0GHdAgP8sC0I+A6HjviRD2TttelAbuzxriVskV9hHMmAxfkMyaAMzkUd+ZxHvteVcLXdiZ0kXIS6pI7HSnwgM0bEkSuwiHIbqg2LcEV3UPS6SjcJDpIrHNxIE4vL96bC6vxYEaujXDEan5VxM2Out0PK3Rm4+EmesesEBXAZxu3QBeOE6HI9FGKPfoYFPRtFVyN4ZSnfYEr3YAojV5UaKynqOP3mqdzGHSB/3V8WIpb6jidrSWk63neV7/hetx2EoFxc+MG6htq0X0lCHzCroiQEJv+sEPHoZ89qX7s7d8YVfHuHDolznxT5qQBwA5HC6FHm1gvfu13pZbeLZXtZTme+8k85THYRrjxmTCwr6gFbgeuUH8mmDxKySY+6iIp+xctXUSyfOLHVGJOorAWCOzpYuiECDjDAif/sKZHORJdxkCpe5jk8G60cVZjOZLRZKpyC2ZZlsJUnhFMyzQZdtjlNwWwZ6lVNUU2Hxx8/Oc5EIUU2cQKWxU+RRpkp00qwH3Zk4db7YYdzn/3wBxCAc4MFz3rvZ/30Qs0OFmxEvNmxObEIiZf7UIk3zUsMct09EIbk6TWokky8nouRGJQ3iJcnlrAQDDkPRHWCdPhed2Ii0BHvnkUykZouVsS5TmvzGRZ2iJGrQRvXWel3pDAc7UIdlKlonLmEpnjJhTT98l4GYUdo+/m/HY1j6+HZGCNJtAOn2g87dLvaHbA7S4FMyywGcUGTQ7C7K4I+We6pPNo9BDjBP2pLXrboXjAPfFlARAVFTkxHTQAoJteVKHXXK97pWFRmFoZxrHFil7oOpEXJnukB7zCzHbpqhPADn0u5GIwlq7m2qe/7wAN3wGynI1YmP074easMk9S5/4hndqsNbjfscB2KC0eg3ETX3EykrS4Vnz8EuyMlQjMk/jF5WYPHl+FKEYhf+g2Zm5d82IAsko9yYV2n/ewJZ2T24BJzHc6c3A9hzT1Dpjrmz2ok4qlUCbOGVBZ6MVrj71B6gXLUPKzTs7Ajo3o+KfYb6i3oPtmN9kGamL4PGnUg75JSOYpjLUzxHeFvtK6uhdX66vs9z5WFYRFo3E/AqwphfK9QtUeiI/IC8IsS8RZU7d33bhgE0Q6eeeYZBrdLmtm+q3nLPZJOTHoCLngkBZE/Kg+ZHLrn1izsYEmFurquMFGGCLEsKjTv9zWeixziMbp7bJNlFZu+XSWV1mM0x9WVaFi+d4Oz4QosUrcxN8it7cZaLq+7EP19Hc7UnNaBfag7FekyULM8D5Z264oMnNS7XBe7C9s/ezfPsoI9HDrYnDhEmzWSPM9Pzf0eSJw3WyP+rJ73K7D8nwzpLHvNtmInDdJgLTMbOUZOHhs8Uz19goQtLcqIntUyxB5d18La1ylFinbGQ1sBlkfM+lFY8cZte+z3punexOHI9sAO6FiEnaNgYc8mog4tDUtWsy6zZOnQWbXK4uqXKnAhYCHw2pR47YDUG+rqIpfoWi+c0G+iLnlBkzeuwAXn/vV/s1VAb9A7EqY5u97L/qqxhisPEF8Zwo1oDiR+qaXLxE9J2mfgIg18BpLflEJDFyXadZWY02dhh3q3Pc8IhVSwQ9OMPIeHYLdq0FdgGSYff4aKz8qq9PhJXqJYZ0XQnTZyYBFcw1fggjobrsCFD06BHYT/kBrgPh83wH2OXPiCeIbgVDjj/mhqS9+DRvfF+AK2+PyFdc5faBxZC18ww8PTnyz9rWAq8Lwp0C7zJrLXbIcDWo58OxzodTqdMHDfbrcjDsQl4rbDVLc36HU7k+PBb0CBEeo3+C+ktlRV9cwz0zuDxlqW5U/7IcFWZdAvBotq0cA8fSGay/VvZbmiqcwqM2vMREZaRmAZrpRlDpF0RGvxFbjgmd4pSznkXb9ALkFx+PBUEvd8CtEqzUuUF4ggEohoGwRdYUqy/hxAhnyCR5HGoCz6cMC07f5U48iB+2pT0d9TAZu6MHeXzTEV/qLHmgpHDjTyyePCM0081JAm38pTdgxPkcurkJgRLUvL4c40K2a9sEj14/pDRQ92I5HEePcQ3j2E8ZhzFuPytDs+0jxPTA+8XCALdhualFcR5WntSKI8Tsu+ACsc9k+6K8ksIrTEArfExm6HA7zz8ylDUl6rPQBTZSmxRwdoxtccMo4K0fhyURteQMFLeGYGhUU68+Nvij2DHKcVGhR3a+QPLpFecLQzCS1efiZRrjLNJPbaFSyA0B3661dW2nS7uLZPDS7CAixEPrMFWOhHF6/iVGugiw20WlsILUZ0JMYL8/7Qs4F9XIQF1Q8PkDkxzcwg0vCSCsWBNrOwm/WkAvVDlY2uwAUuooxC4IU2192gZSjoh2TyLkIYg0RF9eO+wt67GL1W83X89U18Jhzd9HjO+y/1urLaLZA1np7zIiwMwo77Qc8hmYXdGymKKab2IxEos+0LXismFxzRZAjIPwMLPEdTfAJIWuhYDk9AZ/zLh8KFfnjCwKHlKNfh0Vh/PyJ+5uD0vcAZTng3PzyhbgDp5U2rlqtFFeet1s/AAqqhqOstkN3UM5D+/ZYKu7fmHP//wAN8ALzpAfnL39y3oDccoCOKqfkxwIM/4wFB8KEFYEFpwUkDPo4uyyxwFnQsqC2oBLRbUnjIT9/UEIvjkhNhUSN5+wJcSUDcFhdgJQvFl0K8SEaJQxrpcwYW/A1yjHBcgIu8Q10VWU2oN5sf7UXtx7Vb85rmt5q4Tz9ph+8+BZtNNpyjWZZmBVZ1Io9ZltoxkGir3VqE+Qpc4F2+KjNhyYZzoWUjRHjvLOIfGKUjA8IrbBXmaIma/AGvguGmVuzUDUGinqNz1DhydKw6LktskWUxOyt/Gi2+NT0ob4FaqRgrllLnBr1ebz89W5mpZLFA0gg/qAsuxAPi1kqJkHVH9RBJwqF+SoezsY95tsdVs/B9CnJ/YdjeBdZCI0m8DH1NJL4CF5SLX4cVjPRLEo58KCKxXIRFfB+qf4vvQ66AlHi1Z/wZh2wf+BHIeidc5y4LGkac6l6wyOGBRGfhgC4jHtSVglZQ9XbjHgjdDi5KvORnRV70k3+7fnMuRvqJ0d18kj9t4uRP+3QbufnwJZv9kc1++o1Y2Qvr4gsN/PrQ9a9vgIda3+j899ZeH8KvR8debxy5Eo5go9lPLKsyQYdpCDpMQtBQQVuj2qgU0mgXuDxzTTyLsj8ymTpZln831XUQ7awHUDerxdo225ZgPv98/m6fnGegv+PfAT85TvjXnRt0Wrzava41Ny9oFCalDdv0n9XwSgMvYRO8FJrHS1HbCC9xT6HaRRIY71hqWD2yYBQV0SH8Z15UfM33jMj4r7kgfxD4QXTGBTDAbWwIJEZJeE1DYeU76DRFMBiQw+8ivKYmbTxT5AbQ7ow4ir2O7BXpWREyes/QPW9KVBmRdM0x4kz0mRbOxCX8UBkRMeV1uOK63Tb5tF63oddh5CHnkXe427KQQUtG/nXdHc5ryKVoyCuwpCX8lmRdphVhCX2Bsjyoe9hrISAS/4It03MRFtygd7zTaY9g71Rh+53XUOJPszyv8IxuVISXoQ2JQK/bbo8FMGkuq3GDCjtYmIL7mhcGcMbkeKaEaLBKzDHqw2v4DBfFWYEY20aYyeyur18d6/LPcsoB3bB/Cg4UhR9ropZZ8s6WxADUEOcZFAAH2XhuUJPL0JBBWgYyoH15mBsEMnAdyw1YcSMyoMLLhSeD66wHlyEniXxFZ2CBq9yVONhepySCvwgLVZkaMnC98TAIrGmVQgYAM3mho4h8T85g74Tx/X9/TmdCDs9rcLGshDf4wQ6WSfpOXVUnLYHsGTegMtJiTsLrAa12OgUHEguwnLCeSRTYsMEDd8K2qTi1NVJwt27TfQVe53yews7ZKgyW68j4DHrtMFg25wSNDpQysgLXwDjprjnn2KNzLWjCKOxdw40jW2SoWOLw9xwH2K5lS5RtwcsXl0vAANgF66Fe0AVnIY7n4zOuFgExDUOSRpOxlp25X4OLaS579noOEDNYyrjSlfGkVRelrwu28/VNGcvYBi5HZhtH1sd32+ak+Wk6p1vM/T/SmBHcJQ51kc3ZGG90nnDDrw//eBfS3iofeCdGFg8ji4VRAe34+zpc6XbEsSC0jy4HT+69bliiVtJgfVuBazVRAShRc1bItS5WOCDaX8KacsK1WN0sJWyY1UAvhlERTzLvFCGKktWzNM3QDvWaVhQnMizL9/mMUvgBVHhzCnSahTktTDkLc1ppA4ErLGDrCAPapFeAqy3wy9ocKa9E4YZecJQjLDT0xWEeUjFDvw5XtDYGD2mKKXQX4PVB8CJ5zd6Y4q6V5fMR02LOH5gW+YqutVEI4ssKy+g6ISx8BZZqwxyXXGpB8FYvwfUil6KbEpE8WVGpjk5HVGnmbfQdtC0QS+9vaKPeXHst+jsIJHA6CAuCufUbl78W/b06fm1TTX5qDew59emqqiuyBbwGpzFPaPA4gUHYhAT5sUQZ4KygNR/pmEcKLRhI4b/8/5H27cFg11YGavUgBsISpsgzmITgCSz86Apcyw0VXMuiVfHz0lLbb3ArSLtE5oEO7+C6fA2WMkwBpLSQJee6WEkFgS7FIbA9K5iMJFnqDCxYzzPSVJUz08nzNGY6NQtRp91bKjeddk4MN34M6LOMfY1JkS0eBSLQLAyJI39GAL1JAn/yJx9/+C5/Z4b3AfTgeRwpAe5jETFfg+eLUsJv/RnnnjliQF3mCjAcuB+LuSr4xtjwliZ+3rf1sXMWu+iDtKdp87MwZ6s3zfGOmYEQz+cladZz3R5mmT/YXZ2GP9FSeZuWSlMh5R14B+k9NylNSO/iXSHix+r+Yjfl2LdrVj68hvscokH0GiwVqezM52U9CslEFrjUsYSMW19yVNwCVVQtciPeZahRLXT9kttiCtXq7Z5cf5mzrIfkmnGq72twWu2Mr8FpDRYTCv1ZGtFujTcgdST7ERJYyZv2BWqrser98/53ZLf51+D5lvmd51WYx+/k4UeZZtjAwm4kdH7P2WzYOZp8eWrYVpoFtjXodYVtDQYLbEGck+oWXxgdoYBF23K8ExrTjsXz/hCTUTaPxwDa3W53Vz95+cE256K/H9noFdZ9AHuzjbG/XfJy8sjGP0ofuvKB98BQ4weyjxke4eXkZVnU6rokJfCKZlp40Gsp0Dn6Olx5ejmPJS1IC1EceygQ9RvBVGuFV20+3GqN8CtjOA3hWNdKFu9kVQ1e12vdsHGrZ1NVYZfluqLpRsvywje+QRVsSDxEXdMvy/6DnIk1V5IscI3GfQZpjXaGtS0NLJ+rqoqTtc7AAsXupanwuZSE2ouw0AsJvBfhdJ7ndlmuquplXaN/3GbToAd/tFMu09p6nlGCZZSuroriu2aRRyEfuabwHw8eHzDjJ95Yf4LPFmQBMWQ9b1qMNm6nh/DpIXzaHD0dteeZBYsIJbz1c89O9cwkMlqpJoW8FQDGn0ZVbQ5jTROi3Y1yZeaQFcdYjm2DbaqGw9ymMSvv294Iu73thm02sWBbakEyzOlj5Ve4ccSp1+DWfGSD/Z9Wb8jcsZAlErW/E9NHv/HDw+32XT9aJKqEV2xEmzdjz/sxX44Z4e/i028uHvFatfbHc3BdVgTd/UIjH+T0VNYqI2XZixSUD/06XOkNet1u9/jJfuSAUq4qrYkfePN89nXHviIEXaNfX3NUNB0sO7baepqZMw7PXMaEcvyO59o5F1RReyNkzLXxAwa0LQADWhaAAW0LwICWBWBAywKeyZvaSuFMA50xR85EWIOvIq+Xc00/2OYeQG49/BhrtkZ9jDOrP4C9oHnLjfBmm/15DE47Awt1JfobhXZwbb+LcBpCnbHTweg8acDWnR48r8UZDsAsFXApadnRYjXbYM4NBv+WBPdtLN+jGW83bEP6+hEzeufe+fEPf+CfgC5LM6wv4RcHEwT3elWiHo4OGJNJ/DpcKV80gHJg+DuchJP1cYpruxamPaFrZqpea2A6dn/T/3Kfb3vvTR7tMje+scGX12vIMbDbC+IYl5nLzBjgPpiHy3CNenPhGQRkZiJ/x1LGxa/mkZrog/Y9w2UC6FoQSs3cJa0uDU+eRltYFy8MY1W1sSTORVjQ/PqLcLqupayAl8WY7Xop63Sn3WI30Swc6PWkxgCTe577+bIN5lrtdociLj0dk9ZcWTJETeSyenlex37nKmGX4Rpt8M9dramHS7BQsleQX+F8QVHN6BRsGSk61CkOcm9d04ylfP+c/GahWNUszJVUwgUFxW20WU9F01dn3zbYjVESHP9wOY9foa6qXN8HUwfwfTjfOcP3AU43wvfR6bvE1uCqKjc2ANsoXS8e3z0+HeHTBhtxW7oNd7kkFcNqFVkKsY5CPPQ02vc4+yr4k6mKBfFD9O/NwTbaMsWf8R0aar9ysL5INBq+H2M5Egfx3w+Gg77huBWFmJZwEAf77fDWtIfUZbiGW0PjHMERTXkz8yW4pjEXS7CAW6ux0ndazUQLpGWVmKF4Gi7m3CE0/UKmu9DuLgNqnCOn4flnHoegwD1fo58pz1n9kYqonqrfzzk7fg62YRghXrYNExk5J2A3HJRd7fq2Q7A18RfaxOczfj2wiStFGi1qz7AE9QWLqSZUjBYOGyaGywiShJ3+HQP+O4h5G+eC+WUkk0Ka12BJM0uX4FpleIExsi7BAk4dtpGeLoscy0Ba0SQP9NFtGWL5NZLe83C63TIeE/IjUihltLnKgTLEYs7CgW+HPIJ7c1Sv7cC26ADyYYsONBDGVc512u2w9MxxnSViJnVV1a5KiTtz0iwKVwddJX5VD9AWkgY3DwCcqMUi5sgu4kc2rHeX4Uon7A9xmX3LKfmWLxNzzyyv98rIZTRIlQWHRSCvh5f9HFsinaUnQ0rhLCR6GoaKnIEYJy+/4pw+EDauw+XqQF2VIQmfIhS67REFj4M5k1rgXN0PhggZjrlNtgP3h2lI8dOiIdXwqVmY08oOszCnZZ9w5ZDSlyhapJmU4TnoqjIa7K0WuLqiUmCX2Z1MXBwnAspASyjscdSKLMU1RQKeZmtd5kdkQYUBlo7I2P88nO52qU5EP7Z0iW9JPUz3isPCyBYyg08rIt/UXO1k9fUjPJPXkqIw51zF5SjmYLYiaUaUlpw3WvMiUHANzaGewsnx2zBAIM+xKtduOKirmu/q3uMKuMxLNo+rSJhXNCJvUGEyHh4pe3E5x9H1t74M1370m4qXxWuwxBlCuJQuJZjT06IJk4Zbe0EG2l3a+F2F16zfsEGKlfH076bxiEDYlfNAiLig4UnTtAiS0k47EwbPPDMYzDDQYC6SUDmVwUuooQbcNjhIPNDT4TbTxHBp8Re8muvwusKMNVpdBGByLdIHMrqKz/QNTYXkafnOrgA6HLLhwbfTmNlToanwo3Vl+LsX+S/DlXZIhbiMwW28QS0x7vMkNnleXVYsKREzYFfoaVhI2p0u2bEOMKPiCTOr9B5AlvP0Gwy6na2ykp0Cs6wtK3jDVAafcx/6CUvMfs4rXh0O153j9T/D33HdTitMWT9faR3ZBgdVz9jGDLGm/JjLGO6A8ogovChbeFW42x4ZEbMahRvSSragCTYLcJpy5UicmNNFcs7GNc6FTBPStTSOZxbm9tvLBr1ul2I752A2s2d0w845mNVdaeZgG/IwnEvb4CBrIKmOdsqjrYEe1+ByL2QfXoPLO8LvXIPLxd8ZoEWlfR94/ohdhZooqTS+D26cArOAUwgJOVCifFATInCAQgSoE5VcCgVZIBf3h23pqk7wGIShZxLzY/qudlU3+QELCnMcf0HfqWspzTIH26g8HduogLMhsROzTKp1eJ4cFrkrwVhSG/sIpNZyUtozTEhOdljxHd/rToYeTU3HL2kAJoq8RUlgAW5MT0+fPPn08jICvwwtn5jBvr6hOVysUxHFH4A5jjul3sH8KzTXzME2S1VogLuVGuCc+wj7gAg5I7GBFskqmiX02m90O1xE7hpcbtn3qeuCg8CtLSa2xzTxF9FuYE/hm3V27RLAqRoe9JR4b2jiqnwHJ9YN0mUlveyG1WVvUDFx+x2METoNNzp+NidC8BH1o3kzxwCZAxDxOucG/2KPSIKJGSueFyhQbFMOgMMjZB1oLy8DVQba8/LmqAwPZVCT7nVDaxHdwLxggKysw/v8DM3CeEbqM9OZuqqetl1VEBh0261Wu03g8enpXVtC75SSWSjfQc1F+zr3v3M6LyWk9EAQgImSveRFqUGzMNe1lJwSwaJAcHC4Q9DmhX0gbvFrcPmEZXwLM9FkrNgatgQ3aK/CItfeQValT/3X+NScppiHlQDkqXXnERzgd/7TVjParv5tJpcVJWXmBCWPWVVZSZTfQdjmQnLSQdimUQue32c5206UB2WWO1XD9ufLG+LLQ9ges9ZlLzmsa31uGsCXUPPQvwXb+Xttk/P98hCmxhhHFCvZolJ1A06zgqWTLMtFhqgo24MZopCR7/ZQ+eYyVW0RPXlJWf8SXKbsAVKqbtBOFJ6mbpBSlbIcdSOJCTnNyPOxDeZOnpRtt+gJeP/8g7FP5LIrVIpZwmpQWN+VabeSH81yqYNxGm6cNz9qBKQ5r0vmBnh+lpI47ZXX35EpbDVx+iCaTlGwo/7AquUFWS7KsGHsZVgiT1RdUxcYNn5a12zf70mLJejTnvVLF5z2E9vP5QAcl5fSG5wiBt/pYkAj9lQW92GZRX1Y5QbQDpoHmdwychwc1M3ktsHBIrCjbXZEtsHBXq+7KwAMJkTBNEgnTAZIXsiOPIMP2eJL8bp2Wsou0JsWWWr6QOyt+No5y3v+tY0R4AYmKZvvdAOwZrfIjnGwiLqqLiJyY9UldJWn5IOi8Z04YQCMWECsH2tcJzpj+MxB2NbmrE7sKq0seDm2Q944GTred1UphcuxD4RR3IhjKG8U+amIdrjMmJALe1JvuMEftuwsiymkxmRvpZAKn1q3NPC6beHXv7LcMOoxeSx5rPmvaYfvFwf1glw5Xpk0HHEJjwvA+Se9W5YllgPDzpmZkUqaRDr1Tkt7lGUnfYiZDnSGa4gTqAoBypPniFfSkPo+DCkq2+Ag+1vKef8eOvJe0s+zNIslfbJqLqkXz9NEcBB6SmaTHP6OVgo/yNJ0zT8aig75H1XZwBOiP8G5NJcT4z25QYpcxosUl2TEN80jVjX4QwXdMdApp30wB19Sa7AHzv0yNYANswwm0Yo312D3q+O5Ifwlxl8K7Z4w9iHvY5zRwFWSBHkFLqdSXZVWPJGVluCGbhrHrK7B9+4Ulo9vsSAzoGRy430qELQM2/pSUUDUoRhvNO8fX4s9HoQvTYbv0GR2QVWvQjlgVdXJ8O5+3VbpQmsTXoYl5dZLcAOtgxUzaC5IXvHIK7P9UhlqsnwpcMRMQE6Lx0H4Eu55Kw+KxJaTq+xgXVe4ObRYT+rfZsaUIkXzL9vasZeRb8v48Amicf4OGEAC0uWuX067+xeIUZBLnagfi5uD5bz/H2/f0iJJdt1/Uqn/5PyRUQobqhJslNUM3aXBwi4qN9MZWRmRVSLRMsmVFwOuhcFLCW/cBqGMQSV6aXlTW+sjTJALbwwTsgXaCDMfQHhCkjFaGCuEZClGjokw9zzuOTcyux7d1V130f2LiIy4j3PP6557bsN2Lu/2xMl4qGdLc+MKnpmHbVv4xh22QyNnMbcHHvn0/60Il8Y13DjHrGnHmtAb5gN/3+oNRGK8NOPUrXRXXlRqHWDG+XfgEScXocHStyFV1YUlMcTcOAwRoJHb/K2p9cYfWMYaY4FSDk1GemzV+1Nb2jbEva+8bkx8j48HsG4VYrZ106JXnntXvIp+AteszPqt8dy7P0BB/wgm1s80ab9kwBhC9si+0HfgUWwHoSGD3va768NDx8fr0jOk/nA8Ho65q9FF4ngQixX6jmtuIGNKzoDjm93gBimvs9IGurUNjFhvNgbYldFt7Q9kRe0+ZyPxEUxqn/vV9cGG5AKCj2yHtFWZKiBtqziFCWVSAM6kMIn9XtJ34NFmLBuXkQvKqTOH8DjlgxBUK+O1Kp5LTWOc0Dk2+7u9HeMJHZbvw1XMX0Xw5c+bPiCpwICIIrWgyk9hzW8rit/jHT5Fjx+jPdEpPsaWdm0mcOONhe99iNOHeodz1iD43zzoN9PX8bhDYuSjfBQPxQ/4DkwqI0omG9gRJU4hOITHOSf+wXmOz7ACm/FWMgJ4mEWRwsqv10vJ7o2zHZzt4GwHZxJextqfK6vX7NnbZ/uvceCNrb+2/HmtFgWBTYwbHAkEj31Ga4RrL71SufOcTEZ+22/RbbaG9yvKk4H86P3hcMBcmHQITK9B8wq34FNmqonEPKw6+b7eedDsYZPby2rPEvejYNn6UeeKrewEG3bHT+0vOA9o8vKkUDt5Ek6kiYrSiXe0oZ9sgosnPRGleRrMqpaSlXglKM39rBKzMEvJxZmeIkGnOeduuIJMUw0Qka07RLeL5ZrFrlx1ruzDVy/GTHmuMQFRtyWSYeFUTN6HvS6ssMh9UvctfB2dROhI8by+rFUKtFYk9BVsxpbpfcmA3xl2KNuDHsFEE8binUsFQ5OdZ9KEj1UQMNcikFCVBegynsCB8tMJHPQs+PbIgMo7OCZwQEZ3AqsOMb7D/x6YYvFhcOf+eKeonjHoGaXjb4R2/cmQaMdVl0rVm6GSeGCd1HkgUhp2YDuRQuGlRO+UjJfpHVf3WGdf5z7ZkLLAfOWJ2v27hW1AuG8AG59KiTu5tpuxnGToCRne4uEtwI51k9uBJzISgGTkRplO7sVpcaC7x/kxynzqHvPsyL/AyZQDx3RcLzr2fihc6+gZMx06v6Kb5O27ejgHSVKegN8VySo+nS6WK20bXnnxfcKiMXCAbgZXvG8ZGd2aVnad6ed63MdcbWE9jjebLyML/HoYcYODEuJtUKtbcdvehLt/2O3qtvdjEIAGJcfB737+A/YHyoxvnG51wOoUSoFDOBh63+uhd0+yFMDlX7LM12zeVdw5JR/ktIV1+3OZcFtYq3PeV9S9QD6KKvIhHPR8lqlDeMwpkUv8aE1ublzAWrMfB7WRK1344+Ehxu1qsJHVxi2s9fT/AzjE4x1qIsrHlLSTP8od+h/SUs4ocgWZOm+JJlDf5D6g/jU1yLkPeCMQUYu3GajZLFex2aB9Xfkz5DMfnkISl47KphosK6/FXcFSA08RtGVqgOPpBqCnwYOeBeY3mz4DDYy4gnVqPrrWGDCZF0WBZsK61+trX5c+eeEhPK4b8QHimJbMa69gqdYr16AOmlClQRPSU1jy+BRVQ3dsRSsfubaGrZ6SuvYmc2Monolv7M0RZ7nl6T/XuLqdwRUSSEpm6bLiYzdWWAOrlyw7VwTLtWVgSHTx1R3wMty5soYrPe1XBgEn1qGb2nFMR3e7tlkKa31eB0/j7k5G6+ZtW+c0IiGFYXhKterWooOp1cug1YRtr6w7vbbs4O79tdX21uwEUQ7i5AgRmNF03FSqybtOTmb/G8/DuNVMlDjNl7q2vUR6z8ldtIZt7Y/t8mTUkowD7249hIM/tzXQTCy+3+tqdd+9nLf99f5s79Wv7P+/ExdE1G7kiah1MWqJ7JXJaA3L/uBzSmAkCIOZ1NJMIolJpPdYI988R20K6XfOCHIFmWZf1BrgxF6XBR5yThxVXT/40Zrz3KFuEX6UPQMyvjlpbktiBfzqym854vaUuZFEzsDBUVRuj/7rsqRdRsi1ZDvFFSx5wyBRS0PnV9RU0Ub2wZC84YVZrGg4F5u6+j71TuEPOecZR1F5+OqK3dxr2FaUMb4wLAxkzksSPwLxEDyIvygfPRhYEk0hqA6mQqCWkveMBmumTPQKZu7V5Ci4gpkGobmKujq3NfWBGpxMLiQueJpSTk/+qO9ebc8VZJicstfjj9ZFUIOWskHOZK2ARhvvtJfmMdpjd0sYBswgCcpt+J7F1SZx5uIXuAXJcKjUnyifwMdajqlLaMYUqCWHLHf5Qry8F176kuDnXog9a6DRWZMsPuzR8C7RGwgCBjpll62Zv8sPLPh9KS5v91hMezqQWHRBb02xB8SSncnJzGWFxG+L2JYWvVwZ3aWsOp+/vexWeH8D7vR5GLm5M+LzCYBALCnFM7ji5LrITGZ1mXNw2wwSjX0g+krJSX4FiUrPK0iaWg7kTGDJqnC5MgRyt5LcipMdnJjndBJFXTJ8z4LG02Q8HjPhNJI+fm2DVg5g1NQF6nSnMPJ2Ty0dinaygja1YGzAHzsyDMfwcQe9aFlXl41HAXL4cQePbsIy1hyddwWzCs/AdpWeQYLb0T+mQby6fPbsWyJfEhHfq6CHXbkZL+/5/K3YDWKkaYjXsBwMh3iSIg+i0+EKlNGjohAZcMBjUFvQ/r5wI/pYY1NZouRFhZ2TtX79m0FbinSYmeKsLYvJ09DF2Q7OdnB2K0YPhBMvXu1KYLbxCnvC8u3o6NKBSBfT3VikkoRsCWs6bZUNMpy6Ie3XqIyMVCpTtzXkABihCsXhrBnMWGkilsFnnab0UVxIdvJpCZGyDNSXcz6Kfc1RomjIHsCo8kFEPFakZTyGka53ZTBjOi7wo+7H5FGdQbIZC2/jriIxlMDsk+eYlBi7ahZ7yRMJ0ypQhYp0Q/gS1tS2PBf5gqt+1DsQVLT+TVBrCicfcdAM21Az3aI3g6T0+ZYTmOlxGAkrIzg+M0hqvzTPFaX2LCHaUD5ro4vnVNFl4OrIc3H34JiG3et0z4+xony8EFrciZ7U6xQa6hyqtTpoZpBwdu2SH0vB08HQjHZE2mZfwHjo72iIhmvCT5wYalAlXDap1Qw4KNBz7r/8IgMNXXKGlz9rnzgAWxCjcN1ipJHMGSTqHsmQ1dW5Aezooz7grAczvPNRT/pArRvqg5Qycs6skNSRK7VD2vabAmLalrOESPaNWPFpzV+5sg3wNsDbh8A0GTEhfkmTPveBVBkkdS7LAdxTFINHPVXQeXgzSD7yXocZJGpNzCD5pgWpBW0jJ8PPQhU34sPxCtOhbkiXEFHqU6IjDv9Kc/YhWoLHU29TKwja2hMI/sbEtlHj9LwBoQnUlYQmcHeWVPTTqlTw85/mWOuqlMSOiR/5RkaeD21wTTDW0ZaP6mVN1vP7AxiVIdspQw5NOQMylhEFifNMN7s4seBHTqrTWOaS1ly3T55rj2oW/RFWJy/RxDyAkbKDAxhRIoyqWnXF1j1LciOewvQ2vDI/pdLFdO3udtnUly7eW1znTjd9Fc2ROsmo2xs611k5Q0HdvnFcnbvdDQ3JjgmMlChHcOC0NVpjOoBRCh2RSRQ6srs5Mpji9EWGOPWCETn3VGcC3kl5y0UCkb4aay3mVQRLPCGhImIhckXS04oSuQ79AVCOnZRl4SURf7QQmnK09xO+Mx7635Q+kHEkrhsfHaSce6rib4pTlu9McS56IyZS81mI/4sG2Ds9C/gYABysVHk6ys+yKFYdVrq9M3Z6bch8I9ad5X+Kly/Gp7B19fysqi5pDQGb88tfvvU2guFQtprh8Ag3cXSUFnmJ61fEHTk8l0fkqCT3txurzYlQ2F9/zpDb0NJeVXQURtINRnA884+NrK8zg6meOES0x3plAtOepb2KTzLHfi+rsiBKXkJU0+6Qyt3Z5oXwTSUqqk6fXEQEaCbxXKLHPqwMVdb4guOqErNwBMfKuQnEjmGvAktbrK6HxMdw7PExY1NWDx2ffk987KbisV9AdyC1wPt0el/pHdcWBHeUjDKYOkOOFoKmzqAoOEw8gano3KtX87G9RHFc/YILeukSuEBd/g+IQC8wJOo9AUMVuBdVoXR80YYMVQyFCJaVo2rDWXhlHCkchS8+xsuGkNLE9trvCA4aNuuIbQZ6Pu5kollxrGyTiZp8rcfwmM8RwFD749Ssgkx1l+M0tKlQdPwaXz3FDuH2XIQS5kInzwVE6vWPYNm0ddNg45ZW3KB2hCvG1OyGU+5hS6tKQidHPgqh8Up/GopC5kCUNsU14RiDUdmmItHBm8OmTlUCLzouqJ0N1no69MuGFxDh4oaauaIhRuKNZ5u3aWq2QdzImY4/oMw8bPM2dMRoYD1SrVWAH8uOCVLAp5hTDi3oKVzQCoK7cyH9VpdeRDlmSYRUBd2LCWfAGiS4KhsB9PgcLeGOomnQQ89M9wbSfG5BHsr5honPWf7UnpFI85VnZ+q6OmZ29zhgil0G+UAFrUJeAICVfJUr3t6K+S2vhlv/h8Nb0rn9zBDbT370o+ceiN7kBp6CkDxR5rSWHcESg6IRLCHq23lV0NKcGXgksAi2yhoiVD5/QW87hlEby8lZxzBSwXxstb0MpnTodFn42VNUTU1z3m8yvJCPhurvNw0oxDzacJIMqqih3eemPZu5ARigZRvX1O8JiIdDc6ehzZ0RbD88ss1u/ik1fYCd8zOkXa5oQR3C5GKA55XkNbeDhdrN2Q9BectZ34ALTl4j7FHSbEmHjOpGB+tXXGvkh8WRVrRt699Yy+sMzgLL6AxLiO6Gz0zp4k7BtnGr81NXg7qh3TbKUVEoXMD0+XfkONcL11PNt05w+l2Y4rTbl8FWd3ai+zY8tbp0BNuqLCCggl+n+ZEb0bOm+W1JS8lnZHWQB4BbTSeeuOGl/bEEcjvwPehKGIAjB6KCPYI0FyEgyurjj1MIxrpEbb5uKszZg+Cj8XgcDwcE/DkP3dTQ98HuSog75bR32kGnfM3//37eAKXBsw5NdvECyxksArzYxaubSfYlyuJWrAUnBdPKUUECG2nlU0zsKVSEpyTI3ClJ1SIw7hPhtOzZJloxzv2tk+SV6ITgxDey6gmMPjQ6B8ZeUkDfCCYYpIL6w8R6EBxJ1eTDx0Fsqu/THQeQlTk1UEAbv6132m+Zx9pflbg6sPCbRrErvOp4BguN9J/Cmca80URgdxPyOc6G0rZhtDEy4A42V4xEdchikrntPf8k2Pk1KCCiAtyMaRCPMIkajdvzgRm31jsqJ94N9F/uN080PeMInrRtVZYK2pislhE88XmO3CCqFedGVNZHZXg/GdixtvTRtt/zAE/SEJDr2+TINQdATvtGpuGDaTJYaEaZDBYsR2slI9wafgaLfl/0cp5KRVkVTFN9ZaF1XbJyNJIo5LSQrvqkZ/st6MTGgE3swa8/SIEdCBOeVtSJ1rxy3fs/hQG4hHYKT2DE7irU8p+MpdnO8PLhZ6538mAU0Gnop98mHjvhSePz7FIf+6zuTNmWPIALtKfQHFk4QYaHvtdGkKXEdfTQ9ikpVJ7RoAnWkUPoFH7S4NZ99xg1jiOsXOPcPO9rdX76g/QDGlN+KnXVyTS0dQFnyo8Wok24JnBFSd1EIdvzojQC8eA7ka4e7wi2pT+OVWrdkJPrCdYZ1ecntNRPxppjW5VoZExv9NEzosQfM70hGAhwA2IpMc2F3VurMqr9edlcNwjr1lCPVnXTflaVVDddzn7CZMle3QVvUa6pOptNPMZQzjNY6L6bW5zVr1gibO7U6FFn/gprVNPQ17HldM88Y/jkaGpbXVIsd++4d6qOggwWmCYcu41GJM0LbvXAk8EZROhzxFafcT1EsyMcvamyetDXbVG0u3LtnciRv3K9Dztt4BoNMt5JfY3cfsRAe+0JjAaD4biPqvgTXMb5MCC2f7GgrYpUR4ctv4X7zsgya4r156GifAOOWfuJcIashYf3TDwQZIhecy5tWAVtuu600ZUQXQf/f4Byih+lvx9KH7Zk0V3LJo+ipA41zrgnxNs+LLHbhhwkylM5Jx2IvGygAk+5ngq8xpB7UbHAM33IYaXInq9zcglVptYpbkC5diy4H9YtL2RIeWsaAdzeS/LXB80u4Kz0KQzdWPk8Hm6sqvoXxDavKYKemLDrqubZe0QTW7hW/u5a2hXtv/tZQVKJOAO1NFIKi+CaQw1K27jUDEkpNRC/mG9CTk2g0Fqynq9zz1AjeUHTmFe/V/OrZSf1Fq7jMXgx/VVRJLsO+OPeV4Py2vGqdxyU0zeM33Wa27tjS7uctC23Oj+aOouYTmakbmcFL7WTLGVypam0enOdScNp/+/+XGPf9WTYe5f0VYCiwFbjM5tnoADJ47T3buz+tD8gFWUiA6PozK2iMx++peAcN0gJaDnRzCqwLBcdS3MX75bzVytuEF1tviEM6Fz9cgQw78aqYw9Ht+LzHfyK9YxcbTZDmePnKnkiJxXrmjJ7E/v4huz+uva72dzAewXVDXz941zVQ07Gj4xljkGVKQ8i0c2/p6cwZw0X/UN8Jx76x/5RB75lNVJBxSAeSsaIBZzrhq9zUncBI40iOLeRRte0iyqQAgVmurlWA+/d3qk/dsABP32xpbWujixk+nZJbwHzBy4ZZDvYXDG921amd7VqOv7zHTzfwfaKfKSL57fiOVGbDAjMUYE5xzPBURF3kguTDYnkosOeKhKKvttPmdoco8TRYevKjcGccmBV2OpztkVy99G5StVzUn3p1OgIrqtKFlGFCooal3euxyb8be40MEo8Modz3NqOfOocha++WszI1R6Fax/uKmgvgdt2331sTpmn7OS/9gnPSmoOJyB2zck0IfocztkNTW3DQ3XyPL2Tjq7SQLGt7qvglbwyfED70/3f4ocuqrUF/Ynh727yeSVyDtk43mw2m/mfYH+Wnr7OIWLHNVLeUzq8lLS2p47lkZMvgqdqkd7aTQG6DtF+3H3+pt9bXRXtl3lZSmC8TDFcEXZTDMwU0yXYCJ7mZrnsKZm3Iw9aOqeAAXkcnjKXQP2W7vT9CzSQGycs1W3ljb3XUrhXtrqtXLBXgFznUIpU5j9ozNU8kQofEypdgGkvpaGoz0kXxEwF7YaE7Oq2UQ1nwi7e93xwheeSv2YmM1GBG3hn1sjA0/FfjiTOrYtdmzNfwVMq/lMe8ZWnnSsBug13f737vFNMTtiuQafpCV3/fyAAw9cZkC/EP/YPAjab+I8kln5elezwR6FLf5+nseYjznHcaJHBEHUAcOB7J6IgO/v2tHcyGAwGwy/06DuFMXbnPCu8TGNP4DnMB96hyx9F0uNXU5Ys9+oqpzxO3LiPyGWJjRub73BkInKw85xtwJXRBYjLv0mM8UMRJKp0abe9jS1A5QHzgLtuy4Nuq5VPsbGNqkCi5Jp0uy2n7aL4amVnyt5JXA76vR4laPKvdqov182/YOhDupltVhWPYiHxB9qelMig3YxZCpA2U9cVqRxJbmS0CRCfQ3Jkwa8saK1cpxOuSuTVyb8ZXq1bELA6KW31wep4x5OfPj+2QO+QtUrgL1RVOymM3nYysMAvtTjwWal9nTS4Wu+EX9ZRbTuK7l5FuIsTgyVG7Y4Y+9Dvr/ZjVVSN74KmSu0owqVhNG1p75R65xPbh039tv2Nn798AlkGSW7IOmlr4S0UZN+QuZwQiX5PKLmsah/PnFTlx+xloMfSAsNV52aPCv4GqVK2LfzWWUdOkiVuYmEQc23ak1eeiw5lFGND8EntbbBEpk+eE1X6oxEIXHp6jWVHku8Dp4rTvOBzV3DSm5mZVD4iRL6T63fahX5n2GUhabky6/D7y4l7vIPttRNTuviW0uKZ1ubl7kL4+RB37+/FQYUZn+w8wVdJa0mw/8KhShsjPtOctzqVJRpXfIeNq4z2suVpnocethNP1PzXxQ//Jzs6uGqJDVPMILm0hENxkgWTu2O0Kdj9TC1t8OKsymhfJtbxnLQYxElpZRI32f77Lcj5N2VZyezDPf+X9tXytgKjyyg+NKNohL6vW1mUtF3sKRQyk55azi/fwZVJ95gXUU/dTJJAdvcddEkXZRAMjAqTQe5P//cah4csIGYzmF0fq3afjS0Pj2kfivpmE5gWFjy3wIYmT4cWbCyoLGgsGFhQW9C3QKitE8RN4/QiLOO4H+s434j9Fh4dt2nps4FSuN5PJX3VFI/uKzjcFge3opiWKZ4eiErYFPcGx+Q+xMdwyumeFMeP8bHeYDjuyWN0IwW605fDIjOYYlRvnvrHEjocmb7DGjeBoQcDI6CmR2YmTXMLrHyhwEQMwaTv5IVEQHNCwj9EwEsebyPg3z+zjcOF+Gw8lFRyHE+t32kk+ZvrN952cf8VadnnZXG2g7NbcAyxbM+aQqz0PoU4teDvLfg7C96zYGNBYcFfWfCfFlQWDC3QjKYPujxvyH5Kw/4CHEu0qhuqOE3LSuKPY+Zz9SnEdjOhu9Nwdn4HKPVanfMLwDz2qdNnWpQccV4U5g7FweGMiz+r6qpgF3uM4g4fi0XekQERk5OPJFRcXtrvuNknL8jZ2qPqgH2sCWvgKTTWSUagpowX2B5xh8Y0E3q9Hlj+HmN5MaZr98Gxq8LN4ZJYG2vSxbnZmEsD19TU7wWd0pDeVOnsVrxbaV9uqmxr0jBipTVtII41sJCPIftOX/byxpC1tRwRFuNL/vUDAeNxvBl2XsBDlePiGdEU76QVmsqL4jcNEfLA6FAxsay8xDsbH9AVQ6Y8NIbsUwtiC/oWHFnwbTs89N+8kvZgJpYVj8PLlxuJ5EXjpBrMw1Rgf4ViomMWwVn304R3aNI+8GCK2g1/uz1wQwteYwX0glTEfrjbMxa9cgXu+4P9I7mbdRW+1sV0xWJ3xc2Pr4mDfRXeha+9Gr756/tqc+8K+Jf4+/u7LPjYDWXlX7RT8BV7rtxn3F6pAvpRW5UXk8h9OdMdK3BbhZQe797tr1SBsDLdHjE08yYqsG9Y3ngFOgOyMyt0iHax+3fVpfW70fhd/sJPKQmGePU6PnsT7v79XwAAAP//zKS4I7aYBAA="
-}
@@ -0,0 +1,124 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrUnknownZone is returned when parsing a zone name that the tables
+// don't have.
+var ErrUnknownZone = errors.New("latlong: unknown time zone name")
+
+// A ZoneID is a compact identifier for a time zone, for storing in
+// place of its name. The zero ZoneID, NoZone, means that there is no
+// zone, as for LookupZoneName's empty string.
+//
+// ZoneIDs are stable: the generator assigns them from the registry in
+// zoneids.txt, so a zone keeps its ID in tables generated from later
+// boundary releases, and IDs of zones that disappear are not reused.
+//
+// A ZoneID marshals to and from its zone name as text, and so as a
+// JSON string.
+type ZoneID uint16
+
+// NoZone is the ZoneID of the ocean, of invalid coordinates and of
+// anywhere else LookupZoneName would return the empty string.
+const NoZone ZoneID = 0
+
+// zoneID returns the ZoneID of static zone index idx, such as from
+// pixelZone. IDs are one more than indexes, so that oceanIndex, 0xffff,
+// wraps around to NoZone.
+func zoneID(idx uint16) ZoneID {
+	return ZoneID(idx + 1)
+}
+
+// LookupZoneID is like LookupZoneName but returns a ZoneID.
+func LookupZoneID(lat, long float64) ZoneID {
+	return Default().LookupZoneID(lat, long)
+}
+
+// LookupZoneID is like the package-level LookupZoneID but uses t.
+func (t *Table) LookupZoneID(lat, long float64) ZoneID {
+	lat, long, err := Normalize(lat, long)
+	if err != nil || t.Init() != nil {
+		return NoZone
+	}
+	return zoneID(t.pixelZone(t.latLongPixel(lat, long)))
+}
+
+// ParseZoneID returns the ZoneID of the named zone in the default
+// Table. The empty string parses as NoZone, and a name the Table
+// doesn't have returns an error wrapping ErrUnknownZone.
+func ParseZoneID(name string) (ZoneID, error) {
+	return Default().ParseZoneID(name)
+}
+
+// ParseZoneID is like the package-level ParseZoneID but uses t.
+func (t *Table) ParseZoneID(name string) (ZoneID, error) {
+	if name == "" {
+		return NoZone, nil
+	}
+	t.zoneIDsOnce.Do(func() {
+		t.zoneIDs = make(map[string]ZoneID, t.numStatic)
+		for i := 0; i < t.numStatic; i++ {
+			t.zoneIDs[t.zoneName(uint16(i))] = zoneID(uint16(i))
+		}
+	})
+	if id, ok := t.zoneIDs[name]; ok {
+		return id, nil
+	}
+	return NoZone, fmt.Errorf("%w: %q", ErrUnknownZone, name)
+}
+
+// String returns the zone's name in the default Table, or the empty
+// string for NoZone.
+func (id ZoneID) String() string {
+	return Default().ZoneName(id)
+}
+
+// ZoneName returns the name of zone id in t, or the empty string for
+// NoZone or an id t doesn't have.
+func (t *Table) ZoneName(id ZoneID) string {
+	if id == NoZone {
+		return ""
+	}
+	return t.zoneName(uint16(id - 1))
+}
+
+// MarshalText implements encoding.TextMarshaler, returning the zone's
+// name in the default Table. It fails for an id the Table doesn't
+// have, rather than losing it.
+func (id ZoneID) MarshalText() ([]byte, error) {
+	name := id.String()
+	if name == "" && id != NoZone {
+		return nil, fmt.Errorf("latlong: unknown ZoneID %d", uint16(id))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, using
+// ParseZoneID.
+func (id *ZoneID) UnmarshalText(text []byte) error {
+	v, err := ParseZoneID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = v
+	return nil
+}
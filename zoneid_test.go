@@ -0,0 +1,148 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// readZoneRegistry returns the zone names of a ZoneID registry file,
+// such as zoneids.txt, in ID order. Each line is an ID and a name;
+// blank lines and lines starting with '#' are ignored. IDs must start
+// at 1 and have no gaps.
+func readZoneRegistry(r io.Reader) ([]string, error) {
+	var zones []string
+	seen := map[string]bool{}
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		s := strings.TrimSpace(sc.Text())
+		if s == "" || s[0] == '#' {
+			continue
+		}
+		f := strings.Fields(s)
+		if len(f) != 2 {
+			return nil, fmt.Errorf("line %d: want an ID and a name", line)
+		}
+		id, err := strconv.Atoi(f[0])
+		if err != nil || id != len(zones)+1 {
+			return nil, fmt.Errorf("line %d: ID %q; want %d", line, f[0], len(zones)+1)
+		}
+		if seen[f[1]] {
+			return nil, fmt.Errorf("line %d: duplicate zone %q", line, f[1])
+		}
+		seen[f[1]] = true
+		zones = append(zones, f[1])
+	}
+	return zones, sc.Err()
+}
+
+// The compiled-in tables must use the registered IDs.
+func TestZoneRegistry(t *testing.T) {
+	f, err := os.Open("zoneids.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zones, err := readZoneRegistry(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := Default().numStatic; len(zones) != n {
+		t.Errorf("registry has %d zones; tables have %d", len(zones), n)
+	}
+	for i, zone := range zones {
+		id := ZoneID(i + 1)
+		if got := id.String(); got != zone {
+			t.Errorf("ZoneID(%d) = %q; registry says %q", id, got, zone)
+		}
+	}
+}
+
+func TestLookupZoneID(t *testing.T) {
+	cases := []struct {
+		lat, long float64
+		want      string
+	}{
+		{37.7833, -122.4167, "America/Los_Angeles"},
+		{51.5, -0.12, "Europe/London"},
+		{27.5, -55, ""},
+		{91, 0, ""},
+	}
+	for _, tt := range cases {
+		id := LookupZoneID(tt.lat, tt.long)
+		if got := id.String(); got != tt.want {
+			t.Errorf("LookupZoneID(%v, %v) = %d (%q); want %q", tt.lat, tt.long, id, got, tt.want)
+		}
+		if got := LookupZoneName(tt.lat, tt.long); got != tt.want {
+			t.Errorf("LookupZoneName(%v, %v) = %q; want %q", tt.lat, tt.long, got, tt.want)
+		}
+	}
+	if id := new(Table).LookupZoneID(0, 0); id != NoZone {
+		t.Errorf("zero Table LookupZoneID = %d; want NoZone", id)
+	}
+}
+
+func TestParseZoneID(t *testing.T) {
+	for i := 0; i < Default().numStatic; i++ {
+		want := ZoneID(i + 1)
+		if id, err := ParseZoneID(want.String()); id != want || err != nil {
+			t.Errorf("ParseZoneID(%q) = %d, %v; want %d", want.String(), id, err, want)
+		}
+	}
+	if id, err := ParseZoneID(""); id != NoZone || err != nil {
+		t.Errorf(`ParseZoneID("") = %d, %v; want NoZone`, id, err)
+	}
+	if _, err := ParseZoneID("Mars/Olympus_Mons"); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("ParseZoneID(unknown) error = %v; want ErrUnknownZone", err)
+	}
+}
+
+func TestZoneIDJSON(t *testing.T) {
+	type row struct {
+		Zone ZoneID
+	}
+	in := row{LookupZoneID(37.7833, -122.4167)}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"Zone":"America/Los_Angeles"}`; string(b) != want {
+		t.Errorf("Marshal = %s; want %s", b, want)
+	}
+	var out row
+	if err := json.Unmarshal(b, &out); err != nil || out != in {
+		t.Errorf("Unmarshal = %+v, %v; want %+v", out, err, in)
+	}
+
+	if b, err := json.Marshal(row{NoZone}); err != nil || string(b) != `{"Zone":""}` {
+		t.Errorf("Marshal(NoZone) = %s, %v", b, err)
+	}
+	if _, err := json.Marshal(row{ZoneID(60000)}); err == nil {
+		t.Error("Marshal of unknown ZoneID succeeded")
+	}
+	if err := json.Unmarshal([]byte(`{"Zone":"Nowhere"}`), &out); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("Unmarshal of unknown zone error = %v; want ErrUnknownZone", err)
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import "sort"
+
+// zoneInfoLen is the size of each zone's entry in the ZONE section.
+const zoneInfoLen = 20
+
+// ZoneInfo describes a zone as the tables draw it. It is computed by
+// the generator from the same pixels that lookups use, so it is only
+// as accurate as they are.
+type ZoneInfo struct {
+	ID   ZoneID
+	Name string
+
+	// Pixels is the number of pixels the zone covers. It is zero for
+	// a zone that keeps its ZoneID but no longer has any area.
+	Pixels int
+
+	// AreaKm2 is the zone's approximate area in square kilometers.
+	AreaKm2 float64
+
+	// Min and Max are the south-west and north-east corners of the
+	// zone's bounding box. If the box crosses the antimeridian,
+	// Min.Long is greater than Max.Long.
+	Min, Max Point
+
+	// Center is a point inside the zone, as far from its borders as
+	// possible, suitable for labeling it on a map.
+	Center Point
+}
+
+// Zones returns the names of all the zones in the default Table,
+// sorted. Like ZoneCount, it includes zones that keep their ZoneID
+// but no longer cover any pixels, which lookups never return;
+// ZoneInfos reports which those are, as a zero Pixels.
+func Zones() []string {
+	return Default().Zones()
+}
+
+// Zones is like the package-level Zones but uses t.
+func (t *Table) Zones() []string {
+	zones := make([]string, t.ZoneCount())
+	for i := range zones {
+		zones[i] = t.zoneName(uint16(i))
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+// ZoneCount returns the number of zones in the default Table, which
+// is also the largest ZoneID it has.
+func ZoneCount() int {
+	return Default().ZoneCount()
+}
+
+// ZoneCount is like the package-level ZoneCount but uses t.
+func (t *Table) ZoneCount() int {
+	if t.Init() != nil {
+		return 0
+	}
+	return t.numStatic
+}
+
+// ZoneInfos returns the ZoneInfo of every zone in the default Table,
+// in ZoneID order. It returns nil if the tables don't include zone
+// metadata.
+func ZoneInfos() []ZoneInfo {
+	return Default().ZoneInfos()
+}
+
+// ZoneInfos is like the package-level ZoneInfos but uses t.
+func (t *Table) ZoneInfos() []ZoneInfo {
+	if t.zones == "" {
+		return nil
+	}
+	infos := make([]ZoneInfo, t.numStatic)
+	for i := range infos {
+		infos[i], _ = t.ZoneInfo(zoneID(uint16(i)))
+	}
+	return infos
+}
+
+// ZoneInfo returns the ZoneInfo of zone id in t. It reports false if
+// t doesn't have the zone or doesn't include zone metadata, as tables
+// from older generators don't.
+func (t *Table) ZoneInfo(id ZoneID) (ZoneInfo, bool) {
+	if t.zones == "" || id == NoZone || int(id) > t.numStatic {
+		return ZoneInfo{}, false
+	}
+	z := t.zones[zoneInfoLen*int(id-1):]
+	info := ZoneInfo{
+		ID:      id,
+		Name:    t.ZoneName(id),
+		Pixels:  int(be32(z)),
+		AreaKm2: float64(be32(z[4:])),
+	}
+	if info.Pixels == 0 {
+		return info, true
+	}
+	minX, minY := be16(z[8:]), be16(z[10:])
+	maxX, maxY := be16(z[12:]), be16(z[14:])
+	info.Min = t.pixelPoint(float64(minX), float64(maxY)+1)
+	info.Max = t.pixelPoint(float64(maxX)+1, float64(minY))
+	info.Center = t.pixelPoint(float64(be16(z[16:]))+0.5, float64(be16(z[18:]))+0.5)
+	return info, true
+}
+
+// pixelPoint is the inverse of latLongPixel, for fractional pixels.
+func (t *Table) pixelPoint(x, y float64) Point {
+	dp := float64(t.degPixels)
+	return Point{Lat: 90 - y/dp, Long: x/dp - 180}
+}
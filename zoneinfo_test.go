@@ -0,0 +1,318 @@
+/*
+Copyright 2014 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package latlong
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io/ioutil"
+	"math"
+	"strings"
+	"testing"
+)
+
+// zoneInfoSection returns the ZONE section for t, computed from the
+// zone of every pixel. The generator calls it on the table it has
+// just built. It fails if t is too fine for the section's 2 byte
+// pixel coordinates.
+func zoneInfoSection(t *Table) (string, error) {
+	if t.degPixels > maxZoneDegPixels {
+		return "", fmt.Errorf("no ZONE section at %d pixels per degree: coordinates would overflow", t.degPixels)
+	}
+	w, h := 360*t.degPixels, 180*t.degPixels
+	n := t.numStatic
+	label := make([]uint16, w*h)
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			label[y*w+x] = t.pixelZone(x, y)
+		}
+	}
+
+	type stats struct {
+		pixels     int
+		area       float64
+		minY, maxY int
+		cols       []bool
+		best       int // distance of (cx, cy) from the border
+
+		cx, cy int
+	}
+	zs := make([]stats, n)
+	for i := range zs {
+		zs[i].cols = make([]bool, w)
+		zs[i].minY = h
+		zs[i].best = -1
+	}
+
+	// dist is the chamfer (3-4) distance of each pixel from the
+	// nearest pixel of another zone.
+	const inf = math.MaxUint16
+	dist := make([]uint16, w*h)
+	const earthRadiusKm = 6371.0088
+	dx := math.Pi / 180 / float64(t.degPixels)
+	for y := 0; y < h; y++ {
+		top := (90 - float64(y)/float64(t.degPixels)) * math.Pi / 180
+		rowArea := earthRadiusKm * earthRadiusKm * dx * (math.Sin(top) - math.Sin(top-dx))
+		for x := 0; x < w; x++ {
+			l := label[y*w+x]
+			dist[y*w+x] = inf
+			if y == 0 || y == h-1 || label[(y-1)*w+x] != l || label[(y+1)*w+x] != l ||
+				label[y*w+(x+w-1)%w] != l || label[y*w+(x+1)%w] != l {
+				dist[y*w+x] = 0
+			}
+			if l == oceanIndex {
+				continue
+			}
+			z := &zs[l]
+			z.pixels++
+			z.area += rowArea
+			z.cols[x] = true
+			if y < z.minY {
+				z.minY = y
+			}
+			z.maxY = y
+		}
+	}
+	relax := func(i, j int, step uint16) {
+		if d := dist[j]; d < inf-step && d+step < dist[i] {
+			dist[i] = d + step
+		}
+	}
+	for y := 1; y < h; y++ {
+		for x := 1; x < w-1; x++ {
+			i := y*w + x
+			relax(i, i-1, 3)
+			relax(i, i-w, 3)
+			relax(i, i-w-1, 4)
+			relax(i, i-w+1, 4)
+		}
+	}
+	for y := h - 2; y >= 0; y-- {
+		for x := w - 2; x >= 1; x-- {
+			i := y*w + x
+			relax(i, i+1, 3)
+			relax(i, i+w, 3)
+			relax(i, i+w+1, 4)
+			relax(i, i+w-1, 4)
+		}
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			if l := label[y*w+x]; l != oceanIndex {
+				if z := &zs[l]; int(dist[y*w+x]) > z.best {
+					z.best, z.cx, z.cy = int(dist[y*w+x]), x, y
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, z := range zs {
+		var minX, maxX int
+		if z.pixels > 0 {
+			minX, maxX = columnSpan(z.cols)
+		} else {
+			z.minY = 0
+		}
+		binary.Write(&buf, binary.BigEndian, [2]uint32{uint32(z.pixels), uint32(math.Round(z.area))})
+		binary.Write(&buf, binary.BigEndian, [6]uint16{
+			uint16(minX), uint16(z.minY), uint16(maxX), uint16(z.maxY), uint16(z.cx), uint16(z.cy),
+		})
+	}
+	return buf.String(), nil
+}
+
+// columnSpan returns the first and last of the set columns, going
+// east around the world, that leave the widest unset gap outside
+// them. So a span across the antimeridian has first > last.
+func columnSpan(cols []bool) (first, last int) {
+	w := len(cols)
+	gapStart, gapLen := 0, 0
+	run := 0
+	for i := 0; i < 2*w; i++ {
+		if cols[i%w] {
+			run = 0
+			continue
+		}
+		if run++; run > gapLen && run <= w {
+			gapStart, gapLen = i-run+1, run
+		}
+	}
+	if gapLen == 0 {
+		return 0, w - 1
+	}
+	return (gapStart + gapLen) % w, (gapStart - 1 + w) % w
+}
+
+func TestColumnSpan(t *testing.T) {
+	cols := func(s string) []bool {
+		b := make([]bool, len(s))
+		for i := range s {
+			b[i] = s[i] == 'x'
+		}
+		return b
+	}
+	cases := []struct {
+		cols        string
+		first, last int
+	}{
+		{"xxxxxxxx", 0, 7},
+		{"..xx.x..", 2, 5},
+		{"x..xx...x", 8, 4},
+		{"x......x", 7, 0},
+		{"...x....", 3, 3},
+	}
+	for _, tt := range cases {
+		if first, last := columnSpan(cols(tt.cols)); first != tt.first || last != tt.last {
+			t.Errorf("columnSpan(%q) = %d, %d; want %d, %d", tt.cols, first, last, tt.first, tt.last)
+		}
+	}
+}
+
+func TestZones(t *testing.T) {
+	zones := Zones()
+	if len(zones) != ZoneCount() || len(zones) < 400 {
+		t.Fatalf("len(Zones()) = %d, ZoneCount() = %d", len(zones), ZoneCount())
+	}
+	for i := 1; i < len(zones); i++ {
+		if zones[i-1] >= zones[i] {
+			t.Errorf("Zones not sorted: %q before %q", zones[i-1], zones[i])
+		}
+	}
+	if n := new(Table).ZoneCount(); n != 0 {
+		t.Errorf("zero Table ZoneCount = %d", n)
+	}
+}
+
+func TestZoneInfos(t *testing.T) {
+	infos := ZoneInfos()
+	if len(infos) != ZoneCount() {
+		t.Fatalf("len(ZoneInfos()) = %d; want %d", len(infos), ZoneCount())
+	}
+	for i, info := range infos {
+		if info.ID != ZoneID(i+1) || info.Name != info.ID.String() {
+			t.Errorf("ZoneInfos()[%d] = %d %q", i, info.ID, info.Name)
+		}
+		if info.Pixels == 0 {
+			continue
+		}
+		if got := LookupZoneName(info.Center.Lat, info.Center.Long); got != info.Name {
+			t.Errorf("%s: Center %v is in %q", info.Name, info.Center, got)
+		}
+		if info.Min.Lat > info.Center.Lat || info.Center.Lat > info.Max.Lat {
+			t.Errorf("%s: Center %v outside %v-%v", info.Name, info.Center, info.Min, info.Max)
+		}
+	}
+
+	byName := map[string]ZoneInfo{}
+	for _, info := range infos {
+		byName[info.Name] = info
+	}
+	if z := byName["Pacific/Fiji"]; z.Min.Long <= z.Max.Long {
+		t.Errorf("Pacific/Fiji bounds %v-%v don't cross the antimeridian", z.Min, z.Max)
+	}
+	// Metropolitan France has about 550,000 km² of land, and the zone
+	// also covers its coastal waters.
+	if z := byName["Europe/Paris"]; z.AreaKm2 < 500e3 || z.AreaKm2 > 800e3 {
+		t.Errorf("Europe/Paris area = %v km²", z.AreaKm2)
+	}
+	if z := byName["America/Los_Angeles"]; z.Min.Lat < 25 || z.Max.Lat > 52 || z.Min.Long < -135 || z.Max.Long > -110 {
+		t.Errorf("America/Los_Angeles bounds = %v-%v", z.Min, z.Max)
+	}
+}
+
+func TestZoneInfoFixture(t *testing.T) {
+	fix := fixtureTable()
+	if fix.ZoneInfos() != nil {
+		t.Error("ZoneInfos of a table without metadata is non-nil")
+	}
+	zones, err := zoneInfoSection(fix)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fix.zones = zones
+	tb, err := LoadTable(bytes.NewReader(encodeTable(fix, false)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	west, ok := tb.ZoneInfo(1)
+	if !ok {
+		t.Fatal("no ZoneInfo for Fixture/West")
+	}
+	if west.Name != "Fixture/West" || west.Pixels != 256*180+32 {
+		t.Errorf("west = %+v", west)
+	}
+	if want := (Point{-90, -180}); west.Min != want {
+		t.Errorf("west.Min = %v; want %v", west.Min, want)
+	}
+	if want := (Point{90, 272 - 180}); west.Max != want {
+		t.Errorf("west.Max = %v; want %v", west.Max, want)
+	}
+	east, _ := tb.ZoneInfo(2)
+	if east.Pixels != 96 || tb.LookupZoneName(east.Center.Lat, east.Center.Long) != "Fixture/East" {
+		t.Errorf("east = %+v", east)
+	}
+	// 256 of the 360 degrees of longitude, from pole to pole.
+	if want := 4 * math.Pi * 6371.0088 * 6371.0088 * 256 / 360; math.Abs(west.AreaKm2-want) > want*0.01 {
+		t.Errorf("west.AreaKm2 = %v; want about %v", west.AreaKm2, want)
+	}
+	if _, ok := tb.ZoneInfo(3); ok {
+		t.Error("ZoneInfo(3) reported ok for a bitmap leaf")
+	}
+
+	fix.zones = strings.Repeat("\xff", zoneInfoLen*fix.numStatic)
+	if _, err := LoadTable(bytes.NewReader(encodeTable(fix, false))); err == nil {
+		t.Error("LoadTable accepted zone coordinates out of range")
+	}
+}
+
+// Above 182 pixels per degree, pixel x coordinates don't fit in the
+// ZONE section, so it is neither written nor read.
+func TestZoneInfoHighRes(t *testing.T) {
+	hr := highResTable()
+	if _, err := zoneInfoSection(hr); err == nil {
+		t.Error("zoneInfoSection succeeded at 200 pixels per degree")
+	}
+	zones := strings.Repeat("\x00", zoneInfoLen*hr.numStatic)
+	f := &tableFile{degPixels: hr.degPixels, nodes: hr.nodes, numLeaves: hr.numLeaves, leaves: hr.leaves, zones: zones}
+	if err := f.writeTo(ioutil.Discard, false); err == nil {
+		t.Error("writeTo wrote a ZONE section at 200 pixels per degree")
+	}
+
+	f.zones = ""
+	var buf bytes.Buffer
+	if err := f.writeTo(&buf, false); err != nil {
+		t.Fatal(err)
+	}
+	// Splice in a ZONE section before END and fix up the checksum.
+	b := buf.Bytes()[:buf.Len()-12]
+	var sec bytes.Buffer
+	writeSection(&sec, "ZONE", []byte(zones))
+	b = append(b, sec.Bytes()...)
+	var sum [4]byte
+	binary.BigEndian.PutUint32(sum[:], crc32.ChecksumIEEE(b))
+	sec.Reset()
+	writeSection(&sec, "END ", sum[:])
+	b = append(b, sec.Bytes()...)
+	if _, err := LoadTable(bytes.NewReader(b)); !errors.Is(err, ErrCorruptTables) {
+		t.Errorf("LoadTable of a ZONE section at 200 pixels per degree: %v; want ErrCorruptTables", err)
+	}
+}